@@ -0,0 +1,70 @@
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// RegisterFieldNames 让校验错误中的字段名使用 json/form 标签，与请求中的字段名一致
+func RegisterFieldNames() {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		for _, tag := range []string{"json", "form"} {
+			name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+			if name == "-" {
+				return ""
+			}
+			if name != "" {
+				return name
+			}
+		}
+		return field.Name
+	})
+}
+
+// FromBinding 将 ShouldBind 系列方法返回的错误转换为带字段详情的 400 错误
+func FromBinding(err error) *Error {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		apiErr := BadRequest(CodeValidationFailed)
+		for _, fe := range validationErrs {
+			var params map[string]any
+			if fe.Param() != "" {
+				params = map[string]any{"param": fe.Param()}
+			}
+			apiErr.WithDetails(Field(fieldPath(fe), fe.Tag(), params))
+		}
+		return apiErr
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return BadRequest(CodeValidationFailed).
+			WithDetails(Field(typeErr.Field, "type", map[string]any{"param": typeErr.Type.String()})).
+			WithCause(err)
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return BadRequest(CodeInvalidJSON).WithCause(err)
+	}
+	return BadRequest(CodeInvalidRequest).WithCause(err)
+}
+
+// fieldPath 返回去掉顶层结构体名的字段路径，例如 UpdateAdInput.title 返回 title
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if _, rest, ok := strings.Cut(namespace, "."); ok {
+		return rest
+	}
+	return fe.Field()
+}
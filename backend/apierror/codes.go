@@ -0,0 +1,97 @@
+package apierror
+
+// Code 稳定的机器可读错误码，客户端应依据错误码而非错误信息进行判断
+type Code string
+
+// 通用错误码
+const (
+	CodeInvalidRequest   Code = "invalid_request"
+	CodeInvalidJSON      Code = "invalid_json"
+	CodeValidationFailed Code = "validation_failed"
+	CodeInvalidID        Code = "invalid_id"
+	CodeRouteNotFound    Code = "route_not_found"
+	CodeMethodNotAllowed Code = "method_not_allowed"
+	CodeInternal         Code = "internal_error"
+	CodeRateLimited      Code = "rate_limited"
+)
+
+// 认证相关错误码
+const (
+	CodeAuthRequired       Code = "auth_required"
+	CodeAuthMalformed      Code = "auth_malformed"
+	CodeInvalidToken       Code = "invalid_token"
+	CodeInvalidCredentials Code = "invalid_credentials"
+	CodeWrongPassword      Code = "wrong_password"
+	CodeAccountLocked      Code = "account_locked"
+	CodePermissionDenied   Code = "permission_denied"
+	CodeInvalidAPIKey      Code = "invalid_api_key"
+	CodeInsufficientScope  Code = "insufficient_scope"
+
+	CodeInvalidChallenge       Code = "invalid_challenge"
+	CodeInvalidTOTPCode        Code = "invalid_totp_code"
+	CodeTOTPAlreadyEnabled     Code = "totp_already_enabled"
+	CodeTOTPNotEnabled         Code = "totp_not_enabled"
+	CodeTOTPNotEnrolled        Code = "totp_not_enrolled"
+	CodeTOTPRequired           Code = "totp_required"
+	CodeTOTPEnrollmentRequired Code = "totp_enrollment_required"
+)
+
+// 资源相关错误码
+const (
+	CodeAdNotFound         Code = "ad_not_found"
+	CodeBuildingNotFound   Code = "building_not_found"
+	CodePlacementNotFound  Code = "placement_not_found"
+	CodeAdminNotFound      Code = "admin_not_found"
+	CodeAPIKeyNotFound     Code = "api_key_not_found"
+	CodeWebhookNotFound    Code = "webhook_not_found"
+	CodeDeliveryNotFound   Code = "webhook_delivery_not_found"
+	CodeDeliveryPending    Code = "webhook_delivery_pending"
+	CodeManifestVersion    Code = "manifest_version_not_found"
+	CodeLayoutNotFound     Code = "layout_not_found"
+	CodeNoticeNotFound     Code = "notice_not_found"
+	CodeCalendarNotFound   Code = "calendar_not_found"
+	CodeEventNotFound      Code = "calendar_event_not_found"
+	CodeDaypartNotFound    Code = "daypart_not_found"
+	CodeRuleNotFound       Code = "exclusivity_rule_not_found"
+	CodeLayoutInUse        Code = "layout_in_use"
+	CodeCalendarInUse      Code = "calendar_in_use"
+	CodeDaypartInUse       Code = "daypart_in_use"
+	CodeUnknownZone        Code = "unknown_zone"
+	CodeZoneNotPlayable    Code = "zone_not_playable"
+	CodeUnknownAdIDs       Code = "unknown_ad_ids"
+	CodeUnknownBuildingIDs Code = "unknown_building_ids"
+	CodeUnknownNoticeIDs   Code = "unknown_notice_ids"
+	CodeUsernameTaken      Code = "username_taken"
+	CodeAdminSelfDelete    Code = "admin_self_delete"
+	CodeLastSuperAdmin     Code = "last_super_admin"
+	CodeBuildingNameTaken  Code = "building_name_taken"
+	CodeLayoutNameTaken    Code = "layout_name_taken"
+	CodeCalendarNameTaken  Code = "calendar_name_taken"
+	CodeDaypartNameTaken   Code = "daypart_name_taken"
+	CodePlacementExists    Code = "placement_exists"
+	CodeNoticeLinked       Code = "notice_already_linked"
+	CodeRuleExists         Code = "exclusivity_rule_exists"
+	CodeExclusiveConflict  Code = "exclusivity_conflict"
+)
+
+// 计费相关错误码
+const (
+	CodeRateCardNotFound  Code = "rate_card_not_found"
+	CodeRateCardNameTaken Code = "rate_card_name_taken"
+	CodeRateMissing       Code = "rate_missing"
+	CodeInvoiceNotFound   Code = "invoice_not_found"
+	CodeInvoiceOverlap    Code = "invoice_overlap"
+	CodeInvoiceVoid       Code = "invoice_void"
+)
+
+// 文件导入导出相关错误码
+const (
+	CodeFileRequired        Code = "file_required"
+	CodeFileUnreadable      Code = "file_unreadable"
+	CodeUnsupportedFormat   Code = "unsupported_format"
+	CodeFileInvalid         Code = "file_invalid"
+	CodeImportEmpty         Code = "import_empty"
+	CodeImportMissingColumn Code = "import_missing_column"
+	CodeImportInvalid       Code = "import_invalid"
+	CodeUploadPolicyFailed  Code = "upload_policy_failed"
+)
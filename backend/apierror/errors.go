@@ -0,0 +1,201 @@
+package apierror
+
+// apierror 定义统一的 API 错误响应：稳定的错误码、字段级校验详情与按 Accept-Language 本地化的错误信息
+//
+// 响应格式：
+//
+//	{"error": {"code": "ad_not_found", "message": "广告未找到", "details": [...], "meta": {...}, "request_id": "..."}}
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// Error 携带 HTTP 状态码与错误码的错误，可在事务回调等位置直接返回
+type Error struct {
+	Status  int
+	Code    Code
+	Params  map[string]any // 填充错误信息中的 {name} 占位符
+	Details []FieldError
+	Meta    map[string]any // 附加数据，原样返回给客户端
+	cause   error
+
+	retryAfter int // 大于 0 时输出 Retry-After 响应头（秒）
+}
+
+// FieldError 字段级错误，Message 在响应时按语言生成
+type FieldError struct {
+	Field   string         `json:"field"`
+	Rule    string         `json:"rule"`
+	Params  map[string]any `json:"params,omitempty"`
+	Message string         `json:"message"`
+}
+
+// Body 错误响应中 error 字段的内容
+type Body struct {
+	Code      Code           `json:"code"`
+	Message   string         `json:"message"`
+	Details   []FieldError   `json:"details,omitempty"`
+	Meta      map[string]any `json:"meta,omitempty"`
+	RequestID string         `json:"request_id,omitempty"`
+}
+
+// Envelope 错误响应
+type Envelope struct {
+	Error Body `json:"error"`
+}
+
+// New 创建错误
+func New(status int, code Code) *Error {
+	return &Error{Status: status, Code: code}
+}
+
+// BadRequest 返回 400 错误
+func BadRequest(code Code) *Error { return New(http.StatusBadRequest, code) }
+
+// Unauthorized 返回 401 错误
+func Unauthorized(code Code) *Error { return New(http.StatusUnauthorized, code) }
+
+// Forbidden 返回 403 错误
+func Forbidden(code Code) *Error { return New(http.StatusForbidden, code) }
+
+// NotFound 返回 404 错误
+func NotFound(code Code) *Error { return New(http.StatusNotFound, code) }
+
+// Conflict 返回 409 错误
+func Conflict(code Code) *Error { return New(http.StatusConflict, code) }
+
+// Unprocessable 返回 422 错误
+func Unprocessable(code Code) *Error { return New(http.StatusUnprocessableEntity, code) }
+
+// Internal 返回 500 错误，cause 只写入日志，不返回给客户端
+func Internal(cause error) *Error {
+	return New(http.StatusInternalServerError, CodeInternal).WithCause(cause)
+}
+
+// Field 创建字段级错误
+func Field(field, rule string, params map[string]any) FieldError {
+	return FieldError{Field: field, Rule: rule, Params: params}
+}
+
+// With 设置错误信息占位符参数
+func (e *Error) With(key string, value any) *Error {
+	if e.Params == nil {
+		e.Params = make(map[string]any)
+	}
+	e.Params[key] = value
+	return e
+}
+
+// WithDetails 追加字段级错误
+func (e *Error) WithDetails(details ...FieldError) *Error {
+	e.Details = append(e.Details, details...)
+	return e
+}
+
+// WithMeta 设置附加数据
+func (e *Error) WithMeta(key string, value any) *Error {
+	if e.Meta == nil {
+		e.Meta = make(map[string]any)
+	}
+	e.Meta[key] = value
+	return e
+}
+
+// WithRetryAfter 设置 Retry-After 响应头，同时写入 meta 与信息占位符 retry_after
+func (e *Error) WithRetryAfter(seconds int) *Error {
+	e.retryAfter = seconds
+	return e.With("retry_after", seconds).WithMeta("retry_after", seconds)
+}
+
+// WithCause 记录原始错误，仅用于日志
+func (e *Error) WithCause(err error) *Error {
+	e.cause = err
+	return e
+}
+
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return fmt.Sprintf("%s: %v", e.Code, e.cause)
+	}
+	return string(e.Code)
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// From 将任意错误转换为 *Error，非 *Error 的错误视为内部错误
+func From(err error) *Error {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	return Internal(err)
+}
+
+// Respond 按请求语言输出错误响应并中止后续处理，5xx 错误记录日志
+func Respond(c *gin.Context, err error) {
+	apiErr := From(err)
+	ctx := c.Request.Context()
+	if apiErr.Status >= http.StatusInternalServerError {
+		logging.FromContext(ctx).ErrorContext(ctx, "请求处理失败",
+			slog.String("code", string(apiErr.Code)),
+			slog.Any("error", apiErr.Unwrap()),
+		)
+	}
+
+	if apiErr.retryAfter > 0 {
+		c.Header("Retry-After", strconv.Itoa(apiErr.retryAfter))
+	}
+	lang := Language(c)
+	c.Header("Content-Language", string(lang))
+	c.AbortWithStatusJSON(apiErr.Status, Envelope{Error: Body{
+		Code:      apiErr.Code,
+		Message:   Message(lang, apiErr.Code, apiErr.Params),
+		Details:   LocalizeFields(lang, apiErr.Details),
+		Meta:      apiErr.Meta,
+		RequestID: logging.RequestID(ctx),
+	}})
+}
+
+// LocalizeFields 返回填充了本地化 Message 的字段错误副本
+func LocalizeFields(lang Lang, fields []FieldError) []FieldError {
+	if len(fields) == 0 {
+		return nil
+	}
+	result := make([]FieldError, len(fields))
+	for i, f := range fields {
+		f.Message = FieldMessage(lang, f)
+		result[i] = f
+	}
+	return result
+}
+
+// Recovery 捕获处理函数中的 panic，记录日志后返回 internal_error
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(nil, func(c *gin.Context, recovered any) {
+		logging.FromContext(c.Request.Context()).Error("panic recovered",
+			slog.Any("panic", recovered),
+			slog.String("stack", string(debug.Stack())),
+		)
+		Respond(c, New(http.StatusInternalServerError, CodeInternal))
+	})
+}
+
+// NoRoute 未匹配路由时返回 route_not_found
+func NoRoute(c *gin.Context) {
+	Respond(c, NotFound(CodeRouteNotFound))
+}
+
+// NoMethod 路由存在但请求方法不支持时返回 method_not_allowed
+func NoMethod(c *gin.Context) {
+	Respond(c, New(http.StatusMethodNotAllowed, CodeMethodNotAllowed))
+}
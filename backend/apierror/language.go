@@ -0,0 +1,63 @@
+package apierror
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Lang 支持的响应语言
+type Lang string
+
+const (
+	LangZhCN Lang = "zh-CN"
+	LangEn   Lang = "en"
+
+	// DefaultLang 未指定或不支持 Accept-Language 时使用的语言
+	DefaultLang = LangZhCN
+)
+
+// Language 根据请求的 Accept-Language 选择响应语言
+func Language(c *gin.Context) Lang {
+	return ParseAcceptLanguage(c.GetHeader("Accept-Language"))
+}
+
+// ParseAcceptLanguage 按权重选择第一个支持的语言，例如 "en-US,en;q=0.9,zh;q=0.8" 返回 en
+func ParseAcceptLanguage(header string) Lang {
+	type candidate struct {
+		tag string
+		q   float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > 0 {
+			candidates = append(candidates, candidate{tag: strings.ToLower(tag), q: q})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+
+	for _, cand := range candidates {
+		primary, _, _ := strings.Cut(cand.tag, "-")
+		switch primary {
+		case "zh":
+			return LangZhCN
+		case "en":
+			return LangEn
+		}
+	}
+	return DefaultLang
+}
@@ -0,0 +1,180 @@
+package apierror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// messages 错误码对应的本地化信息，{name} 为占位符
+var messages = map[Code]map[Lang]string{
+	CodeInvalidRequest:   {LangZhCN: "请求参数格式错误", LangEn: "Malformed request"},
+	CodeInvalidJSON:      {LangZhCN: "请求体不是有效的 JSON", LangEn: "Request body is not valid JSON"},
+	CodeValidationFailed: {LangZhCN: "请求参数校验失败", LangEn: "Request validation failed"},
+	CodeInvalidID:        {LangZhCN: "无效的 ID", LangEn: "Invalid ID"},
+	CodeRouteNotFound:    {LangZhCN: "接口不存在", LangEn: "Route not found"},
+	CodeMethodNotAllowed: {LangZhCN: "不支持的请求方法", LangEn: "Method not allowed"},
+	CodeInternal:         {LangZhCN: "服务器内部错误", LangEn: "Internal server error"},
+	CodeRateLimited:      {LangZhCN: "请求过于频繁，请 {retry_after} 秒后重试", LangEn: "Too many requests, retry in {retry_after} seconds"},
+
+	CodeAuthRequired:       {LangZhCN: "缺少 Authorization 请求头", LangEn: "Authorization header required"},
+	CodeAuthMalformed:      {LangZhCN: "Authorization 请求头格式应为 Bearer {token}", LangEn: "Authorization header format must be Bearer {token}"},
+	CodeInvalidToken:       {LangZhCN: "令牌无效或已过期", LangEn: "Invalid or expired token"},
+	CodeInvalidCredentials: {LangZhCN: "无效的凭证", LangEn: "Invalid credentials"},
+	CodeWrongPassword:      {LangZhCN: "旧密码不正确", LangEn: "Old password is incorrect"},
+	CodePermissionDenied:   {LangZhCN: "没有执行该操作的权限", LangEn: "You do not have permission to perform this action"},
+	CodeInvalidAPIKey:      {LangZhCN: "API 密钥无效、已过期或已吊销", LangEn: "The API key is invalid, expired or revoked"},
+	CodeInsufficientScope:  {LangZhCN: "API 密钥缺少 {scope} 权限", LangEn: "The API key lacks the {scope} scope"},
+	CodeAccountLocked:      {LangZhCN: "登录失败次数过多，账号已锁定，请 {retry_after} 秒后重试", LangEn: "Too many failed logins, the account is locked for {retry_after} seconds"},
+
+	CodeInvalidChallenge:       {LangZhCN: "两步验证会话无效或已过期，请重新登录", LangEn: "The two-factor challenge is invalid or expired, please log in again"},
+	CodeInvalidTOTPCode:        {LangZhCN: "验证码或恢复码不正确", LangEn: "Invalid verification or recovery code"},
+	CodeTOTPAlreadyEnabled:     {LangZhCN: "两步验证已启用", LangEn: "Two-factor authentication is already enabled"},
+	CodeTOTPNotEnabled:         {LangZhCN: "两步验证未启用", LangEn: "Two-factor authentication is not enabled"},
+	CodeTOTPNotEnrolled:        {LangZhCN: "请先获取两步验证密钥", LangEn: "Start two-factor enrollment first"},
+	CodeTOTPRequired:           {LangZhCN: "超级管理员必须启用两步验证", LangEn: "Super administrators must keep two-factor authentication enabled"},
+	CodeTOTPEnrollmentRequired: {LangZhCN: "请先启用两步验证", LangEn: "Enable two-factor authentication to continue"},
+
+	CodeAdNotFound:         {LangZhCN: "广告未找到", LangEn: "Advertisement not found"},
+	CodeBuildingNotFound:   {LangZhCN: "大厦未找到", LangEn: "Building not found"},
+	CodePlacementNotFound:  {LangZhCN: "关联记录未找到", LangEn: "Placement not found"},
+	CodeAdminNotFound:      {LangZhCN: "管理员未找到", LangEn: "Administrator not found"},
+	CodeAPIKeyNotFound:     {LangZhCN: "API 密钥未找到", LangEn: "API key not found"},
+	CodeWebhookNotFound:    {LangZhCN: "事件推送订阅未找到", LangEn: "Webhook subscription not found"},
+	CodeDeliveryNotFound:   {LangZhCN: "投递记录未找到", LangEn: "Webhook delivery not found"},
+	CodeDeliveryPending:    {LangZhCN: "投递记录正在等待发送，无需重试", LangEn: "The delivery is still pending and cannot be retried"},
+	CodeManifestVersion:    {LangZhCN: "清单版本 {since} 不存在或已过期，请获取完整清单", LangEn: "Manifest version {since} is unknown or expired, fetch the full manifest"},
+	CodeNoticeNotFound:     {LangZhCN: "通知未找到", LangEn: "Notice not found"},
+	CodeCalendarNotFound:   {LangZhCN: "日历未找到", LangEn: "Calendar not found"},
+	CodeEventNotFound:      {LangZhCN: "日历事件未找到", LangEn: "Calendar event not found"},
+	CodeDaypartNotFound:    {LangZhCN: "分时规则未找到", LangEn: "Daypart not found"},
+	CodeRuleNotFound:       {LangZhCN: "排他规则未找到", LangEn: "Exclusivity rule not found"},
+	CodeLayoutNotFound:     {LangZhCN: "屏幕布局未找到", LangEn: "Layout not found"},
+	CodeLayoutInUse:        {LangZhCN: "屏幕布局正在被大厦使用，无法删除", LangEn: "The layout is assigned to buildings and cannot be deleted"},
+	CodeCalendarInUse:      {LangZhCN: "日历正在被分时规则引用，无法删除", LangEn: "The calendar is referenced by dayparts and cannot be deleted"},
+	CodeDaypartInUse:       {LangZhCN: "分时规则正在被投放使用，无法删除", LangEn: "The daypart is used by placements and cannot be deleted"},
+	CodeUnknownZone:        {LangZhCN: "大厦的屏幕布局中没有区域 {zone}", LangEn: "The building layout has no zone {zone}"},
+	CodeZoneNotPlayable:    {LangZhCN: "区域 {zone} 是组件区域，不能投放广告", LangEn: "Zone {zone} is a widget zone and cannot show advertisements"},
+	CodeUnknownAdIDs:       {LangZhCN: "某些广告 ID 不存在", LangEn: "Some advertisement IDs do not exist"},
+	CodeUnknownBuildingIDs: {LangZhCN: "某些建筑 ID 不存在", LangEn: "Some building IDs do not exist"},
+	CodeUnknownNoticeIDs:   {LangZhCN: "某些通知 ID 不存在", LangEn: "Some notice IDs do not exist"},
+	CodeUsernameTaken:      {LangZhCN: "用户名已存在", LangEn: "Username already exists"},
+	CodeAdminSelfDelete:    {LangZhCN: "不能删除当前登录的管理员", LangEn: "You cannot delete the administrator you are signed in as"},
+	CodeLastSuperAdmin:     {LangZhCN: "不能删除最后一个超级管理员", LangEn: "The last super administrator cannot be deleted"},
+	CodeBuildingNameTaken:  {LangZhCN: "大厦名称已存在", LangEn: "Building name already exists"},
+	CodeLayoutNameTaken:    {LangZhCN: "屏幕布局名称已存在", LangEn: "Layout name already exists"},
+	CodeCalendarNameTaken:  {LangZhCN: "日历名称已存在", LangEn: "Calendar name already exists"},
+	CodeDaypartNameTaken:   {LangZhCN: "分时规则名称已存在", LangEn: "Daypart name already exists"},
+	CodePlacementExists:    {LangZhCN: "广告与建筑已关联", LangEn: "Advertisement is already linked to the building"},
+	CodeNoticeLinked:       {LangZhCN: "通知与建筑已关联", LangEn: "Notice is already linked to the building"},
+	CodeRuleExists:         {LangZhCN: "大厦已有该类别的排他规则", LangEn: "The building already has an exclusivity rule for this category"},
+	CodeExclusiveConflict:  {LangZhCN: "投放违反大厦的广告类别排他规则", LangEn: "The placements violate the building's category exclusivity rules"},
+
+	CodeRateCardNotFound:  {LangZhCN: "费率卡未找到", LangEn: "Rate card not found"},
+	CodeRateCardNameTaken: {LangZhCN: "费率卡名称已存在", LangEn: "Rate card name already exists"},
+	CodeRateMissing:       {LangZhCN: "费率卡没有适用于某些大厦等级的费率", LangEn: "The rate card has no rates for some building tiers"},
+	CodeInvoiceNotFound:   {LangZhCN: "发票未找到", LangEn: "Invoice not found"},
+	CodeInvoiceOverlap:    {LangZhCN: "广告在该计费期已有未作废的发票", LangEn: "The advertisement already has invoices for this billing period"},
+	CodeInvoiceVoid:       {LangZhCN: "发票已作废", LangEn: "The invoice is already void"},
+
+	CodeFileRequired:        {LangZhCN: "请上传文件（字段名 {field}）", LangEn: "A file is required (field {field})"},
+	CodeFileUnreadable:      {LangZhCN: "读取上传文件失败", LangEn: "Failed to read the uploaded file"},
+	CodeUnsupportedFormat:   {LangZhCN: "仅支持 csv 和 xlsx 格式", LangEn: "Only csv and xlsx formats are supported"},
+	CodeFileInvalid:         {LangZhCN: "无法解析上传的文件", LangEn: "The uploaded file could not be parsed"},
+	CodeImportEmpty:         {LangZhCN: "导入文件没有数据行", LangEn: "The import file has no data rows"},
+	CodeImportMissingColumn: {LangZhCN: "表头缺少 {column} 列", LangEn: "The header is missing the {column} column"},
+	CodeImportInvalid:       {LangZhCN: "导入数据校验未通过", LangEn: "The import contains invalid rows"},
+	CodeUploadPolicyFailed:  {LangZhCN: "生成上传策略失败", LangEn: "Failed to issue the upload policy"},
+}
+
+// fieldMessages 字段校验规则对应的本地化信息，{field} 为字段名，{param} 等为规则参数
+var fieldMessages = map[string]map[Lang]string{
+	"required":  {LangZhCN: "{field} 为必填项", LangEn: "{field} is required"},
+	"min":       {LangZhCN: "{field} 不能小于 {param}", LangEn: "{field} must be at least {param}"},
+	"max":       {LangZhCN: "{field} 不能大于 {param}", LangEn: "{field} must be at most {param}"},
+	"len":       {LangZhCN: "{field} 长度必须为 {param}", LangEn: "{field} must have length {param}"},
+	"gt":        {LangZhCN: "{field} 必须大于 {param}", LangEn: "{field} must be greater than {param}"},
+	"gte":       {LangZhCN: "{field} 必须大于或等于 {param}", LangEn: "{field} must be greater than or equal to {param}"},
+	"lt":        {LangZhCN: "{field} 必须小于 {param}", LangEn: "{field} must be less than {param}"},
+	"lte":       {LangZhCN: "{field} 必须小于或等于 {param}", LangEn: "{field} must be less than or equal to {param}"},
+	"oneof":     {LangZhCN: "{field} 必须是以下值之一：{param}", LangEn: "{field} must be one of: {param}"},
+	"email":     {LangZhCN: "{field} 不是有效的邮箱地址", LangEn: "{field} must be a valid email address"},
+	"url":       {LangZhCN: "{field} 不是有效的 URL", LangEn: "{field} must be a valid URL"},
+	"type":      {LangZhCN: "{field} 类型错误，应为 {param}", LangEn: "{field} must be of type {param}"},
+	"invalid":   {LangZhCN: "{field} 的值 {value} 无效", LangEn: "{field} has an invalid value {value}"},
+	"duplicate": {LangZhCN: "{field} 与第 {row} 行重复", LangEn: "{field} duplicates row {row}"},
+	"taken":     {LangZhCN: "{field} 已存在", LangEn: "{field} already exists"},
+	"not_found": {LangZhCN: "{field} 中的 {value} 不存在", LangEn: "{field} {value} does not exist"},
+	"future":    {LangZhCN: "{field} 必须是将来的时间", LangEn: "{field} must be in the future"},
+	"after":     {LangZhCN: "{field} 必须晚于 {param}", LangEn: "{field} must be after {param}"},
+	"max_days":  {LangZhCN: "{field} 距 {param} 不能超过 {days} 天", LangEn: "{field} must be within {days} days of {param}"},
+
+	"not_before":   {LangZhCN: "{field} 不能早于 {param}", LangEn: "{field} must not be before {param}"},
+	"duplicate_of": {LangZhCN: "{field} 与 {other} 重复", LangEn: "{field} duplicates {other}"},
+	"on_the_hour":  {LangZhCN: "{field} 必须是大厦当地的整点时间", LangEn: "{field} must be on the hour in the building's time zone"},
+
+	"whole_hour":   {LangZhCN: "{field} 必须是整点（HH:00）", LangEn: "{field} must be a whole hour (HH:00)"},
+	"overlaps":     {LangZhCN: "{field} 与 {other} 的时段重叠", LangEn: "{field} overlaps {other}"},
+	"day_coverage": {LangZhCN: "{field} 中等级 {tier} 的时段必须覆盖 00:00-24:00", LangEn: "{field} for tier {tier} must cover 00:00-24:00"},
+
+	"hexadecimal": {LangZhCN: "{field} 必须是十六进制字符串", LangEn: "{field} must be a hexadecimal string"},
+
+	"zone_bounds":         {LangZhCN: "{field} 超出布局画布范围", LangEn: "{field} extends beyond the layout canvas"},
+	"media_zone_required": {LangZhCN: "{field} 至少需要一个媒体区域", LangEn: "{field} must contain at least one media zone"},
+
+	"category_mismatch": {LangZhCN: "{field} 对应广告的类别应为 {param}", LangEn: "{field} must reference an advertisement in category {param}"},
+
+	"date":        {LangZhCN: "{field} 应为 YYYY-MM-DD 格式的日期", LangEn: "{field} must be a date in YYYY-MM-DD format"},
+	"time_of_day": {LangZhCN: "{field} 应为 00:00-24:00 之间的 HH:MM 时间", LangEn: "{field} must be a HH:MM time between 00:00 and 24:00"},
+	"timezone":    {LangZhCN: "{field} 不是有效的 IANA 时区", LangEn: "{field} must be a valid IANA time zone"},
+	"rrule":       {LangZhCN: "{field} 不是支持的重复规则：{reason}", LangEn: "{field} is not a supported recurrence rule: {reason}"},
+	"locale":      {LangZhCN: "{field} 不是有效的 BCP 47 语言标签", LangEn: "{field} must be a valid BCP 47 language tag"},
+
+	"password_length":   {LangZhCN: "{field} 至少需要 {param} 个字符", LangEn: "{field} must be at least {param} characters long"},
+	"password_too_long": {LangZhCN: "{field} 不能超过 {param} 个字节", LangEn: "{field} must be at most {param} bytes"},
+	"password_classes":  {LangZhCN: "{field} 至少需要包含小写字母、大写字母、数字、符号中的 {param} 类", LangEn: "{field} must contain at least {param} of: lowercase letters, uppercase letters, digits, symbols"},
+	"password_username": {LangZhCN: "{field} 不能与用户名相同", LangEn: "{field} must not match the username"},
+	"password_reused":   {LangZhCN: "{field} 不能与当前密码相同", LangEn: "{field} must differ from the current password"},
+}
+
+// Message 返回错误码在指定语言下的信息
+func Message(lang Lang, code Code, params map[string]any) string {
+	return render(lookup(messages[code], lang, string(code)), params)
+}
+
+// FieldMessage 返回字段错误在指定语言下的信息，未知规则使用通用描述
+func FieldMessage(lang Lang, f FieldError) string {
+	template, ok := fieldMessages[f.Rule]
+	if !ok {
+		template = map[Lang]string{
+			LangZhCN: "{field} 未通过 " + f.Rule + " 校验",
+			LangEn:   "{field} failed the " + f.Rule + " validation",
+		}
+	}
+	params := map[string]any{"field": f.Field}
+	for k, v := range f.Params {
+		params[k] = v
+	}
+	return render(lookup(template, lang, f.Rule), params)
+}
+
+func lookup(translations map[Lang]string, lang Lang, fallback string) string {
+	if message, ok := translations[lang]; ok {
+		return message
+	}
+	if message, ok := translations[DefaultLang]; ok {
+		return message
+	}
+	return fallback
+}
+
+// render 使用 params 替换 {name} 占位符
+func render(template string, params map[string]any) string {
+	if len(params) == 0 || !strings.Contains(template, "{") {
+		return template
+	}
+	pairs := make([]string, 0, len(params)*2)
+	for k, v := range params {
+		pairs = append(pairs, "{"+k+"}", fmt.Sprint(v))
+	}
+	return strings.NewReplacer(pairs...).Replace(template)
+}
@@ -0,0 +1,73 @@
+package apikey
+
+// apikey 生成与解析供系统集成使用的 API 密钥
+//
+// 密钥格式为 ams_<8 位标识>_<32 位密文>，前 12 个字符（ams_ 加标识）明文保存用于定位与展示，
+// 完整密钥只保存 SHA-256 哈希，创建后无法再次查看
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Marker 所有 API 密钥的前缀，用于与 JWT 区分
+const Marker = "ams_"
+
+const (
+	idLength     = 8
+	secretLength = 32
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Generate 生成新的 API 密钥，返回完整密钥、用于查找的前缀与需要保存的哈希
+func Generate() (key, prefix, hash string, err error) {
+	id, err := randomString(idLength)
+	if err != nil {
+		return "", "", "", err
+	}
+	secret, err := randomString(secretLength)
+	if err != nil {
+		return "", "", "", err
+	}
+	prefix = Marker + id
+	key = prefix + "_" + secret
+	return key, prefix, Hash(key), nil
+}
+
+// Looks 判断凭证是否为 API 密钥格式
+func Looks(credential string) bool {
+	return strings.HasPrefix(credential, Marker)
+}
+
+// Parse 返回 API 密钥的前缀，格式不正确时返回 false
+func Parse(key string) (string, bool) {
+	if !Looks(key) || len(key) != len(Marker)+idLength+1+secretLength || key[len(Marker)+idLength] != '_' {
+		return "", false
+	}
+	return key[:len(Marker)+idLength], true
+}
+
+// Hash 计算 API 密钥的 SHA-256 哈希
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify 以常量时间比较密钥与保存的哈希
+func Verify(key, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(Hash(key)), []byte(hash)) == 1
+}
+
+func randomString(n int) (string, error) {
+	raw := make([]byte, n*5/8)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("生成 API 密钥失败: %w", err)
+	}
+	return strings.ToLower(encoding.EncodeToString(raw)), nil
+}
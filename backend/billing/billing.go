@@ -0,0 +1,146 @@
+package billing
+
+// billing 按费率卡计算广告投放的费用
+//
+// 费率卡按大厦等级与当地时段规定播放时长每秒的价格。投放在计费期内每个播放小时按预订的
+// PlayDuration 计费一次：金额 = PlayDuration × 该小时所在时段的每秒价格。
+// 播放小时为按 preview.Check 能够播放的当地整点小时（与播放预览、曝光估算的规则相同），
+// 使用当前的投放与广告设置。大厦等级在费率卡中没有单独费率时使用默认费率（等级为空）。
+// 夏令时切换的当天按实际经过的当地整点计费：重复的整点计两次，跳过的整点不计
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/dayparting"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/preview"
+)
+
+// Line 一栋大厦在计费期内的费用
+type Line struct {
+	BuildingID   uint   `json:"building_id"`
+	BuildingName string `json:"building_name"`
+	Tier         string `json:"tier"`
+	Timezone     string `json:"timezone"`
+	PlayDuration int64  `json:"play_duration"` // 预订的播放时长（秒）
+	Hours        int    `json:"hours"`         // 计费的播放小时数
+	Seconds      int64  `json:"seconds"`       // 各播放小时的播放时长之和
+	Amount       int64  `json:"amount"`        // 以货币的最小单位计
+}
+
+// NormalizeTier 返回等级的规范写法：去除首尾空白并转为小写
+func NormalizeTier(tier string) string {
+	return strings.ToLower(strings.TrimSpace(tier))
+}
+
+// RatesFor 返回费率卡中适用于 tier 的费率，没有单独费率时使用默认费率；都没有时返回 false
+func RatesFor(card *models.RateCard, tier string) ([]models.RateCardRate, bool) {
+	tier = NormalizeTier(tier)
+	var own, fallback []models.RateCardRate
+	for _, rate := range card.Rates {
+		switch rate.Tier {
+		case tier:
+			own = append(own, rate)
+		case "":
+			fallback = append(fallback, rate)
+		}
+	}
+	if len(own) > 0 {
+		return own, true
+	}
+	return fallback, len(fallback) > 0
+}
+
+// Charge 计算投放在 [start, end) 内的费用，rates 为大厦适用的费率，loc 为大厦所在时区
+func Charge(plan *dayparting.Plan, rates []models.RateCardRate, ad models.Advertisement, placement models.AdvertisementBuilding, loc *time.Location, start, end time.Time) Line {
+	line := Line{PlayDuration: placement.PlayDuration}
+	if placement.PlayDuration <= 0 {
+		return line
+	}
+	entry := preview.Entry{Placement: placement, Ad: ad}
+	for t := start; t.Before(end); t = nextHour(t, loc) {
+		if !preview.Check(plan, entry, t.In(loc)).Eligible {
+			continue
+		}
+		price, ok := priceAt(rates, t.In(loc).Hour()*60)
+		if !ok {
+			continue
+		}
+		line.Hours++
+		line.Seconds += placement.PlayDuration
+		line.Amount += placement.PlayDuration * price
+	}
+	return line
+}
+
+// nextHour 返回 t 之后的下一个当地整点。时区偏移以半小时变化时（例如 Australia/Lord_Howe 的夏令时），
+// 直接加一小时会落在半点上
+func nextHour(t time.Time, loc *time.Location) time.Time {
+	next := t.Add(time.Hour)
+	if minute := next.In(loc).Minute(); minute != 0 {
+		next = next.Add(time.Duration(60-minute) * time.Minute)
+	}
+	return next
+}
+
+// Overlap 同一等级中开始时间早于之前时段结束时间的费率，Index 与 Other 为费率在 rates 中的下标
+type Overlap struct {
+	Index int
+	Other int
+}
+
+// CheckBands 检查每个等级的时段按开始时间排序后是否首尾相接地覆盖 00:00 到 24:00。
+// rates 的等级需已规范化，时刻需已校验为整点且结束晚于开始。
+// 返回重叠的费率，以及有空隙或没有覆盖全天的等级（按等级排序）
+func CheckBands(rates []models.RateCardRate) (overlaps []Overlap, uncovered []string) {
+	// band 一条费率在一天中的分钟范围
+	type band struct {
+		index      int
+		start, end int
+	}
+	bands := make(map[string][]band)
+	for i, rate := range rates {
+		start, _ := dayparting.ParseClock(rate.Start)
+		end, _ := dayparting.ParseClock(rate.End)
+		bands[rate.Tier] = append(bands[rate.Tier], band{index: i, start: start, end: end})
+	}
+
+	tiers := make([]string, 0, len(bands))
+	for tier := range bands {
+		tiers = append(tiers, tier)
+	}
+	slices.Sort(tiers)
+	for _, tier := range tiers {
+		list := bands[tier]
+		slices.SortStableFunc(list, func(a, b band) int { return cmp.Compare(a.start, b.start) })
+		covered, gap := 0, false
+		for j, b := range list {
+			switch {
+			case b.start < covered:
+				overlaps = append(overlaps, Overlap{Index: b.index, Other: list[j-1].index})
+			case b.start > covered:
+				gap = true
+			}
+			covered = max(covered, b.end)
+		}
+		if gap || covered < 24*60 {
+			uncovered = append(uncovered, tier)
+		}
+	}
+	return overlaps, uncovered
+}
+
+// priceAt 返回当天第 minute 分钟所在时段的每秒价格
+func priceAt(rates []models.RateCardRate, minute int) (int64, bool) {
+	for _, rate := range rates {
+		start, _ := dayparting.ParseClock(rate.Start)
+		end, _ := dayparting.ParseClock(rate.End)
+		if minute >= start && minute < end {
+			return rate.PricePerSecond, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,132 @@
+package billing
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	_ "time/tzdata" // 测试环境可能没有时区数据库
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+)
+
+// dayRates 夜间 1、白天 3、晚间 2，覆盖全天
+var dayRates = []models.RateCardRate{
+	{Start: "00:00", End: "08:00", PricePerSecond: 1},
+	{Start: "08:00", End: "20:00", PricePerSecond: 3},
+	{Start: "20:00", End: "24:00", PricePerSecond: 2},
+}
+
+func TestCharge(t *testing.T) {
+	ad := models.Advertisement{Status: "active"}
+	placement := models.AdvertisementBuilding{PlayDuration: 10}
+	expires := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		zone      string
+		date      string
+		ad        models.Advertisement
+		duration  int64
+		wantHours int
+		want      int64
+	}{
+		{name: "UTC", zone: "UTC", date: "2026-06-01", wantHours: 24, want: 10 * (8*1 + 12*3 + 4*2)},
+		// 整点按当地时间计算，不是 UTC 的整点
+		{name: "半小时偏移", zone: "Asia/Kolkata", date: "2026-06-01", wantHours: 24, want: 10 * (8*1 + 12*3 + 4*2)},
+		{name: "45 分钟偏移", zone: "Asia/Kathmandu", date: "2026-06-01", wantHours: 24, want: 10 * (8*1 + 12*3 + 4*2)},
+		// 夏令时开始，02:00 被跳过
+		{name: "夏令时开始", zone: "America/New_York", date: "2026-03-08", wantHours: 23, want: 10 * (7*1 + 12*3 + 4*2)},
+		// 夏令时结束，01:00 出现两次
+		{name: "夏令时结束", zone: "America/New_York", date: "2026-11-01", wantHours: 25, want: 10 * (9*1 + 12*3 + 4*2)},
+		// 偏移从 +10:30 变为 +11:00，02:00 直接跳到 02:30，02:30 之后的整点是 03:00
+		{name: "半小时夏令时开始", zone: "Australia/Lord_Howe", date: "2026-10-04", wantHours: 23, want: 10 * (7*1 + 12*3 + 4*2)},
+		// 偏移从 +11:00 变为 +10:30，02:00 回到 01:30，每个整点只出现一次
+		{name: "半小时夏令时结束", zone: "Australia/Lord_Howe", date: "2026-04-05", wantHours: 24, want: 10 * (8*1 + 12*3 + 4*2)},
+		{name: "中午到期", zone: "UTC", date: "2026-06-01", ad: models.Advertisement{Status: "active", ExpiresAt: &expires}, wantHours: 12, want: 10 * (8*1 + 4*3)},
+		{name: "已停用", zone: "UTC", date: "2026-06-01", ad: models.Advertisement{Status: "inactive"}},
+		{name: "播放时长为 0", zone: "UTC", date: "2026-06-01", duration: -10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Fatal(err)
+			}
+			day, err := time.ParseInLocation(models.DateLayout, tt.date, loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			a := ad
+			if tt.ad.Status != "" {
+				a = tt.ad
+			}
+			p := placement
+			p.PlayDuration += tt.duration
+
+			line := Charge(nil, dayRates, a, p, loc, day, day.AddDate(0, 0, 1))
+			if line.Hours != tt.wantHours || line.Amount != tt.want || line.Seconds != int64(tt.wantHours)*p.PlayDuration {
+				t.Errorf("Charge = %d 小时 %d 秒 %d，期望 %d 小时 %d 秒 %d",
+					line.Hours, line.Seconds, line.Amount, tt.wantHours, int64(tt.wantHours)*p.PlayDuration, tt.want)
+			}
+		})
+	}
+}
+
+func TestRatesFor(t *testing.T) {
+	premium := models.RateCardRate{Tier: "premium", Start: "00:00", End: "24:00", PricePerSecond: 5}
+	fallback := models.RateCardRate{Start: "00:00", End: "24:00", PricePerSecond: 1}
+
+	tests := []struct {
+		name   string
+		rates  []models.RateCardRate
+		tier   string
+		want   []models.RateCardRate
+		wantOK bool
+	}{
+		{"单独费率", []models.RateCardRate{fallback, premium}, "premium", []models.RateCardRate{premium}, true},
+		{"等级不区分大小写与空白", []models.RateCardRate{fallback, premium}, " Premium ", []models.RateCardRate{premium}, true},
+		{"使用默认费率", []models.RateCardRate{fallback, premium}, "gold", []models.RateCardRate{fallback}, true},
+		{"大厦没有等级", []models.RateCardRate{fallback, premium}, "", []models.RateCardRate{fallback}, true},
+		{"没有默认费率", []models.RateCardRate{premium}, "gold", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := RatesFor(&models.RateCard{Rates: tt.rates}, tt.tier)
+			if ok != tt.wantOK || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RatesFor(%q) = %v, %v，期望 %v, %v", tt.tier, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCheckBands(t *testing.T) {
+	rate := func(tier, start, end string) models.RateCardRate {
+		return models.RateCardRate{Tier: tier, Start: start, End: end}
+	}
+
+	tests := []struct {
+		name          string
+		rates         []models.RateCardRate
+		wantOverlaps  []Overlap
+		wantUncovered []string
+	}{
+		{name: "全天一段", rates: []models.RateCardRate{rate("", "00:00", "24:00")}},
+		{name: "乱序但首尾相接", rates: []models.RateCardRate{rate("", "20:00", "24:00"), rate("", "00:00", "08:00"), rate("", "08:00", "20:00")}},
+		{name: "多个等级各自覆盖全天", rates: []models.RateCardRate{rate("", "00:00", "24:00"), rate("premium", "00:00", "12:00"), rate("premium", "12:00", "24:00")}},
+		{name: "中间有空隙", rates: []models.RateCardRate{rate("", "00:00", "08:00"), rate("", "09:00", "24:00")}, wantUncovered: []string{""}},
+		{name: "没有从 00:00 开始", rates: []models.RateCardRate{rate("", "01:00", "24:00")}, wantUncovered: []string{""}},
+		{name: "没有到 24:00", rates: []models.RateCardRate{rate("", "00:00", "23:00")}, wantUncovered: []string{""}},
+		{name: "重叠", rates: []models.RateCardRate{rate("", "00:00", "12:00"), rate("", "11:00", "24:00")}, wantOverlaps: []Overlap{{Index: 1, Other: 0}}},
+		// 被完全包含的时段同样算重叠，之后的时段与覆盖到的最晚时刻比较
+		{name: "包含", rates: []models.RateCardRate{rate("", "00:00", "24:00"), rate("", "06:00", "08:00"), rate("", "08:00", "10:00")}, wantOverlaps: []Overlap{{Index: 1, Other: 0}, {Index: 2, Other: 1}}},
+		{name: "只检查同一等级", rates: []models.RateCardRate{rate("", "00:00", "24:00"), rate("premium", "00:00", "12:00"), rate("gold", "06:00", "24:00")}, wantUncovered: []string{"gold", "premium"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overlaps, uncovered := CheckBands(tt.rates)
+			if !reflect.DeepEqual(overlaps, tt.wantOverlaps) || !reflect.DeepEqual(uncovered, tt.wantUncovered) {
+				t.Errorf("CheckBands = %v, %q，期望 %v, %q", overlaps, uncovered, tt.wantOverlaps, tt.wantUncovered)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// BearerToken 返回为请求添加 Authorization: Bearer 请求头的 RequestEditorFn
+func BearerToken(token string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
+
+// APIKeyHeader 返回为请求添加 X-API-Key 请求头的 RequestEditorFn，用于系统集成
+func APIKeyHeader(key string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-API-Key", key)
+		return nil
+	}
+}
@@ -0,0 +1,17717 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.3.0 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for APIKeyScope.
+const (
+	AdsRead         APIKeyScope = "ads:read"
+	AdsWrite        APIKeyScope = "ads:write"
+	BillingRead     APIKeyScope = "billing:read"
+	BillingWrite    APIKeyScope = "billing:write"
+	BuildingsRead   APIKeyScope = "buildings:read"
+	BuildingsWrite  APIKeyScope = "buildings:write"
+	ExportsRead     APIKeyScope = "exports:read"
+	LayoutsRead     APIKeyScope = "layouts:read"
+	LayoutsWrite    APIKeyScope = "layouts:write"
+	NoticesRead     APIKeyScope = "notices:read"
+	NoticesWrite    APIKeyScope = "notices:write"
+	PlacementsRead  APIKeyScope = "placements:read"
+	PlacementsWrite APIKeyScope = "placements:write"
+	SchedulesRead   APIKeyScope = "schedules:read"
+	SchedulesWrite  APIKeyScope = "schedules:write"
+	UploadsWrite    APIKeyScope = "uploads:write"
+)
+
+// Defines values for AdministratorRole.
+const (
+	Admin      AdministratorRole = "admin"
+	SuperAdmin AdministratorRole = "super_admin"
+)
+
+// Defines values for AdvertisementStatus.
+const (
+	AdvertisementStatusActive   AdvertisementStatus = "active"
+	AdvertisementStatusInactive AdvertisementStatus = "inactive"
+)
+
+// Defines values for AdvertisementInputStatus.
+const (
+	AdvertisementInputStatusActive   AdvertisementInputStatus = "active"
+	AdvertisementInputStatusInactive AdvertisementInputStatus = "inactive"
+)
+
+// Defines values for BuildingLayoutZonesKind.
+const (
+	BuildingLayoutZonesKindMedia  BuildingLayoutZonesKind = "media"
+	BuildingLayoutZonesKindTicker BuildingLayoutZonesKind = "ticker"
+	BuildingLayoutZonesKindWidget BuildingLayoutZonesKind = "widget"
+)
+
+// Defines values for BuildingLayoutZonesWidget.
+const (
+	BuildingLayoutZonesWidgetClock   BuildingLayoutZonesWidget = "clock"
+	BuildingLayoutZonesWidgetDate    BuildingLayoutZonesWidget = "date"
+	BuildingLayoutZonesWidgetWeather BuildingLayoutZonesWidget = "weather"
+)
+
+// Defines values for ExclusivityConflictKind.
+const (
+	ExclusivityConflictKindLimit   ExclusivityConflictKind = "limit"
+	ExclusivityConflictKindSponsor ExclusivityConflictKind = "sponsor"
+)
+
+// Defines values for ExclusivityRuleKind.
+const (
+	ExclusivityRuleKindLimit   ExclusivityRuleKind = "limit"
+	ExclusivityRuleKindSponsor ExclusivityRuleKind = "sponsor"
+)
+
+// Defines values for ExclusivityRuleInputKind.
+const (
+	Limit   ExclusivityRuleInputKind = "limit"
+	Sponsor ExclusivityRuleInputKind = "sponsor"
+)
+
+// Defines values for HealthStatusChecks.
+const (
+	HealthStatusChecksOk          HealthStatusChecks = "ok"
+	HealthStatusChecksUnavailable HealthStatusChecks = "unavailable"
+)
+
+// Defines values for HealthStatusStatus.
+const (
+	HealthStatusStatusDraining    HealthStatusStatus = "draining"
+	HealthStatusStatusOk          HealthStatusStatus = "ok"
+	HealthStatusStatusUnavailable HealthStatusStatus = "unavailable"
+)
+
+// Defines values for InvoiceStatus.
+const (
+	Issued InvoiceStatus = "issued"
+	Void   InvoiceStatus = "void"
+)
+
+// Defines values for LayoutZoneKind.
+const (
+	LayoutZoneKindMedia  LayoutZoneKind = "media"
+	LayoutZoneKindTicker LayoutZoneKind = "ticker"
+	LayoutZoneKindWidget LayoutZoneKind = "widget"
+)
+
+// Defines values for LayoutZoneWidget.
+const (
+	LayoutZoneWidgetClock   LayoutZoneWidget = "clock"
+	LayoutZoneWidgetDate    LayoutZoneWidget = "date"
+	LayoutZoneWidgetWeather LayoutZoneWidget = "weather"
+)
+
+// Defines values for ManifestItemAssetsType.
+const (
+	Image ManifestItemAssetsType = "image"
+	Video ManifestItemAssetsType = "video"
+)
+
+// Defines values for ManifestPublicKeyAlgorithm.
+const (
+	Ed25519 ManifestPublicKeyAlgorithm = "Ed25519"
+)
+
+// Defines values for PlacementEligibilityReason.
+const (
+	PlacementEligibilityReasonDaypartMissing   PlacementEligibilityReason = "daypart_missing"
+	PlacementEligibilityReasonEligible         PlacementEligibilityReason = "eligible"
+	PlacementEligibilityReasonExcludedCalendar PlacementEligibilityReason = "excluded_calendar"
+	PlacementEligibilityReasonExpired          PlacementEligibilityReason = "expired"
+	PlacementEligibilityReasonInactive         PlacementEligibilityReason = "inactive"
+	PlacementEligibilityReasonNoDuration       PlacementEligibilityReason = "no_duration"
+	PlacementEligibilityReasonNotInCalendar    PlacementEligibilityReason = "not_in_calendar"
+	PlacementEligibilityReasonNotLive          PlacementEligibilityReason = "not_live"
+	PlacementEligibilityReasonOutsideWindow    PlacementEligibilityReason = "outside_window"
+)
+
+// Defines values for PreviewExclusionReason.
+const (
+	PreviewExclusionReasonCapacity         PreviewExclusionReason = "capacity"
+	PreviewExclusionReasonDaypartMissing   PreviewExclusionReason = "daypart_missing"
+	PreviewExclusionReasonExcludedCalendar PreviewExclusionReason = "excluded_calendar"
+	PreviewExclusionReasonExpired          PreviewExclusionReason = "expired"
+	PreviewExclusionReasonInactive         PreviewExclusionReason = "inactive"
+	PreviewExclusionReasonNoDuration       PreviewExclusionReason = "no_duration"
+	PreviewExclusionReasonNotInCalendar    PreviewExclusionReason = "not_in_calendar"
+	PreviewExclusionReasonNotLive          PreviewExclusionReason = "not_live"
+	PreviewExclusionReasonOutsideWindow    PreviewExclusionReason = "outside_window"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+	Succeeded WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for WebhookEventType.
+const (
+	AdActivated      WebhookEventType = "ad.activated"
+	AdCreated        WebhookEventType = "ad.created"
+	AdDeleted        WebhookEventType = "ad.deleted"
+	AdExpired        WebhookEventType = "ad.expired"
+	AdUpdated        WebhookEventType = "ad.updated"
+	PlacementCreated WebhookEventType = "placement.created"
+	PlacementDeleted WebhookEventType = "placement.deleted"
+	PlacementUpdated WebhookEventType = "placement.updated"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCsv  ExportFormat = "csv"
+	ExportFormatXlsx ExportFormat = "xlsx"
+)
+
+// Defines values for ExportAdsParamsFormat.
+const (
+	ExportAdsParamsFormatCsv  ExportAdsParamsFormat = "csv"
+	ExportAdsParamsFormatXlsx ExportAdsParamsFormat = "xlsx"
+)
+
+// Defines values for ExportBuildingsParamsFormat.
+const (
+	ExportBuildingsParamsFormatCsv  ExportBuildingsParamsFormat = "csv"
+	ExportBuildingsParamsFormatXlsx ExportBuildingsParamsFormat = "xlsx"
+)
+
+// Defines values for ExportInvoiceParamsFormat.
+const (
+	ExportInvoiceParamsFormatCsv  ExportInvoiceParamsFormat = "csv"
+	ExportInvoiceParamsFormatPdf  ExportInvoiceParamsFormat = "pdf"
+	ExportInvoiceParamsFormatXlsx ExportInvoiceParamsFormat = "xlsx"
+)
+
+// Defines values for ExportPlacementsParamsFormat.
+const (
+	Csv  ExportPlacementsParamsFormat = "csv"
+	Xlsx ExportPlacementsParamsFormat = "xlsx"
+)
+
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt   time.Time  `json:"created_at"`
+	CreatedById uint       `json:"created_by_id"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	Id          uint       `json:"id"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	LastUsedIp  *string    `json:"last_used_ip,omitempty"`
+	Name        string     `json:"name"`
+
+	// Prefix 密钥的前 12 个字符，用于识别
+	Prefix    string        `json:"prefix"`
+	RevokedAt *time.Time    `json:"revoked_at"`
+	Scopes    []APIKeyScope `json:"scopes"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// APIKeyCreated defines model for APIKeyCreated.
+type APIKeyCreated struct {
+	ApiKey APIKey `json:"api_key"`
+
+	// Key 完整的 API 密钥，只返回这一次
+	Key     string `json:"key"`
+	Message string `json:"message"`
+}
+
+// APIKeyInput defines model for APIKeyInput.
+type APIKeyInput struct {
+	// ExpiresAt 过期时间，必须是将来的时间，为空表示永不过期
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+	Name      string        `json:"name"`
+	Scopes    []APIKeyScope `json:"scopes"`
+}
+
+// APIKeyPage defines model for APIKeyPage.
+type APIKeyPage struct {
+	Data     []APIKey `json:"data"`
+	PageNum  int      `json:"pageNum"`
+	PageSize int      `json:"pageSize"`
+	Total    int64    `json:"total"`
+}
+
+// APIKeyScope defines model for APIKeyScope.
+type APIKeyScope string
+
+// ActiveNoticeList defines model for ActiveNoticeList.
+type ActiveNoticeList struct {
+	// Locale 大厦使用的语言
+	Locale  string   `json:"locale"`
+	Notices []Notice `json:"notices"`
+
+	// Timezone 大厦所在时区
+	Timezone string `json:"timezone"`
+}
+
+// AdImpressionDay defines model for AdImpressionDay.
+type AdImpressionDay struct {
+	Date        openapi_types.Date `json:"date"`
+	Impressions int64              `json:"impressions"`
+}
+
+// AdImpressionReport defines model for AdImpressionReport.
+type AdImpressionReport struct {
+	AdvertisementId uint                  `json:"advertisement_id"`
+	Buildings       []BuildingImpressions `json:"buildings"`
+	From            openapi_types.Date    `json:"from"`
+	Impressions     int64                 `json:"impressions"`
+	Title           string                `json:"title"`
+	To              openapi_types.Date    `json:"to"`
+}
+
+// AdImpressions defines model for AdImpressions.
+type AdImpressions struct {
+	AdvertisementId uint              `json:"advertisement_id"`
+	Days            []AdImpressionDay `json:"days"`
+	Impressions     int64             `json:"impressions"`
+	Title           string            `json:"title"`
+}
+
+// Administrator defines model for Administrator.
+type Administrator struct {
+	CreatedAt time.Time  `json:"CreatedAt"`
+	DeletedAt *time.Time `json:"DeletedAt"`
+	ID        uint       `json:"ID"`
+	UpdatedAt time.Time  `json:"UpdatedAt"`
+
+	// FailedLoginAttempts 当前连续登录失败次数
+	FailedLoginAttempts *int       `json:"failed_login_attempts,omitempty"`
+	LastLoginAt         *time.Time `json:"last_login_at"`
+
+	// LockedUntil 账号锁定截止时间
+	LockedUntil *time.Time `json:"locked_until"`
+
+	// PasswordChangedAt 早于该时间签发的令牌已失效
+	PasswordChangedAt *time.Time         `json:"password_changed_at"`
+	Role              *AdministratorRole `json:"role,omitempty"`
+	TotpEnabled       *bool              `json:"totp_enabled,omitempty"`
+	Username          *string            `json:"username,omitempty"`
+}
+
+// AdministratorRole defines model for Administrator.Role.
+type AdministratorRole string
+
+// AdministratorPage defines model for AdministratorPage.
+type AdministratorPage struct {
+	Data     []Administrator `json:"data"`
+	PageNum  int             `json:"pageNum"`
+	PageSize int             `json:"pageSize"`
+	Total    int64           `json:"total"`
+}
+
+// Advertisement defines model for Advertisement.
+type Advertisement struct {
+	CreatedAt time.Time  `json:"CreatedAt"`
+	DeletedAt *time.Time `json:"DeletedAt"`
+	ID        uint       `json:"ID"`
+	UpdatedAt time.Time  `json:"UpdatedAt"`
+
+	// ActivatedAt 后台任务按 go_live_at 上线的时间，修改 go_live_at 时重置
+	ActivatedAt             *time.Time               `json:"activated_at"`
+	AdvertisementsBuildings *[]AdvertisementBuilding `json:"advertisements_buildings"`
+
+	// Category 广告主类别，例如 banking，不区分大小写，用于大厦的排他规则；为空表示不限制。更新时省略表示不修改，空字符串表示清除
+	Category    *string `json:"category,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// ExpiredAt 后台任务按 expires_at 到期的时间，修改 expires_at 时重置
+	ExpiredAt *time.Time `json:"expired_at"`
+
+	// ExpiresAt 到期时间，必须是将来的时间且晚于 go_live_at，到达时后台任务将状态改为 inactive 并推送 ad.expired。更新时省略表示不修改，空字符串表示清除
+	ExpiresAt *time.Time `json:"expires_at"`
+
+	// GoLiveAt 上线时间，到达时后台任务将状态改为 active 并推送 ad.activated。
+	// 晚于当前时间时广告在上线前为 inactive；不晚于当前时间时视为已经上线，状态以 status 为准。
+	// 更新时省略表示不修改，空字符串表示清除
+	GoLiveAt *time.Time `json:"go_live_at"`
+
+	// ImageSha256 图片的 SHA-256，播放端据此校验缓存；更换 image_url 而不提供新值时清空
+	ImageSha256 *string `json:"image_sha256,omitempty"`
+
+	// ImageSize 图片字节数，0 表示未登记
+	ImageSize *int64               `json:"image_size,omitempty"`
+	ImageUrl  *string              `json:"image_url,omitempty"`
+	Status    *AdvertisementStatus `json:"status,omitempty"`
+	Title     *string              `json:"title,omitempty"`
+
+	// VideoDuration 视频时长（秒）
+	VideoDuration *int64 `json:"video_duration,omitempty"`
+
+	// VideoSha256 视频的 SHA-256；更换 video_url 而不提供新值时清空
+	VideoSha256 *string `json:"video_sha256,omitempty"`
+
+	// VideoSize 视频字节数，0 表示未登记
+	VideoSize *int64  `json:"video_size,omitempty"`
+	VideoUrl  *string `json:"video_url,omitempty"`
+}
+
+// AdvertisementStatus defines model for Advertisement.Status.
+type AdvertisementStatus string
+
+// AdvertisementBuilding 广告在大厦的投放记录；Advertisement 与 Building 仅在预加载时有值
+type AdvertisementBuilding struct {
+	Advertisement   *Advertisement `json:"Advertisement,omitempty"`
+	Building        *Building      `json:"Building,omitempty"`
+	AdvertisementId uint           `json:"advertisement_id"`
+	BuildingId      uint           `json:"building_id"`
+
+	// DaypartId 分时规则，为空表示全天播放
+	DaypartId *uint `json:"daypart_id"`
+
+	// PlayDuration 播放时长（秒）
+	PlayDuration int64 `json:"play_duration"`
+
+	// Zone 布局中的区域名称，为空表示默认媒体区域
+	Zone *string `json:"zone,omitempty"`
+}
+
+// AdvertisementIDs defines model for AdvertisementIDs.
+type AdvertisementIDs struct {
+	AdvertisementIds []uint `json:"advertisement_ids"`
+}
+
+// AdvertisementInput defines model for AdvertisementInput.
+type AdvertisementInput struct {
+	// Category 广告主类别，例如 banking，不区分大小写，用于大厦的排他规则；为空表示不限制。更新时省略表示不修改，空字符串表示清除
+	Category    *string `json:"category,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// ExpiresAt 到期时间，必须是将来的时间且晚于 go_live_at，到达时后台任务将状态改为 inactive 并推送 ad.expired。更新时省略表示不修改，空字符串表示清除
+	ExpiresAt *time.Time `json:"expires_at"`
+
+	// GoLiveAt 上线时间，到达时后台任务将状态改为 active 并推送 ad.activated。
+	// 晚于当前时间时广告在上线前为 inactive；不晚于当前时间时视为已经上线，状态以 status 为准。
+	// 更新时省略表示不修改，空字符串表示清除
+	GoLiveAt *time.Time `json:"go_live_at"`
+
+	// ImageSha256 图片的 SHA-256，播放端据此校验缓存；更换 image_url 而不提供新值时清空
+	ImageSha256 *string `json:"image_sha256,omitempty"`
+
+	// ImageSize 图片字节数，0 表示未登记
+	ImageSize *int64                    `json:"image_size,omitempty"`
+	ImageUrl  *string                   `json:"image_url,omitempty"`
+	Status    *AdvertisementInputStatus `json:"status,omitempty"`
+	Title     *string                   `json:"title,omitempty"`
+
+	// VideoDuration 视频时长（秒）
+	VideoDuration *int64 `json:"video_duration,omitempty"`
+
+	// VideoSha256 视频的 SHA-256；更换 video_url 而不提供新值时清空
+	VideoSha256 *string `json:"video_sha256,omitempty"`
+
+	// VideoSize 视频字节数，0 表示未登记
+	VideoSize *int64  `json:"video_size,omitempty"`
+	VideoUrl  *string `json:"video_url,omitempty"`
+}
+
+// AdvertisementInputStatus defines model for AdvertisementInput.Status.
+type AdvertisementInputStatus string
+
+// AdvertisementList defines model for AdvertisementList.
+type AdvertisementList struct {
+	Advertisements []Advertisement `json:"advertisements"`
+}
+
+// AdvertisementPage defines model for AdvertisementPage.
+type AdvertisementPage struct {
+	Data     []Advertisement `json:"data"`
+	PageNum  int             `json:"pageNum"`
+	PageSize int             `json:"pageSize"`
+	Total    int64           `json:"total"`
+}
+
+// AssignDaypartInput defines model for AssignDaypartInput.
+type AssignDaypartInput struct {
+	// DaypartId 为 null 时全天播放
+	DaypartId *uint `json:"daypart_id"`
+}
+
+// AssignLayoutInput defines model for AssignLayoutInput.
+type AssignLayoutInput struct {
+	// LayoutId 为 null 时恢复全屏播放
+	LayoutId *uint `json:"layout_id"`
+}
+
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// Action 操作类型，例如 admin.password_reset
+	Action         string    `json:"action"`
+	ActorId        uint      `json:"actor_id"`
+	ActorUsername  string    `json:"actor_username"`
+	ClientIp       *string   `json:"client_ip,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	Id             uint      `json:"id"`
+	RequestId      *string   `json:"request_id,omitempty"`
+	TargetId       *uint     `json:"target_id,omitempty"`
+	TargetUsername *string   `json:"target_username,omitempty"`
+}
+
+// AuditLogPage defines model for AuditLogPage.
+type AuditLogPage struct {
+	Data     []AuditLog `json:"data"`
+	PageNum  int        `json:"pageNum"`
+	PageSize int        `json:"pageSize"`
+	Total    int64      `json:"total"`
+}
+
+// Building defines model for Building.
+type Building struct {
+	CreatedAt               time.Time                `json:"CreatedAt"`
+	DeletedAt               *time.Time               `json:"DeletedAt"`
+	ID                      uint                     `json:"ID"`
+	UpdatedAt               time.Time                `json:"UpdatedAt"`
+	Address                 *string                  `json:"address,omitempty"`
+	AdvertisementsBuildings *[]AdvertisementBuilding `json:"advertisements_buildings"`
+	BlgId                   *string                  `json:"blg_id,omitempty"`
+
+	// LayoutId 屏幕布局，为空表示全屏播放
+	LayoutId *uint `json:"layout_id"`
+
+	// Locale BCP 47 语言标签，为空时使用 DEFAULT_LOCALE
+	Locale *string `json:"locale,omitempty"`
+	Name   *string `json:"name,omitempty"`
+
+	// Tier 计费等级，为空时按费率卡的默认费率计费
+	Tier *string `json:"tier,omitempty"`
+
+	// Timezone IANA 时区，为空时使用 DEFAULT_TIMEZONE
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// BuildingEligibility defines model for BuildingEligibility.
+type BuildingEligibility struct {
+	// At 判断时刻，使用大厦所在时区的偏移
+	At         time.Time              `json:"at"`
+	BuildingId uint                   `json:"building_id"`
+	Data       []PlacementEligibility `json:"data"`
+	Locale     string                 `json:"locale"`
+	Timezone   string                 `json:"timezone"`
+}
+
+// BuildingIDs defines model for BuildingIDs.
+type BuildingIDs struct {
+	BuildingIds []uint `json:"building_ids"`
+}
+
+// BuildingImportReport defines model for BuildingImportReport.
+type BuildingImportReport struct {
+	Committed bool                `json:"committed"`
+	DryRun    bool                `json:"dry_run"`
+	Invalid   int                 `json:"invalid"`
+	Rows      []BuildingImportRow `json:"rows"`
+	Total     int                 `json:"total"`
+	Valid     int                 `json:"valid"`
+}
+
+// BuildingImportRow defines model for BuildingImportRow.
+type BuildingImportRow struct {
+	Address          string        `json:"address"`
+	AdvertisementIds *[]uint       `json:"advertisement_ids"`
+	BlgId            string        `json:"blg_id"`
+	Errors           *[]FieldError `json:"errors,omitempty"`
+	Locale           *string       `json:"locale,omitempty"`
+	Name             string        `json:"name"`
+
+	// Row 文件中的行号，从 1 开始，包含表头
+	Row      int     `json:"row"`
+	Tier     *string `json:"tier,omitempty"`
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// BuildingImpressionReport defines model for BuildingImpressionReport.
+type BuildingImpressionReport struct {
+	Ads         []AdImpressions    `json:"ads"`
+	BuildingId  uint               `json:"building_id"`
+	Days        []ImpressionDay    `json:"days"`
+	From        openapi_types.Date `json:"from"`
+	Impressions int64              `json:"impressions"`
+	Timezone    string             `json:"timezone"`
+	To          openapi_types.Date `json:"to"`
+	Visitors    int64              `json:"visitors"`
+}
+
+// BuildingImpressions defines model for BuildingImpressions.
+type BuildingImpressions struct {
+	BuildingId  uint            `json:"building_id"`
+	Days        []ImpressionDay `json:"days"`
+	Impressions int64           `json:"impressions"`
+	Name        string          `json:"name"`
+	Timezone    string          `json:"timezone"`
+}
+
+// BuildingLayout defines model for BuildingLayout.
+type BuildingLayout struct {
+	BuildingId uint `json:"building_id"`
+
+	// GeneratedAt 生成时刻，使用大厦所在时区的偏移
+	GeneratedAt time.Time `json:"generated_at"`
+	Height      int       `json:"height"`
+	LayoutId    *uint     `json:"layout_id"`
+
+	// Locale 大厦使用的语言
+	Locale string `json:"locale"`
+	Name   string `json:"name"`
+
+	// Timezone 大厦所在时区
+	Timezone string `json:"timezone"`
+	Width    int    `json:"width"`
+	Zones    []struct {
+		Height int        `json:"height"`
+		Items  []ZoneItem `json:"items"`
+
+		// Kind media 播放图片或视频，ticker 滚动播放标题与描述，widget 由播放端渲染且不投放广告
+		Kind BuildingLayoutZonesKind `json:"kind"`
+		Name string                  `json:"name"`
+
+		// Widget 仅组件区域使用
+		Widget *BuildingLayoutZonesWidget `json:"widget,omitempty"`
+		Width  int                        `json:"width"`
+		X      int                        `json:"x"`
+		Y      int                        `json:"y"`
+	} `json:"zones"`
+}
+
+// BuildingLayoutZonesKind media 播放图片或视频，ticker 滚动播放标题与描述，widget 由播放端渲染且不投放广告
+type BuildingLayoutZonesKind string
+
+// BuildingLayoutZonesWidget 仅组件区域使用
+type BuildingLayoutZonesWidget string
+
+// BuildingList defines model for BuildingList.
+type BuildingList struct {
+	Buildings []Building `json:"buildings"`
+}
+
+// BuildingPage defines model for BuildingPage.
+type BuildingPage struct {
+	Data     []Building `json:"data"`
+	PageNum  int        `json:"pageNum"`
+	PageSize int        `json:"pageSize"`
+	Total    int64      `json:"total"`
+}
+
+// BuildingPreview defines model for BuildingPreview.
+type BuildingPreview struct {
+	BuildingId uint               `json:"building_id"`
+	Exclusions []PreviewExclusion `json:"exclusions"`
+	From       time.Time          `json:"from"`
+	Locale     string             `json:"locale"`
+
+	// LoopCapacity 一轮播放的最长时长（秒），0 表示不限制
+	LoopCapacity int64          `json:"loop_capacity"`
+	Slots        []PreviewSlot  `json:"slots"`
+	Timezone     string         `json:"timezone"`
+	To           time.Time      `json:"to"`
+	Totals       []PreviewTotal `json:"totals"`
+	Truncated    bool           `json:"truncated"`
+}
+
+// Calendar defines model for Calendar.
+type Calendar struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+	Id          uint      `json:"id"`
+	Name        string    `json:"name"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CalendarEvent defines model for CalendarEvent.
+type CalendarEvent struct {
+	CalendarId uint      `json:"calendar_id"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	// EndDate 不包含在内
+	EndDate openapi_types.Date `json:"end_date"`
+	Id      uint               `json:"id"`
+
+	// Rrule 为空表示不重复
+	Rrule     string             `json:"rrule"`
+	StartDate openapi_types.Date `json:"start_date"`
+	Summary   string             `json:"summary"`
+
+	// Uid iCalendar 的 UID，手工添加的事件为空
+	Uid string `json:"uid"`
+}
+
+// CalendarEventInput defines model for CalendarEventInput.
+type CalendarEventInput struct {
+	// EndDate 不包含在内，为空表示只有 start_date 一天
+	EndDate   *openapi_types.Date `json:"end_date,omitempty"`
+	Rrule     *string             `json:"rrule,omitempty"`
+	StartDate openapi_types.Date  `json:"start_date"`
+	Summary   string              `json:"summary"`
+}
+
+// CalendarEventList defines model for CalendarEventList.
+type CalendarEventList struct {
+	Data []CalendarEvent `json:"data"`
+}
+
+// CalendarImportReport defines model for CalendarImportReport.
+type CalendarImportReport struct {
+	CalendarId uint `json:"calendar_id"`
+	Imported   int  `json:"imported"`
+	Skipped    []struct {
+		Reason  string `json:"reason"`
+		Summary string `json:"summary"`
+		Uid     string `json:"uid"`
+	} `json:"skipped"`
+}
+
+// CalendarInput defines model for CalendarInput.
+type CalendarInput struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+}
+
+// CalendarPage defines model for CalendarPage.
+type CalendarPage struct {
+	Data     []Calendar `json:"data"`
+	PageNum  int        `json:"pageNum"`
+	PageSize int        `json:"pageSize"`
+	Total    int64      `json:"total"`
+}
+
+// ChargeLine defines model for ChargeLine.
+type ChargeLine struct {
+	// Amount 以货币的最小单位计
+	Amount       int64  `json:"amount"`
+	BuildingId   uint   `json:"building_id"`
+	BuildingName string `json:"building_name"`
+
+	// Hours 计费的播放小时数
+	Hours int `json:"hours"`
+
+	// PlayDuration 预订的播放时长（秒）
+	PlayDuration int64 `json:"play_duration"`
+
+	// Seconds 各播放小时的播放时长之和
+	Seconds  int64  `json:"seconds"`
+	Tier     string `json:"tier"`
+	Timezone string `json:"timezone"`
+}
+
+// Charges defines model for Charges.
+type Charges struct {
+	AdvertisementId uint               `json:"advertisement_id"`
+	Currency        string             `json:"currency"`
+	From            openapi_types.Date `json:"from"`
+	Lines           []ChargeLine       `json:"lines"`
+	RateCardId      uint               `json:"rate_card_id"`
+	Seconds         int64              `json:"seconds"`
+	Title           string             `json:"title"`
+	To              openapi_types.Date `json:"to"`
+	Total           int64              `json:"total"`
+}
+
+// CreateBuildingInput defines model for CreateBuildingInput.
+type CreateBuildingInput struct {
+	Address          *string `json:"address,omitempty"`
+	AdvertisementIds *[]uint `json:"advertisement_ids,omitempty"`
+	BlgId            *string `json:"blg_id,omitempty"`
+
+	// Locale BCP 47 语言标签，例如 zh-CN，保存为规范写法，为空时使用 DEFAULT_LOCALE
+	Locale *string `json:"locale,omitempty"`
+	Name   string  `json:"name"`
+
+	// Tier 计费等级，保存为小写，为空时按费率卡的默认费率计费
+	Tier *string `json:"tier,omitempty"`
+
+	// Timezone IANA 时区，例如 Asia/Shanghai，为空时使用 DEFAULT_TIMEZONE
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// Credentials defines model for Credentials.
+type Credentials struct {
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+// Daypart defines model for Daypart.
+type Daypart struct {
+	CreatedAt          time.Time       `json:"created_at"`
+	Description        string          `json:"description"`
+	ExcludeCalendarIds []uint          `json:"exclude_calendar_ids"`
+	Id                 uint            `json:"id"`
+	IncludeCalendarIds []uint          `json:"include_calendar_ids"`
+	Name               string          `json:"name"`
+	UpdatedAt          time.Time       `json:"updated_at"`
+	Windows            []DaypartWindow `json:"windows"`
+}
+
+// DaypartInput defines model for DaypartInput.
+type DaypartInput struct {
+	Description        *string         `json:"description,omitempty"`
+	ExcludeCalendarIds *[]uint         `json:"exclude_calendar_ids,omitempty"`
+	IncludeCalendarIds *[]uint         `json:"include_calendar_ids,omitempty"`
+	Name               string          `json:"name"`
+	Windows            []DaypartWindow `json:"windows"`
+}
+
+// DaypartPage defines model for DaypartPage.
+type DaypartPage struct {
+	Data     []Daypart `json:"data"`
+	PageNum  int       `json:"pageNum"`
+	PageSize int       `json:"pageSize"`
+	Total    int64     `json:"total"`
+}
+
+// DaypartWindow defines model for DaypartWindow.
+type DaypartWindow struct {
+	// End 24:00 表示当天结束，跨零点的时段需拆成两个
+	End string `json:"end"`
+
+	// Rrule 为空表示每天
+	Rrule *string `json:"rrule,omitempty"`
+	Start string  `json:"start"`
+
+	// StartDate RRULE 的起始日期，INTERVAL、COUNT 需要
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+}
+
+// DisableTOTPInput defines model for DisableTOTPInput.
+type DisableTOTPInput struct {
+	// Code 验证器应用中的 6 位验证码
+	Code     *string `json:"code,omitempty"`
+	Password string  `json:"password"`
+
+	// RecoveryCode 一次性恢复码
+	RecoveryCode *string `json:"recovery_code,omitempty"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Error ErrorBody `json:"error"`
+}
+
+// ErrorBody defines model for ErrorBody.
+type ErrorBody struct {
+	// Code 稳定的机器可读错误码，例如 ad_not_found、validation_failed
+	Code    string        `json:"code"`
+	Details *[]FieldError `json:"details,omitempty"`
+
+	// Message 按 Accept-Language 本地化的错误信息
+	Message   string                  `json:"message"`
+	Meta      *map[string]interface{} `json:"meta,omitempty"`
+	RequestId *string                 `json:"request_id,omitempty"`
+}
+
+// ExclusivityConflict defines model for ExclusivityConflict.
+type ExclusivityConflict struct {
+	BuildingId uint                    `json:"building_id"`
+	Category   string                  `json:"category"`
+	Kind       ExclusivityConflictKind `json:"kind"`
+	MaxAds     int                     `json:"max_ads"`
+
+	// Placements limit 规则为该类别的全部投放，sponsor 规则为赞助广告以外的投放
+	Placements  []ExclusivityPlacement `json:"placements"`
+	RuleId      uint                   `json:"rule_id"`
+	SponsorAdId *uint                  `json:"sponsor_ad_id,omitempty"`
+}
+
+// ExclusivityConflictKind defines model for ExclusivityConflict.Kind.
+type ExclusivityConflictKind string
+
+// ExclusivityConflictError defines model for ExclusivityConflictError.
+type ExclusivityConflictError struct {
+	Error struct {
+		// Code 稳定的机器可读错误码，例如 ad_not_found、validation_failed
+		Code    string        `json:"code"`
+		Details *[]FieldError `json:"details,omitempty"`
+
+		// Message 按 Accept-Language 本地化的错误信息
+		Message string `json:"message"`
+		Meta    *struct {
+			Conflicts *[]ExclusivityConflict `json:"conflicts,omitempty"`
+		} `json:"meta,omitempty"`
+		RequestId *string `json:"request_id,omitempty"`
+	} `json:"error"`
+}
+
+// ExclusivityPlacement defines model for ExclusivityPlacement.
+type ExclusivityPlacement struct {
+	AdvertisementId uint `json:"advertisement_id"`
+	BuildingId      uint `json:"building_id"`
+
+	// Pending 本次请求新增的投放或修改了类别的广告
+	Pending bool   `json:"pending"`
+	Title   string `json:"title"`
+}
+
+// ExclusivityRule defines model for ExclusivityRule.
+type ExclusivityRule struct {
+	BuildingId uint                `json:"building_id"`
+	Category   string              `json:"category"`
+	CreatedAt  time.Time           `json:"created_at"`
+	Id         uint                `json:"id"`
+	Kind       ExclusivityRuleKind `json:"kind"`
+
+	// MaxAds sponsor 规则为 1
+	MaxAds      int       `json:"max_ads"`
+	SponsorAdId *uint     `json:"sponsor_ad_id"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ExclusivityRuleKind defines model for ExclusivityRule.Kind.
+type ExclusivityRuleKind string
+
+// ExclusivityRuleInput defines model for ExclusivityRuleInput.
+type ExclusivityRuleInput struct {
+	Category string                   `json:"category"`
+	Kind     ExclusivityRuleInputKind `json:"kind"`
+
+	// MaxAds limit 规则允许的广告数
+	MaxAds *int `json:"max_ads,omitempty"`
+
+	// SponsorAdId sponsor 规则的赞助广告，其类别必须与规则相同
+	SponsorAdId *uint `json:"sponsor_ad_id,omitempty"`
+}
+
+// ExclusivityRuleInputKind defines model for ExclusivityRuleInput.Kind.
+type ExclusivityRuleInputKind string
+
+// ExclusivityRuleList defines model for ExclusivityRuleList.
+type ExclusivityRuleList struct {
+	Data []ExclusivityRule `json:"data"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Field   string                  `json:"field"`
+	Message string                  `json:"message"`
+	Params  *map[string]interface{} `json:"params,omitempty"`
+
+	// Rule 校验规则，例如 required、oneof、type、duplicate、not_found
+	Rule string `json:"rule"`
+}
+
+// HealthStatus defines model for HealthStatus.
+type HealthStatus struct {
+	// Checks 各项检查的结果，ok 或 unavailable；失败原因只记录在服务端日志中
+	Checks *map[string]HealthStatusChecks `json:"checks,omitempty"`
+	Status HealthStatusStatus             `json:"status"`
+}
+
+// HealthStatusChecks defines model for HealthStatus.Checks.
+type HealthStatusChecks string
+
+// HealthStatusStatus defines model for HealthStatus.Status.
+type HealthStatusStatus string
+
+// ImpressionDay defines model for ImpressionDay.
+type ImpressionDay struct {
+	Date openapi_types.Date `json:"date"`
+
+	// Hours 上传了人流量的小时数
+	Hours       int   `json:"hours"`
+	Impressions int64 `json:"impressions"`
+	Visitors    int64 `json:"visitors"`
+}
+
+// Invoice defines model for Invoice.
+type Invoice struct {
+	AdvertisementId    uint      `json:"advertisement_id"`
+	AdvertisementTitle string    `json:"advertisement_title"`
+	CreatedAt          time.Time `json:"created_at"`
+	Currency           string    `json:"currency"`
+	Id                 uint      `json:"id"`
+
+	// Lines 仅在获取单张发票与开具发票时返回
+	Lines       *[]InvoiceLine     `json:"lines,omitempty"`
+	PeriodEnd   openapi_types.Date `json:"period_end"`
+	PeriodStart openapi_types.Date `json:"period_start"`
+
+	// RateCardId 费率卡已删除时为空
+	RateCardId   *uint         `json:"rate_card_id"`
+	RateCardName string        `json:"rate_card_name"`
+	Seconds      int64         `json:"seconds"`
+	Status       InvoiceStatus `json:"status"`
+	Total        int64         `json:"total"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+	VoidedAt     *time.Time    `json:"voided_at"`
+}
+
+// InvoiceStatus defines model for Invoice.Status.
+type InvoiceStatus string
+
+// InvoiceInput defines model for InvoiceInput.
+type InvoiceInput struct {
+	AdvertisementId uint `json:"advertisement_id"`
+
+	// PeriodEnd 当地日期 YYYY-MM-DD（包含当天），与 period_start 最多相隔 92 天
+	PeriodEnd openapi_types.Date `json:"period_end"`
+
+	// PeriodStart 当地日期 YYYY-MM-DD
+	PeriodStart openapi_types.Date `json:"period_start"`
+	RateCardId  uint               `json:"rate_card_id"`
+}
+
+// InvoiceLine defines model for InvoiceLine.
+type InvoiceLine struct {
+	Amount       int64  `json:"amount"`
+	BuildingId   uint   `json:"building_id"`
+	BuildingName string `json:"building_name"`
+	Hours        int    `json:"hours"`
+	Id           uint   `json:"id"`
+	InvoiceId    uint   `json:"invoice_id"`
+	PlayDuration int64  `json:"play_duration"`
+	Seconds      int64  `json:"seconds"`
+	Tier         string `json:"tier"`
+	Timezone     string `json:"timezone"`
+}
+
+// InvoicePage defines model for InvoicePage.
+type InvoicePage struct {
+	Data     []Invoice `json:"data"`
+	PageNum  int       `json:"pageNum"`
+	PageSize int       `json:"pageSize"`
+	Total    int64     `json:"total"`
+}
+
+// Layout defines model for Layout.
+type Layout struct {
+	CreatedAt   time.Time    `json:"created_at"`
+	Description string       `json:"description"`
+	Height      int          `json:"height"`
+	Id          uint         `json:"id"`
+	Name        string       `json:"name"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+	Width       int          `json:"width"`
+	Zones       []LayoutZone `json:"zones"`
+}
+
+// LayoutInput defines model for LayoutInput.
+type LayoutInput struct {
+	Description *string      `json:"description,omitempty"`
+	Height      int          `json:"height"`
+	Name        string       `json:"name"`
+	Width       int          `json:"width"`
+	Zones       []LayoutZone `json:"zones"`
+}
+
+// LayoutPage defines model for LayoutPage.
+type LayoutPage struct {
+	Data     []Layout `json:"data"`
+	PageNum  int      `json:"pageNum"`
+	PageSize int      `json:"pageSize"`
+	Total    int64    `json:"total"`
+}
+
+// LayoutZone defines model for LayoutZone.
+type LayoutZone struct {
+	Height int `json:"height"`
+
+	// Kind media 播放图片或视频，ticker 滚动播放标题与描述，widget 由播放端渲染且不投放广告
+	Kind LayoutZoneKind `json:"kind"`
+	Name string         `json:"name"`
+
+	// Widget 仅组件区域使用
+	Widget *LayoutZoneWidget `json:"widget,omitempty"`
+	Width  int               `json:"width"`
+	X      int               `json:"x"`
+	Y      int               `json:"y"`
+}
+
+// LayoutZoneKind media 播放图片或视频，ticker 滚动播放标题与描述，widget 由播放端渲染且不投放广告
+type LayoutZoneKind string
+
+// LayoutZoneWidget 仅组件区域使用
+type LayoutZoneWidget string
+
+// LoginResponse defines model for LoginResponse.
+type LoginResponse struct {
+	// ChallengeToken 提交到 /api/admin/login/2fa 的短期挑战令牌
+	ChallengeToken *string `json:"challenge_token,omitempty"`
+	Message        string  `json:"message"`
+
+	// MfaRequired 需要提交两步验证码
+	MfaRequired *bool `json:"mfa_required,omitempty"`
+
+	// Token 访问令牌，mfa_required 为 true 时不返回
+	Token *string `json:"token,omitempty"`
+
+	// TotpEnrollmentRequired 超级管理员必须先启用两步验证，此前令牌只能访问 /api/admins/2fa
+	TotpEnrollmentRequired *bool `json:"totp_enrollment_required,omitempty"`
+}
+
+// Manifest defines model for Manifest.
+type Manifest struct {
+	Assets     []ManifestAsset `json:"assets"`
+	BuildingId uint            `json:"building_id"`
+
+	// Calendars 各条广告的分时规则引用的日历
+	Calendars   []ManifestCalendar `json:"calendars"`
+	ContentHash string             `json:"content_hash"`
+	GeneratedAt time.Time          `json:"generated_at"`
+	Items       []ManifestItem     `json:"items"`
+
+	// Timezone 大厦所在时区，分时规则与日历按此解释
+	Timezone string `json:"timezone"`
+	Version  int    `json:"version"`
+}
+
+// ManifestAsset defines model for ManifestAsset.
+type ManifestAsset struct {
+	// Sha256 为空表示未登记，播放端无法校验
+	Sha256 string `json:"sha256"`
+
+	// Size 字节数，0 表示未登记
+	Size int64  `json:"size"`
+	Url  string `json:"url"`
+}
+
+// ManifestCalendar defines model for ManifestCalendar.
+type ManifestCalendar struct {
+	Events []struct {
+		// EndDate 不包含当天
+		EndDate openapi_types.Date `json:"end_date"`
+
+		// Rrule 为空表示不重复
+		Rrule     *string            `json:"rrule,omitempty"`
+		StartDate openapi_types.Date `json:"start_date"`
+		Summary   string             `json:"summary"`
+	} `json:"events"`
+	Id uint `json:"id"`
+}
+
+// ManifestDaypart 分时规则，为空表示不受限制。任一时段命中即可播放；日期在排除日历中时不播放，
+// 设置了包含日历时日期必须在其中之一。
+type ManifestDaypart struct {
+	ExcludeCalendarIds []uint          `json:"exclude_calendar_ids"`
+	Id                 uint            `json:"id"`
+	IncludeCalendarIds []uint          `json:"include_calendar_ids"`
+	Windows            []DaypartWindow `json:"windows"`
+}
+
+// ManifestDelta defines model for ManifestDelta.
+type ManifestDelta struct {
+	Added       []ManifestAsset    `json:"added"`
+	BuildingId  uint               `json:"building_id"`
+	Calendars   []ManifestCalendar `json:"calendars"`
+	ContentHash string             `json:"content_hash"`
+	FromVersion int                `json:"from_version"`
+
+	// Items 当前版本的完整播放规则
+	Items    []ManifestItem  `json:"items"`
+	Removed  []ManifestAsset `json:"removed"`
+	Timezone string          `json:"timezone"`
+	Version  int             `json:"version"`
+}
+
+// ManifestItem defines model for ManifestItem.
+type ManifestItem struct {
+	AdvertisementId uint `json:"advertisement_id"`
+	Assets          []struct {
+		Type ManifestItemAssetsType `json:"type"`
+		Url  string                 `json:"url"`
+	} `json:"assets"`
+	Schedule struct {
+		// Daypart 分时规则，为空表示不受限制。任一时段命中即可播放；日期在排除日历中时不播放，
+		// 设置了包含日历时日期必须在其中之一。
+		Daypart *ManifestDaypart `json:"daypart,omitempty"`
+
+		// ExpiresAt 此后不播放
+		ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+		// GoLiveAt 此前不播放
+		GoLiveAt *time.Time `json:"go_live_at,omitempty"`
+
+		// PlayDuration 在该大厦的播放时长（秒）
+		PlayDuration int64 `json:"play_duration"`
+	} `json:"schedule"`
+	Title string `json:"title"`
+
+	// Zone 布局区域，为空表示默认媒体区域
+	Zone *string `json:"zone,omitempty"`
+}
+
+// ManifestItemAssetsType defines model for ManifestItem.Assets.Type.
+type ManifestItemAssetsType string
+
+// ManifestPublicKey defines model for ManifestPublicKey.
+type ManifestPublicKey struct {
+	Algorithm ManifestPublicKeyAlgorithm `json:"algorithm"`
+	KeyId     string                     `json:"key_id"`
+
+	// PublicKey base64 编码的 32 字节公钥
+	PublicKey string `json:"public_key"`
+}
+
+// ManifestPublicKeyAlgorithm defines model for ManifestPublicKey.Algorithm.
+type ManifestPublicKeyAlgorithm string
+
+// Message defines model for Message.
+type Message struct {
+	Message string `json:"message"`
+}
+
+// Model 所有数据表共有的字段
+type Model struct {
+	CreatedAt time.Time  `json:"CreatedAt"`
+	DeletedAt *time.Time `json:"DeletedAt"`
+	ID        uint       `json:"ID"`
+	UpdatedAt time.Time  `json:"UpdatedAt"`
+}
+
+// Notice defines model for Notice.
+type Notice struct {
+	// Content 清理后的 HTML
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy 发布人的用户名，API 密钥为 apikey:<前缀>
+	CreatedBy string     `json:"created_by"`
+	EndsAt    *time.Time `json:"ends_at"`
+	Id        uint       `json:"id"`
+	Priority  int        `json:"priority"`
+	StartsAt  time.Time  `json:"starts_at"`
+	Title     string     `json:"title"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// NoticeIDs defines model for NoticeIDs.
+type NoticeIDs struct {
+	NoticeIds []uint `json:"notice_ids"`
+}
+
+// NoticeInput defines model for NoticeInput.
+type NoticeInput struct {
+	// Content HTML 富文本
+	Content string `json:"content"`
+
+	// EndsAt 失效时间，必须晚于 starts_at，为空表示长期有效
+	EndsAt *time.Time `json:"ends_at"`
+
+	// Priority 越大越靠前
+	Priority *int `json:"priority,omitempty"`
+
+	// StartsAt 生效时间，创建时为空表示立即生效
+	StartsAt *time.Time `json:"starts_at"`
+	Title    string     `json:"title"`
+}
+
+// NoticeList defines model for NoticeList.
+type NoticeList struct {
+	Notices []Notice `json:"notices"`
+}
+
+// NoticePage defines model for NoticePage.
+type NoticePage struct {
+	Data     []Notice `json:"data"`
+	PageNum  int      `json:"pageNum"`
+	PageSize int      `json:"pageSize"`
+	Total    int64    `json:"total"`
+}
+
+// Pagination defines model for Pagination.
+type Pagination struct {
+	PageNum  int   `json:"pageNum"`
+	PageSize int   `json:"pageSize"`
+	Total    int64 `json:"total"`
+}
+
+// PlacementEligibility defines model for PlacementEligibility.
+type PlacementEligibility struct {
+	AdvertisementId uint `json:"advertisement_id"`
+
+	// CalendarId 因排除日历不能播放时命中的日历
+	CalendarId *uint                      `json:"calendar_id,omitempty"`
+	DaypartId  *uint                      `json:"daypart_id"`
+	Eligible   bool                       `json:"eligible"`
+	Reason     PlacementEligibilityReason `json:"reason"`
+	Title      string                     `json:"title"`
+}
+
+// PlacementEligibilityReason defines model for PlacementEligibility.Reason.
+type PlacementEligibilityReason string
+
+// PlacementZoneInput defines model for PlacementZoneInput.
+type PlacementZoneInput struct {
+	Zone *string `json:"zone,omitempty"`
+}
+
+// PolicyToken defines model for PolicyToken.
+type PolicyToken struct {
+	Accessid  *string `json:"accessid,omitempty"`
+	Callback  *string `json:"callback,omitempty"`
+	Dir       *string `json:"dir,omitempty"`
+	Expire    *int64  `json:"expire,omitempty"`
+	Host      *string `json:"host,omitempty"`
+	Policy    *string `json:"policy,omitempty"`
+	Signature *string `json:"signature,omitempty"`
+}
+
+// PreviewExclusion defines model for PreviewExclusion.
+type PreviewExclusion struct {
+	AdvertisementId uint `json:"advertisement_id"`
+
+	// CalendarId 因排除日历不能播放时命中的日历
+	CalendarId *uint                  `json:"calendar_id,omitempty"`
+	From       time.Time              `json:"from"`
+	Reason     PreviewExclusionReason `json:"reason"`
+	Title      string                 `json:"title"`
+	To         time.Time              `json:"to"`
+	Zone       string                 `json:"zone"`
+}
+
+// PreviewExclusionReason defines model for PreviewExclusion.Reason.
+type PreviewExclusionReason string
+
+// PreviewSlot defines model for PreviewSlot.
+type PreviewSlot struct {
+	AdvertisementId uint `json:"advertisement_id"`
+
+	// Duration 秒
+	Duration int64 `json:"duration"`
+
+	// Loop 区域内的轮次，从 1 开始
+	Loop  int       `json:"loop"`
+	Start time.Time `json:"start"`
+	Title string    `json:"title"`
+	Zone  string    `json:"zone"`
+}
+
+// PreviewTotal defines model for PreviewTotal.
+type PreviewTotal struct {
+	AdvertisementId uint   `json:"advertisement_id"`
+	PlayDuration    int64  `json:"play_duration"`
+	Plays           int    `json:"plays"`
+	Seconds         int64  `json:"seconds"`
+	Title           string `json:"title"`
+	Zone            string `json:"zone"`
+}
+
+// RateCard defines model for RateCard.
+type RateCard struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Currency    string    `json:"currency"`
+	Description string    `json:"description"`
+	Id          uint      `json:"id"`
+	Name        string    `json:"name"`
+
+	// Rates 按等级与开始时间排序
+	Rates     []RateCardRate `json:"rates"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// RateCardInput defines model for RateCardInput.
+type RateCardInput struct {
+	// Currency ISO 4217 货币代码，保存为大写
+	Currency    string         `json:"currency"`
+	Description *string        `json:"description,omitempty"`
+	Name        string         `json:"name"`
+	Rates       []RateCardRate `json:"rates"`
+}
+
+// RateCardPage defines model for RateCardPage.
+type RateCardPage struct {
+	Data     []RateCard `json:"data"`
+	PageNum  int        `json:"pageNum"`
+	PageSize int        `json:"pageSize"`
+	Total    int64      `json:"total"`
+}
+
+// RateCardRate defines model for RateCardRate.
+type RateCardRate struct {
+	// End 当地时间 HH:MM，必须是整点且晚于 start，24:00 表示当天结束
+	End string `json:"end"`
+
+	// PricePerSecond 每秒播放时长的价格，以货币的最小单位计
+	PricePerSecond int64 `json:"price_per_second"`
+
+	// Start 当地时间 HH:MM，必须是整点
+	Start string `json:"start"`
+
+	// Tier 大厦等级，保存为小写；为空表示默认费率
+	Tier string `json:"tier"`
+}
+
+// RecoveryCodes defines model for RecoveryCodes.
+type RecoveryCodes struct {
+	Message       string   `json:"message"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// ResetPasswordInput defines model for ResetPasswordInput.
+type ResetPasswordInput struct {
+	NewPassword string `json:"new_password"`
+}
+
+// SecondFactor code 与 recovery_code 二选一
+type SecondFactor struct {
+	// Code 验证器应用中的 6 位验证码
+	Code *string `json:"code,omitempty"`
+
+	// RecoveryCode 一次性恢复码
+	RecoveryCode *string `json:"recovery_code,omitempty"`
+}
+
+// TOTPEnrollment defines model for TOTPEnrollment.
+type TOTPEnrollment struct {
+	// ProvisioningUri otpauth:// 链接
+	ProvisioningUri string `json:"provisioning_uri"`
+
+	// QrCode 二维码 PNG 的 data URI
+	QrCode string `json:"qr_code"`
+
+	// Secret Base32 密钥，无法扫码时手动输入
+	Secret string `json:"secret"`
+}
+
+// TOTPLoginInput defines model for TOTPLoginInput.
+type TOTPLoginInput struct {
+	ChallengeToken string `json:"challenge_token"`
+
+	// Code 验证器应用中的 6 位验证码
+	Code *string `json:"code,omitempty"`
+
+	// RecoveryCode 一次性恢复码
+	RecoveryCode *string `json:"recovery_code,omitempty"`
+}
+
+// TrafficCount defines model for TrafficCount.
+type TrafficCount struct {
+	BuildingId uint      `json:"building_id"`
+	CreatedAt  time.Time `json:"created_at"`
+	Hour       time.Time `json:"hour"`
+	Id         uint      `json:"id"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Visitors   int64     `json:"visitors"`
+}
+
+// TrafficCountInput defines model for TrafficCountInput.
+type TrafficCountInput struct {
+	// Hour 大厦当地的整点
+	Hour     time.Time `json:"hour"`
+	Visitors int64     `json:"visitors"`
+}
+
+// TrafficImportReport defines model for TrafficImportReport.
+type TrafficImportReport struct {
+	Committed bool               `json:"committed"`
+	DryRun    bool               `json:"dry_run"`
+	Invalid   int                `json:"invalid"`
+	Rows      []TrafficImportRow `json:"rows"`
+	Total     int                `json:"total"`
+	Valid     int                `json:"valid"`
+}
+
+// TrafficImportRow defines model for TrafficImportRow.
+type TrafficImportRow struct {
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Hour 文件中的原始值
+	Hour string `json:"hour"`
+
+	// Row 文件中的行号，从 1 开始，包含表头
+	Row      int   `json:"row"`
+	Visitors int64 `json:"visitors"`
+}
+
+// TrafficInput defines model for TrafficInput.
+type TrafficInput struct {
+	Counts []TrafficCountInput `json:"counts"`
+}
+
+// TrafficList defines model for TrafficList.
+type TrafficList struct {
+	BuildingId uint               `json:"building_id"`
+	Data       []TrafficCount     `json:"data"`
+	From       openapi_types.Date `json:"from"`
+	Timezone   string             `json:"timezone"`
+	To         openapi_types.Date `json:"to"`
+}
+
+// UpdateBuildingInput defines model for UpdateBuildingInput.
+type UpdateBuildingInput struct {
+	Address *string `json:"address,omitempty"`
+	BlgId   *string `json:"blg_id,omitempty"`
+	Locale  *string `json:"locale,omitempty"`
+	Name    *string `json:"name,omitempty"`
+
+	// Tier 传空字符串清除等级
+	Tier     *string `json:"tier,omitempty"`
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// UpdatePasswordInput defines model for UpdatePasswordInput.
+type UpdatePasswordInput struct {
+	NewPassword string `json:"new_password"`
+	OldPassword string `json:"old_password"`
+
+	// Username 已废弃，只能修改当前登录管理员的密码，与当前用户不一致时返回 403
+	// Deprecated:
+	Username *string `json:"username,omitempty"`
+}
+
+// UploadPolicyRequest defines model for UploadPolicyRequest.
+type UploadPolicyRequest struct {
+	CallbackUrl string `json:"callback_url"`
+	UploadDir   string `json:"upload_dir"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	Active      bool               `json:"active"`
+	CreatedAt   time.Time          `json:"created_at"`
+	CreatedById uint               `json:"created_by_id"`
+	Events      []WebhookEventType `json:"events"`
+	Id          uint               `json:"id"`
+	Name        string             `json:"name"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+	Url         string             `json:"url"`
+}
+
+// WebhookAdData defines model for WebhookAdData.
+type WebhookAdData struct {
+	Description   *string    `json:"description,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	GoLiveAt      *time.Time `json:"go_live_at"`
+	Id            uint       `json:"id"`
+	ImageUrl      *string    `json:"image_url,omitempty"`
+	Status        string     `json:"status"`
+	Title         string     `json:"title"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	VideoDuration *int64     `json:"video_duration,omitempty"`
+	VideoUrl      *string    `json:"video_url,omitempty"`
+}
+
+// WebhookCreated defines model for WebhookCreated.
+type WebhookCreated struct {
+	Message string `json:"message"`
+
+	// Secret 签名密钥，只返回这一次
+	Secret  string  `json:"secret"`
+	Webhook Webhook `json:"webhook"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts      int              `json:"attempts"`
+	CreatedAt     time.Time        `json:"created_at"`
+	DeliveredAt   *time.Time       `json:"delivered_at"`
+	Event         WebhookEventType `json:"event"`
+	EventId       string           `json:"event_id"`
+	Id            uint             `json:"id"`
+	LastAttemptAt *time.Time       `json:"last_attempt_at"`
+	LastError     *string          `json:"last_error,omitempty"`
+	NextAttemptAt time.Time        `json:"next_attempt_at"`
+
+	// Payload 发送的请求体（WebhookEvent 的 JSON）
+	Payload string `json:"payload"`
+
+	// ResponseStatus 最近一次尝试的 HTTP 状态码，请求未发出时为 0
+	ResponseStatus *int                  `json:"response_status,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	SubscriptionId uint                  `json:"subscription_id"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookDeliveryPage defines model for WebhookDeliveryPage.
+type WebhookDeliveryPage struct {
+	Data     []WebhookDelivery `json:"data"`
+	PageNum  int               `json:"pageNum"`
+	PageSize int               `json:"pageSize"`
+	Total    int64             `json:"total"`
+}
+
+// WebhookEvent 投递的请求体，ad.* 事件的 data 为 WebhookAdData，placement.* 事件为 WebhookPlacementData
+type WebhookEvent struct {
+	CreatedAt time.Time         `json:"created_at"`
+	Data      WebhookEvent_Data `json:"data"`
+	Event     WebhookEventType  `json:"event"`
+	Id        string            `json:"id"`
+}
+
+// WebhookEvent_Data defines model for WebhookEvent.Data.
+type WebhookEvent_Data struct {
+	union json.RawMessage
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookInput 创建时 name、url、events 为必填
+type WebhookInput struct {
+	Active *bool               `json:"active,omitempty"`
+	Events *[]WebhookEventType `json:"events,omitempty"`
+	Name   *string             `json:"name,omitempty"`
+
+	// Url http 或 https 地址
+	Url *string `json:"url,omitempty"`
+}
+
+// WebhookPage defines model for WebhookPage.
+type WebhookPage struct {
+	Data     []Webhook `json:"data"`
+	PageNum  int       `json:"pageNum"`
+	PageSize int       `json:"pageSize"`
+	Total    int64     `json:"total"`
+}
+
+// WebhookPlacementData defines model for WebhookPlacementData.
+type WebhookPlacementData struct {
+	AdvertisementId uint  `json:"advertisement_id"`
+	BuildingId      uint  `json:"building_id"`
+	PlayDuration    int64 `json:"play_duration"`
+}
+
+// ZoneItem defines model for ZoneItem.
+type ZoneItem struct {
+	AdvertisementId uint    `json:"advertisement_id"`
+	Description     *string `json:"description,omitempty"`
+	ImageUrl        *string `json:"image_url,omitempty"`
+	PlayDuration    int64   `json:"play_duration"`
+
+	// Text 滚动字幕区域的文字
+	Text     *string `json:"text,omitempty"`
+	Title    string  `json:"title"`
+	VideoUrl *string `json:"video_url,omitempty"`
+}
+
+// Desc defines model for Desc.
+type Desc = bool
+
+// ExportFormat defines model for ExportFormat.
+type ExportFormat string
+
+// ID defines model for ID.
+type ID = uint
+
+// PageNum defines model for PageNum.
+type PageNum = int
+
+// PageSize defines model for PageSize.
+type PageSize = int
+
+// Locked defines model for Locked.
+type Locked = Error
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = Error
+
+// ConfirmTOTPJSONBody defines parameters for ConfirmTOTP.
+type ConfirmTOTPJSONBody struct {
+	// Code 验证器应用中的 6 位验证码
+	Code string `json:"code"`
+}
+
+// ListAPIKeysParams defines parameters for ListAPIKeys.
+type ListAPIKeysParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// ListAuditLogsParams defines parameters for ListAuditLogs.
+type ListAuditLogsParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// DeleteAdminJSONBody defines parameters for DeleteAdmin.
+type DeleteAdminJSONBody struct {
+	Id uint `json:"id"`
+}
+
+// ListAdminsParams defines parameters for ListAdmins.
+type ListAdminsParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Desc 按创建时间倒序
+	Desc *Desc `form:"desc,omitempty" json:"desc,omitempty"`
+}
+
+// ListWebhooksParams defines parameters for ListWebhooks.
+type ListWebhooksParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// ListAdsParams defines parameters for ListAds.
+type ListAdsParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Desc 按创建时间倒序
+	Desc *Desc `form:"desc,omitempty" json:"desc,omitempty"`
+}
+
+// GetAdChargesParams defines parameters for GetAdCharges.
+type GetAdChargesParams struct {
+	RateCardId uint `form:"rate_card_id" json:"rate_card_id"`
+
+	// From 当地日期 YYYY-MM-DD
+	From openapi_types.Date `form:"from" json:"from"`
+
+	// To 当地日期 YYYY-MM-DD（包含当天），与 from 最多相隔 92 天
+	To openapi_types.Date `form:"to" json:"to"`
+}
+
+// GetAdImpressionsParams defines parameters for GetAdImpressions.
+type GetAdImpressionsParams struct {
+	// From 当地日期 YYYY-MM-DD，默认为 to 之前第 6 天
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 当地日期 YYYY-MM-DD（包含当天），默认为今天；与 from 最多相隔 92 天
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListBuildingsParams defines parameters for ListBuildings.
+type ListBuildingsParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Desc 按创建时间倒序
+	Desc *Desc `form:"desc,omitempty" json:"desc,omitempty"`
+}
+
+// ImportBuildingsMultipartBody defines parameters for ImportBuildings.
+type ImportBuildingsMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ImportBuildingsParams defines parameters for ImportBuildings.
+type ImportBuildingsParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetBuildingEligibilityParams defines parameters for GetBuildingEligibility.
+type GetBuildingEligibilityParams struct {
+	// At RFC 3339 时间，默认当前时间
+	At *time.Time `form:"at,omitempty" json:"at,omitempty"`
+}
+
+// GetBuildingImpressionsParams defines parameters for GetBuildingImpressions.
+type GetBuildingImpressionsParams struct {
+	// From 当地日期 YYYY-MM-DD，默认为 to 之前第 6 天
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 当地日期 YYYY-MM-DD（包含当天），默认为今天；与 from 最多相隔 92 天
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetBuildingManifestParams defines parameters for GetBuildingManifest.
+type GetBuildingManifestParams struct {
+	XDeviceID   *string `json:"X-Device-ID,omitempty"`
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// GetBuildingManifestDeltaParams defines parameters for GetBuildingManifestDelta.
+type GetBuildingManifestDeltaParams struct {
+	Since     int     `form:"since" json:"since"`
+	XDeviceID *string `json:"X-Device-ID,omitempty"`
+}
+
+// GetBuildingPreviewParams defines parameters for GetBuildingPreview.
+type GetBuildingPreviewParams struct {
+	// From RFC 3339 时间或大厦当地日期 YYYY-MM-DD，默认为当地明天零点
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To RFC 3339 时间或大厦当地日期 YYYY-MM-DD（包含当天），默认为 from 所在当地日期的结束
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListBuildingTrafficParams defines parameters for ListBuildingTraffic.
+type ListBuildingTrafficParams struct {
+	// From 当地日期 YYYY-MM-DD，默认为 to 之前第 6 天
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 当地日期 YYYY-MM-DD（包含当天），默认为今天；与 from 最多相隔 92 天
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ImportBuildingTrafficMultipartBody defines parameters for ImportBuildingTraffic.
+type ImportBuildingTrafficMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ImportBuildingTrafficParams defines parameters for ImportBuildingTraffic.
+type ImportBuildingTrafficParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ListCalendarsParams defines parameters for ListCalendars.
+type ListCalendarsParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// ImportCalendarMultipartBody defines parameters for ImportCalendar.
+type ImportCalendarMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ListDaypartsParams defines parameters for ListDayparts.
+type ListDaypartsParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// ExportAdsParams defines parameters for ExportAds.
+type ExportAdsParams struct {
+	Format *ExportAdsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportAdsParamsFormat defines parameters for ExportAds.
+type ExportAdsParamsFormat string
+
+// ExportBuildingsParams defines parameters for ExportBuildings.
+type ExportBuildingsParams struct {
+	Format *ExportBuildingsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportBuildingsParamsFormat defines parameters for ExportBuildings.
+type ExportBuildingsParamsFormat string
+
+// ExportInvoiceParams defines parameters for ExportInvoice.
+type ExportInvoiceParams struct {
+	Format *ExportInvoiceParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportInvoiceParamsFormat defines parameters for ExportInvoice.
+type ExportInvoiceParamsFormat string
+
+// ExportPlacementsParams defines parameters for ExportPlacements.
+type ExportPlacementsParams struct {
+	Format *ExportPlacementsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportPlacementsParamsFormat defines parameters for ExportPlacements.
+type ExportPlacementsParamsFormat string
+
+// ListInvoicesParams defines parameters for ListInvoices.
+type ListInvoicesParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// AdvertisementId 只返回该广告的发票
+	AdvertisementId *uint `form:"advertisement_id,omitempty" json:"advertisement_id,omitempty"`
+}
+
+// ListLayoutsParams defines parameters for ListLayouts.
+type ListLayoutsParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// ListNoticesParams defines parameters for ListNotices.
+type ListNoticesParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Desc 按创建时间倒序
+	Desc *Desc `form:"desc,omitempty" json:"desc,omitempty"`
+}
+
+// ListRateCardsParams defines parameters for ListRateCards.
+type ListRateCardsParams struct {
+	PageNum  *PageNum  `form:"pageNum,omitempty" json:"pageNum,omitempty"`
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// LoginAdminJSONRequestBody defines body for LoginAdmin for application/json ContentType.
+type LoginAdminJSONRequestBody = Credentials
+
+// VerifyLoginTOTPJSONRequestBody defines body for VerifyLoginTOTP for application/json ContentType.
+type VerifyLoginTOTPJSONRequestBody = TOTPLoginInput
+
+// RegisterAdminJSONRequestBody defines body for RegisterAdmin for application/json ContentType.
+type RegisterAdminJSONRequestBody = Credentials
+
+// DisableTOTPJSONRequestBody defines body for DisableTOTP for application/json ContentType.
+type DisableTOTPJSONRequestBody = DisableTOTPInput
+
+// RegenerateRecoveryCodesJSONRequestBody defines body for RegenerateRecoveryCodes for application/json ContentType.
+type RegenerateRecoveryCodesJSONRequestBody = SecondFactor
+
+// ConfirmTOTPJSONRequestBody defines body for ConfirmTOTP for application/json ContentType.
+type ConfirmTOTPJSONRequestBody ConfirmTOTPJSONBody
+
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody = APIKeyInput
+
+// UpdateAdminPasswordJSONRequestBody defines body for UpdateAdminPassword for application/json ContentType.
+type UpdateAdminPasswordJSONRequestBody = UpdatePasswordInput
+
+// DeleteAdminJSONRequestBody defines body for DeleteAdmin for application/json ContentType.
+type DeleteAdminJSONRequestBody DeleteAdminJSONBody
+
+// ResetAdminPasswordJSONRequestBody defines body for ResetAdminPassword for application/json ContentType.
+type ResetAdminPasswordJSONRequestBody = ResetPasswordInput
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = WebhookInput
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = WebhookInput
+
+// CreateAdJSONRequestBody defines body for CreateAd for application/json ContentType.
+type CreateAdJSONRequestBody = AdvertisementInput
+
+// UpdateAdJSONRequestBody defines body for UpdateAd for application/json ContentType.
+type UpdateAdJSONRequestBody = AdvertisementInput
+
+// RemoveBuildingsFromAdJSONRequestBody defines body for RemoveBuildingsFromAd for application/json ContentType.
+type RemoveBuildingsFromAdJSONRequestBody = BuildingIDs
+
+// AddBuildingsToAdJSONRequestBody defines body for AddBuildingsToAd for application/json ContentType.
+type AddBuildingsToAdJSONRequestBody = BuildingIDs
+
+// CreateBuildingJSONRequestBody defines body for CreateBuilding for application/json ContentType.
+type CreateBuildingJSONRequestBody = CreateBuildingInput
+
+// ImportBuildingsMultipartRequestBody defines body for ImportBuildings for multipart/form-data ContentType.
+type ImportBuildingsMultipartRequestBody ImportBuildingsMultipartBody
+
+// UpdateBuildingJSONRequestBody defines body for UpdateBuilding for application/json ContentType.
+type UpdateBuildingJSONRequestBody = UpdateBuildingInput
+
+// RemoveAdsFromBuildingJSONRequestBody defines body for RemoveAdsFromBuilding for application/json ContentType.
+type RemoveAdsFromBuildingJSONRequestBody = AdvertisementIDs
+
+// AddAdsToBuildingJSONRequestBody defines body for AddAdsToBuilding for application/json ContentType.
+type AddAdsToBuildingJSONRequestBody = AdvertisementIDs
+
+// AssignPlacementDaypartJSONRequestBody defines body for AssignPlacementDaypart for application/json ContentType.
+type AssignPlacementDaypartJSONRequestBody = AssignDaypartInput
+
+// UpdatePlacementZoneJSONRequestBody defines body for UpdatePlacementZone for application/json ContentType.
+type UpdatePlacementZoneJSONRequestBody = PlacementZoneInput
+
+// CreateExclusivityRuleJSONRequestBody defines body for CreateExclusivityRule for application/json ContentType.
+type CreateExclusivityRuleJSONRequestBody = ExclusivityRuleInput
+
+// UpdateExclusivityRuleJSONRequestBody defines body for UpdateExclusivityRule for application/json ContentType.
+type UpdateExclusivityRuleJSONRequestBody = ExclusivityRuleInput
+
+// AssignBuildingLayoutJSONRequestBody defines body for AssignBuildingLayout for application/json ContentType.
+type AssignBuildingLayoutJSONRequestBody = AssignLayoutInput
+
+// RemoveNoticesFromBuildingJSONRequestBody defines body for RemoveNoticesFromBuilding for application/json ContentType.
+type RemoveNoticesFromBuildingJSONRequestBody = NoticeIDs
+
+// AddNoticesToBuildingJSONRequestBody defines body for AddNoticesToBuilding for application/json ContentType.
+type AddNoticesToBuildingJSONRequestBody = NoticeIDs
+
+// PutBuildingTrafficJSONRequestBody defines body for PutBuildingTraffic for application/json ContentType.
+type PutBuildingTrafficJSONRequestBody = TrafficInput
+
+// ImportBuildingTrafficMultipartRequestBody defines body for ImportBuildingTraffic for multipart/form-data ContentType.
+type ImportBuildingTrafficMultipartRequestBody ImportBuildingTrafficMultipartBody
+
+// CreateCalendarJSONRequestBody defines body for CreateCalendar for application/json ContentType.
+type CreateCalendarJSONRequestBody = CalendarInput
+
+// UpdateCalendarJSONRequestBody defines body for UpdateCalendar for application/json ContentType.
+type UpdateCalendarJSONRequestBody = CalendarInput
+
+// CreateCalendarEventJSONRequestBody defines body for CreateCalendarEvent for application/json ContentType.
+type CreateCalendarEventJSONRequestBody = CalendarEventInput
+
+// ImportCalendarMultipartRequestBody defines body for ImportCalendar for multipart/form-data ContentType.
+type ImportCalendarMultipartRequestBody ImportCalendarMultipartBody
+
+// CreateDaypartJSONRequestBody defines body for CreateDaypart for application/json ContentType.
+type CreateDaypartJSONRequestBody = DaypartInput
+
+// UpdateDaypartJSONRequestBody defines body for UpdateDaypart for application/json ContentType.
+type UpdateDaypartJSONRequestBody = DaypartInput
+
+// CreateInvoiceJSONRequestBody defines body for CreateInvoice for application/json ContentType.
+type CreateInvoiceJSONRequestBody = InvoiceInput
+
+// CreateLayoutJSONRequestBody defines body for CreateLayout for application/json ContentType.
+type CreateLayoutJSONRequestBody = LayoutInput
+
+// UpdateLayoutJSONRequestBody defines body for UpdateLayout for application/json ContentType.
+type UpdateLayoutJSONRequestBody = LayoutInput
+
+// CreateNoticeJSONRequestBody defines body for CreateNotice for application/json ContentType.
+type CreateNoticeJSONRequestBody = NoticeInput
+
+// UpdateNoticeJSONRequestBody defines body for UpdateNotice for application/json ContentType.
+type UpdateNoticeJSONRequestBody = NoticeInput
+
+// RemoveBuildingsFromNoticeJSONRequestBody defines body for RemoveBuildingsFromNotice for application/json ContentType.
+type RemoveBuildingsFromNoticeJSONRequestBody = BuildingIDs
+
+// AddBuildingsToNoticeJSONRequestBody defines body for AddBuildingsToNotice for application/json ContentType.
+type AddBuildingsToNoticeJSONRequestBody = BuildingIDs
+
+// CreateRateCardJSONRequestBody defines body for CreateRateCard for application/json ContentType.
+type CreateRateCardJSONRequestBody = RateCardInput
+
+// UpdateRateCardJSONRequestBody defines body for UpdateRateCard for application/json ContentType.
+type UpdateRateCardJSONRequestBody = RateCardInput
+
+// GetUploadPolicyJSONRequestBody defines body for GetUploadPolicy for application/json ContentType.
+type GetUploadPolicyJSONRequestBody = UploadPolicyRequest
+
+// GetUploadPolicyFormdataRequestBody defines body for GetUploadPolicy for application/x-www-form-urlencoded ContentType.
+type GetUploadPolicyFormdataRequestBody = UploadPolicyRequest
+
+// AsWebhookAdData returns the union data inside the WebhookEvent_Data as a WebhookAdData
+func (t WebhookEvent_Data) AsWebhookAdData() (WebhookAdData, error) {
+	var body WebhookAdData
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromWebhookAdData overwrites any union data inside the WebhookEvent_Data as the provided WebhookAdData
+func (t *WebhookEvent_Data) FromWebhookAdData(v WebhookAdData) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeWebhookAdData performs a merge with any union data inside the WebhookEvent_Data, using the provided WebhookAdData
+func (t *WebhookEvent_Data) MergeWebhookAdData(v WebhookAdData) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsWebhookPlacementData returns the union data inside the WebhookEvent_Data as a WebhookPlacementData
+func (t WebhookEvent_Data) AsWebhookPlacementData() (WebhookPlacementData, error) {
+	var body WebhookPlacementData
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromWebhookPlacementData overwrites any union data inside the WebhookEvent_Data as the provided WebhookPlacementData
+func (t *WebhookEvent_Data) FromWebhookPlacementData(v WebhookPlacementData) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeWebhookPlacementData performs a merge with any union data inside the WebhookEvent_Data, using the provided WebhookPlacementData
+func (t *WebhookEvent_Data) MergeWebhookPlacementData(v WebhookPlacementData) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t WebhookEvent_Data) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *WebhookEvent_Data) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// LoginAdminWithBody request with any body
+	LoginAdminWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LoginAdmin(ctx context.Context, body LoginAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyLoginTOTPWithBody request with any body
+	VerifyLoginTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyLoginTOTP(ctx context.Context, body VerifyLoginTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterAdminWithBody request with any body
+	RegisterAdminWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegisterAdmin(ctx context.Context, body RegisterAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisableTOTPWithBody request with any body
+	DisableTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DisableTOTP(ctx context.Context, body DisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnrollTOTP request
+	EnrollTOTP(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegenerateRecoveryCodesWithBody request with any body
+	RegenerateRecoveryCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegenerateRecoveryCodes(ctx context.Context, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmTOTPWithBody request with any body
+	ConfirmTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmTOTP(ctx context.Context, body ConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAPIKeys request
+	ListAPIKeys(ctx context.Context, params *ListAPIKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAPIKeyWithBody request with any body
+	CreateAPIKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAPIKey(ctx context.Context, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeAPIKey request
+	RevokeAPIKey(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAuditLogs request
+	ListAuditLogs(ctx context.Context, params *ListAuditLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAdminPasswordWithBody request with any body
+	UpdateAdminPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAdminPassword(ctx context.Context, body UpdateAdminPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminWithBody request with any body
+	DeleteAdminWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteAdmin(ctx context.Context, body DeleteAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAdmins request
+	ListAdmins(ctx context.Context, params *ListAdminsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetAdminTOTP request
+	ResetAdminTOTP(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetAdminPasswordWithBody request with any body
+	ResetAdminPasswordWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResetAdminPassword(ctx context.Context, id ID, body ResetAdminPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhooks request
+	ListWebhooks(ctx context.Context, params *ListWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWebhookWithBody request with any body
+	CreateWebhookWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWebhook(ctx context.Context, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhook request
+	DeleteWebhook(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWebhookWithBody request with any body
+	UpdateWebhookWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWebhook(ctx context.Context, id ID, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhookDeliveries request
+	ListWebhookDeliveries(ctx context.Context, id ID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RetryWebhookDelivery request
+	RetryWebhookDelivery(ctx context.Context, id ID, deliveryId uint, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAds request
+	ListAds(ctx context.Context, params *ListAdsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAdWithBody request with any body
+	CreateAdWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAd(ctx context.Context, body CreateAdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAd request
+	DeleteAd(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAd request
+	GetAd(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAdWithBody request with any body
+	UpdateAdWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAd(ctx context.Context, id ID, body UpdateAdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveBuildingsFromAdWithBody request with any body
+	RemoveBuildingsFromAdWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RemoveBuildingsFromAd(ctx context.Context, id ID, body RemoveBuildingsFromAdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAdBuildings request
+	ListAdBuildings(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddBuildingsToAdWithBody request with any body
+	AddBuildingsToAdWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddBuildingsToAd(ctx context.Context, id ID, body AddBuildingsToAdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdCharges request
+	GetAdCharges(ctx context.Context, id ID, params *GetAdChargesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdImpressions request
+	GetAdImpressions(ctx context.Context, id ID, params *GetAdImpressionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBuildings request
+	ListBuildings(ctx context.Context, params *ListBuildingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBuildingWithBody request with any body
+	CreateBuildingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBuilding(ctx context.Context, body CreateBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportBuildingsWithBody request with any body
+	ImportBuildingsWithBody(ctx context.Context, params *ImportBuildingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBuilding request
+	DeleteBuilding(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuilding request
+	GetBuilding(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateBuildingWithBody request with any body
+	UpdateBuildingWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateBuilding(ctx context.Context, id ID, body UpdateBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveAdsFromBuildingWithBody request with any body
+	RemoveAdsFromBuildingWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RemoveAdsFromBuilding(ctx context.Context, id ID, body RemoveAdsFromBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBuildingAds request
+	ListBuildingAds(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddAdsToBuildingWithBody request with any body
+	AddAdsToBuildingWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddAdsToBuilding(ctx context.Context, id ID, body AddAdsToBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AssignPlacementDaypartWithBody request with any body
+	AssignPlacementDaypartWithBody(ctx context.Context, id ID, adId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AssignPlacementDaypart(ctx context.Context, id ID, adId uint, body AssignPlacementDaypartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePlacementZoneWithBody request with any body
+	UpdatePlacementZoneWithBody(ctx context.Context, id ID, adId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePlacementZone(ctx context.Context, id ID, adId uint, body UpdatePlacementZoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildingEligibility request
+	GetBuildingEligibility(ctx context.Context, id ID, params *GetBuildingEligibilityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListExclusivityRules request
+	ListExclusivityRules(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateExclusivityRuleWithBody request with any body
+	CreateExclusivityRuleWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateExclusivityRule(ctx context.Context, id ID, body CreateExclusivityRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteExclusivityRule request
+	DeleteExclusivityRule(ctx context.Context, id ID, ruleId uint, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateExclusivityRuleWithBody request with any body
+	UpdateExclusivityRuleWithBody(ctx context.Context, id ID, ruleId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateExclusivityRule(ctx context.Context, id ID, ruleId uint, body UpdateExclusivityRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildingImpressions request
+	GetBuildingImpressions(ctx context.Context, id ID, params *GetBuildingImpressionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildingLayout request
+	GetBuildingLayout(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AssignBuildingLayoutWithBody request with any body
+	AssignBuildingLayoutWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AssignBuildingLayout(ctx context.Context, id ID, body AssignBuildingLayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildingManifest request
+	GetBuildingManifest(ctx context.Context, id ID, params *GetBuildingManifestParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildingManifestDelta request
+	GetBuildingManifestDelta(ctx context.Context, id ID, params *GetBuildingManifestDeltaParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveNoticesFromBuildingWithBody request with any body
+	RemoveNoticesFromBuildingWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RemoveNoticesFromBuilding(ctx context.Context, id ID, body RemoveNoticesFromBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBuildingNotices request
+	ListBuildingNotices(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddNoticesToBuildingWithBody request with any body
+	AddNoticesToBuildingWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddNoticesToBuilding(ctx context.Context, id ID, body AddNoticesToBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListActiveBuildingNotices request
+	ListActiveBuildingNotices(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBuildingPreview request
+	GetBuildingPreview(ctx context.Context, id ID, params *GetBuildingPreviewParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBuildingTraffic request
+	ListBuildingTraffic(ctx context.Context, id ID, params *ListBuildingTrafficParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutBuildingTrafficWithBody request with any body
+	PutBuildingTrafficWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutBuildingTraffic(ctx context.Context, id ID, body PutBuildingTrafficJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportBuildingTrafficWithBody request with any body
+	ImportBuildingTrafficWithBody(ctx context.Context, id ID, params *ImportBuildingTrafficParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCalendars request
+	ListCalendars(ctx context.Context, params *ListCalendarsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCalendarWithBody request with any body
+	CreateCalendarWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCalendar(ctx context.Context, body CreateCalendarJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCalendar request
+	DeleteCalendar(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCalendar request
+	GetCalendar(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCalendarWithBody request with any body
+	UpdateCalendarWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCalendar(ctx context.Context, id ID, body UpdateCalendarJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCalendarEvents request
+	ListCalendarEvents(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCalendarEventWithBody request with any body
+	CreateCalendarEventWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCalendarEvent(ctx context.Context, id ID, body CreateCalendarEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCalendarEvent request
+	DeleteCalendarEvent(ctx context.Context, id ID, eventId uint, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportCalendarWithBody request with any body
+	ImportCalendarWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListDayparts request
+	ListDayparts(ctx context.Context, params *ListDaypartsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDaypartWithBody request with any body
+	CreateDaypartWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDaypart(ctx context.Context, body CreateDaypartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDaypart request
+	DeleteDaypart(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDaypart request
+	GetDaypart(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDaypartWithBody request with any body
+	UpdateDaypartWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDaypart(ctx context.Context, id ID, body UpdateDaypartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportAds request
+	ExportAds(ctx context.Context, params *ExportAdsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportAdCertificate request
+	ExportAdCertificate(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportBuildings request
+	ExportBuildings(ctx context.Context, params *ExportBuildingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportInvoice request
+	ExportInvoice(ctx context.Context, id ID, params *ExportInvoiceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportPlacements request
+	ExportPlacements(ctx context.Context, params *ExportPlacementsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInvoices request
+	ListInvoices(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateInvoiceWithBody request with any body
+	CreateInvoiceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInvoice(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInvoice request
+	GetInvoice(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VoidInvoice request
+	VoidInvoice(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLayouts request
+	ListLayouts(ctx context.Context, params *ListLayoutsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateLayoutWithBody request with any body
+	CreateLayoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateLayout(ctx context.Context, body CreateLayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteLayout request
+	DeleteLayout(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLayout request
+	GetLayout(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateLayoutWithBody request with any body
+	UpdateLayoutWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateLayout(ctx context.Context, id ID, body UpdateLayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetManifestPublicKey request
+	GetManifestPublicKey(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListNotices request
+	ListNotices(ctx context.Context, params *ListNoticesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateNoticeWithBody request with any body
+	CreateNoticeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateNotice(ctx context.Context, body CreateNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteNotice request
+	DeleteNotice(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNotice request
+	GetNotice(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateNoticeWithBody request with any body
+	UpdateNoticeWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateNotice(ctx context.Context, id ID, body UpdateNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveBuildingsFromNoticeWithBody request with any body
+	RemoveBuildingsFromNoticeWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RemoveBuildingsFromNotice(ctx context.Context, id ID, body RemoveBuildingsFromNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListNoticeBuildings request
+	ListNoticeBuildings(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddBuildingsToNoticeWithBody request with any body
+	AddBuildingsToNoticeWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddBuildingsToNotice(ctx context.Context, id ID, body AddBuildingsToNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOpenAPI request
+	GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRateCards request
+	ListRateCards(ctx context.Context, params *ListRateCardsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRateCardWithBody request with any body
+	CreateRateCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRateCard(ctx context.Context, body CreateRateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRateCard request
+	DeleteRateCard(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRateCard request
+	GetRateCard(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRateCardWithBody request with any body
+	UpdateRateCardWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRateCard(ctx context.Context, id ID, body UpdateRateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUploadPolicyWithBody request with any body
+	GetUploadPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetUploadPolicy(ctx context.Context, body GetUploadPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetUploadPolicyWithFormdataBody(ctx context.Context, body GetUploadPolicyFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Healthz request
+	Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Metrics request
+	Metrics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Readyz request
+	Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) LoginAdminWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginAdminRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginAdmin(ctx context.Context, body LoginAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginAdminRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyLoginTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyLoginTOTPRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyLoginTOTP(ctx context.Context, body VerifyLoginTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyLoginTOTPRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterAdminWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterAdminRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterAdmin(ctx context.Context, body RegisterAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterAdminRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisableTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTOTPRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisableTOTP(ctx context.Context, body DisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTOTPRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EnrollTOTP(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrollTOTPRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegenerateRecoveryCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateRecoveryCodesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegenerateRecoveryCodes(ctx context.Context, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateRecoveryCodesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTOTPRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmTOTP(ctx context.Context, body ConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTOTPRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAPIKeys(ctx context.Context, params *ListAPIKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAPIKeysRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAPIKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAPIKeyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAPIKey(ctx context.Context, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAPIKeyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeAPIKey(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeAPIKeyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAuditLogs(ctx context.Context, params *ListAuditLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuditLogsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAdminPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAdminPasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAdminPassword(ctx context.Context, body UpdateAdminPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAdminPasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdmin(ctx context.Context, body DeleteAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAdmins(ctx context.Context, params *ListAdminsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAdminsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetAdminTOTP(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetAdminTOTPRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetAdminPasswordWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetAdminPasswordRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetAdminPassword(ctx context.Context, id ID, body ResetAdminPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetAdminPasswordRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListWebhooks(ctx context.Context, params *ListWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhooksRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWebhookWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWebhook(ctx context.Context, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebhook(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhookWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhook(ctx context.Context, id ID, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListWebhookDeliveries(ctx context.Context, id ID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhookDeliveriesRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RetryWebhookDelivery(ctx context.Context, id ID, deliveryId uint, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetryWebhookDeliveryRequest(c.Server, id, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAds(ctx context.Context, params *ListAdsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAdsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAdWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAdRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAd(ctx context.Context, body CreateAdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAdRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAd(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAd(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAdWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAd(ctx context.Context, id ID, body UpdateAdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveBuildingsFromAdWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveBuildingsFromAdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveBuildingsFromAd(ctx context.Context, id ID, body RemoveBuildingsFromAdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveBuildingsFromAdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAdBuildings(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAdBuildingsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddBuildingsToAdWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddBuildingsToAdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddBuildingsToAd(ctx context.Context, id ID, body AddBuildingsToAdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddBuildingsToAdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdCharges(ctx context.Context, id ID, params *GetAdChargesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdChargesRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdImpressions(ctx context.Context, id ID, params *GetAdImpressionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdImpressionsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBuildings(ctx context.Context, params *ListBuildingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBuildingsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBuildingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBuildingRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBuilding(ctx context.Context, body CreateBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBuildingRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportBuildingsWithBody(ctx context.Context, params *ImportBuildingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportBuildingsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteBuilding(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBuildingRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuilding(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildingRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBuildingWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBuildingRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBuilding(ctx context.Context, id ID, body UpdateBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBuildingRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveAdsFromBuildingWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveAdsFromBuildingRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveAdsFromBuilding(ctx context.Context, id ID, body RemoveAdsFromBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveAdsFromBuildingRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBuildingAds(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBuildingAdsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddAdsToBuildingWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddAdsToBuildingRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddAdsToBuilding(ctx context.Context, id ID, body AddAdsToBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddAdsToBuildingRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AssignPlacementDaypartWithBody(ctx context.Context, id ID, adId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignPlacementDaypartRequestWithBody(c.Server, id, adId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AssignPlacementDaypart(ctx context.Context, id ID, adId uint, body AssignPlacementDaypartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignPlacementDaypartRequest(c.Server, id, adId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePlacementZoneWithBody(ctx context.Context, id ID, adId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePlacementZoneRequestWithBody(c.Server, id, adId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePlacementZone(ctx context.Context, id ID, adId uint, body UpdatePlacementZoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePlacementZoneRequest(c.Server, id, adId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildingEligibility(ctx context.Context, id ID, params *GetBuildingEligibilityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildingEligibilityRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListExclusivityRules(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListExclusivityRulesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateExclusivityRuleWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateExclusivityRuleRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateExclusivityRule(ctx context.Context, id ID, body CreateExclusivityRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateExclusivityRuleRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteExclusivityRule(ctx context.Context, id ID, ruleId uint, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteExclusivityRuleRequest(c.Server, id, ruleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateExclusivityRuleWithBody(ctx context.Context, id ID, ruleId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateExclusivityRuleRequestWithBody(c.Server, id, ruleId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateExclusivityRule(ctx context.Context, id ID, ruleId uint, body UpdateExclusivityRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateExclusivityRuleRequest(c.Server, id, ruleId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildingImpressions(ctx context.Context, id ID, params *GetBuildingImpressionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildingImpressionsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildingLayout(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildingLayoutRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AssignBuildingLayoutWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignBuildingLayoutRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AssignBuildingLayout(ctx context.Context, id ID, body AssignBuildingLayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignBuildingLayoutRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildingManifest(ctx context.Context, id ID, params *GetBuildingManifestParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildingManifestRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildingManifestDelta(ctx context.Context, id ID, params *GetBuildingManifestDeltaParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildingManifestDeltaRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveNoticesFromBuildingWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveNoticesFromBuildingRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveNoticesFromBuilding(ctx context.Context, id ID, body RemoveNoticesFromBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveNoticesFromBuildingRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBuildingNotices(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBuildingNoticesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddNoticesToBuildingWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddNoticesToBuildingRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddNoticesToBuilding(ctx context.Context, id ID, body AddNoticesToBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddNoticesToBuildingRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListActiveBuildingNotices(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListActiveBuildingNoticesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildingPreview(ctx context.Context, id ID, params *GetBuildingPreviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildingPreviewRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBuildingTraffic(ctx context.Context, id ID, params *ListBuildingTrafficParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBuildingTrafficRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutBuildingTrafficWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBuildingTrafficRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutBuildingTraffic(ctx context.Context, id ID, body PutBuildingTrafficJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBuildingTrafficRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportBuildingTrafficWithBody(ctx context.Context, id ID, params *ImportBuildingTrafficParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportBuildingTrafficRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCalendars(ctx context.Context, params *ListCalendarsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCalendarsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCalendarWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCalendarRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCalendar(ctx context.Context, body CreateCalendarJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCalendarRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteCalendar(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCalendarRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCalendar(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCalendarWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCalendarRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCalendar(ctx context.Context, id ID, body UpdateCalendarJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCalendarRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCalendarEvents(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCalendarEventsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCalendarEventWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCalendarEventRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCalendarEvent(ctx context.Context, id ID, body CreateCalendarEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCalendarEventRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteCalendarEvent(ctx context.Context, id ID, eventId uint, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCalendarEventRequest(c.Server, id, eventId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportCalendarWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportCalendarRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListDayparts(ctx context.Context, params *ListDaypartsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDaypartsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDaypartWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDaypartRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDaypart(ctx context.Context, body CreateDaypartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDaypartRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteDaypart(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDaypartRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDaypart(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDaypartRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDaypartWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDaypartRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDaypart(ctx context.Context, id ID, body UpdateDaypartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDaypartRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportAds(ctx context.Context, params *ExportAdsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportAdsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportAdCertificate(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportAdCertificateRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportBuildings(ctx context.Context, params *ExportBuildingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportBuildingsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportInvoice(ctx context.Context, id ID, params *ExportInvoiceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportInvoiceRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportPlacements(ctx context.Context, params *ExportPlacementsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportPlacementsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInvoices(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInvoicesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInvoiceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInvoiceRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInvoice(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInvoiceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInvoice(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInvoiceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VoidInvoice(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVoidInvoiceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListLayouts(ctx context.Context, params *ListLayoutsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLayoutsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateLayoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLayoutRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateLayout(ctx context.Context, body CreateLayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLayoutRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteLayout(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteLayoutRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLayout(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLayoutRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateLayoutWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLayoutRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateLayout(ctx context.Context, id ID, body UpdateLayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLayoutRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetManifestPublicKey(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetManifestPublicKeyRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListNotices(ctx context.Context, params *ListNoticesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNoticesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateNoticeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateNoticeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateNotice(ctx context.Context, body CreateNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateNoticeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteNotice(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteNoticeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetNotice(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNoticeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateNoticeWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNoticeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateNotice(ctx context.Context, id ID, body UpdateNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNoticeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveBuildingsFromNoticeWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveBuildingsFromNoticeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveBuildingsFromNotice(ctx context.Context, id ID, body RemoveBuildingsFromNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveBuildingsFromNoticeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListNoticeBuildings(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNoticeBuildingsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddBuildingsToNoticeWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddBuildingsToNoticeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddBuildingsToNotice(ctx context.Context, id ID, body AddBuildingsToNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddBuildingsToNoticeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenAPIRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRateCards(ctx context.Context, params *ListRateCardsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRateCardsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRateCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRateCardRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRateCard(ctx context.Context, body CreateRateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRateCardRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteRateCard(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRateCardRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRateCard(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRateCardRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRateCardWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRateCardRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRateCard(ctx context.Context, id ID, body UpdateRateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRateCardRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUploadPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUploadPolicyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUploadPolicy(ctx context.Context, body GetUploadPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUploadPolicyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUploadPolicyWithFormdataBody(ctx context.Context, body GetUploadPolicyFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUploadPolicyRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Metrics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetricsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadyzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewLoginAdminRequest calls the generic LoginAdmin builder with application/json body
+func NewLoginAdminRequest(server string, body LoginAdminJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginAdminRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginAdminRequestWithBody generates requests for LoginAdmin with any type of body
+func NewLoginAdminRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyLoginTOTPRequest calls the generic VerifyLoginTOTP builder with application/json body
+func NewVerifyLoginTOTPRequest(server string, body VerifyLoginTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyLoginTOTPRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyLoginTOTPRequestWithBody generates requests for VerifyLoginTOTP with any type of body
+func NewVerifyLoginTOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/login/2fa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRegisterAdminRequest calls the generic RegisterAdmin builder with application/json body
+func NewRegisterAdminRequest(server string, body RegisterAdminJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterAdminRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegisterAdminRequestWithBody generates requests for RegisterAdmin with any type of body
+func NewRegisterAdminRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDisableTOTPRequest calls the generic DisableTOTP builder with application/json body
+func NewDisableTOTPRequest(server string, body DisableTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDisableTOTPRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDisableTOTPRequestWithBody generates requests for DisableTOTP with any type of body
+func NewDisableTOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/2fa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEnrollTOTPRequest generates requests for EnrollTOTP
+func NewEnrollTOTPRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/2fa/enroll")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRegenerateRecoveryCodesRequest calls the generic RegenerateRecoveryCodes builder with application/json body
+func NewRegenerateRecoveryCodesRequest(server string, body RegenerateRecoveryCodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegenerateRecoveryCodesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegenerateRecoveryCodesRequestWithBody generates requests for RegenerateRecoveryCodes with any type of body
+func NewRegenerateRecoveryCodesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/2fa/recovery-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewConfirmTOTPRequest calls the generic ConfirmTOTP builder with application/json body
+func NewConfirmTOTPRequest(server string, body ConfirmTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmTOTPRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConfirmTOTPRequestWithBody generates requests for ConfirmTOTP with any type of body
+func NewConfirmTOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/2fa/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListAPIKeysRequest generates requests for ListAPIKeys
+func NewListAPIKeysRequest(server string, params *ListAPIKeysParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateAPIKeyRequest calls the generic CreateAPIKey builder with application/json body
+func NewCreateAPIKeyRequest(server string, body CreateAPIKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAPIKeyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateAPIKeyRequestWithBody generates requests for CreateAPIKey with any type of body
+func NewCreateAPIKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeAPIKeyRequest generates requests for RevokeAPIKey
+func NewRevokeAPIKeyRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/api-keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAuditLogsRequest generates requests for ListAuditLogs
+func NewListAuditLogsRequest(server string, params *ListAuditLogsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/audit-logs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateAdminPasswordRequest calls the generic UpdateAdminPassword builder with application/json body
+func NewUpdateAdminPasswordRequest(server string, body UpdateAdminPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAdminPasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateAdminPasswordRequestWithBody generates requests for UpdateAdminPassword with any type of body
+func NewUpdateAdminPasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminRequest calls the generic DeleteAdmin builder with application/json body
+func NewDeleteAdminRequest(server string, body DeleteAdminJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteAdminRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeleteAdminRequestWithBody generates requests for DeleteAdmin with any type of body
+func NewDeleteAdminRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListAdminsRequest generates requests for ListAdmins
+func NewListAdminsRequest(server string, params *ListAdminsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Desc != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "desc", runtime.ParamLocationQuery, *params.Desc); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResetAdminTOTPRequest generates requests for ResetAdminTOTP
+func NewResetAdminTOTPRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/users/%s/2fa", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResetAdminPasswordRequest calls the generic ResetAdminPassword builder with application/json body
+func NewResetAdminPasswordRequest(server string, id ID, body ResetAdminPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResetAdminPasswordRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewResetAdminPasswordRequestWithBody generates requests for ResetAdminPassword with any type of body
+func NewResetAdminPasswordRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/users/%s/password", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListWebhooksRequest generates requests for ListWebhooks
+func NewListWebhooksRequest(server string, params *ListWebhooksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWebhookRequest calls the generic CreateWebhook builder with application/json body
+func NewCreateWebhookRequest(server string, body CreateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateWebhookRequestWithBody generates requests for CreateWebhook with any type of body
+func NewCreateWebhookRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWebhookRequest generates requests for DeleteWebhook
+func NewDeleteWebhookRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateWebhookRequest calls the generic UpdateWebhook builder with application/json body
+func NewUpdateWebhookRequest(server string, id ID, body UpdateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWebhookRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateWebhookRequestWithBody generates requests for UpdateWebhook with any type of body
+func NewUpdateWebhookRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListWebhookDeliveriesRequest generates requests for ListWebhookDeliveries
+func NewListWebhookDeliveriesRequest(server string, id ID, params *ListWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRetryWebhookDeliveryRequest generates requests for RetryWebhookDelivery
+func NewRetryWebhookDeliveryRequest(server string, id ID, deliveryId uint) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "delivery_id", runtime.ParamLocationPath, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admins/webhooks/%s/deliveries/%s/retry", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAdsRequest generates requests for ListAds
+func NewListAdsRequest(server string, params *ListAdsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/ads")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Desc != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "desc", runtime.ParamLocationQuery, *params.Desc); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateAdRequest calls the generic CreateAd builder with application/json body
+func NewCreateAdRequest(server string, body CreateAdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAdRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateAdRequestWithBody generates requests for CreateAd with any type of body
+func NewCreateAdRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/ads")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdRequest generates requests for DeleteAd
+func NewDeleteAdRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/ads/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdRequest generates requests for GetAd
+func NewGetAdRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/ads/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateAdRequest calls the generic UpdateAd builder with application/json body
+func NewUpdateAdRequest(server string, id ID, body UpdateAdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateAdRequestWithBody generates requests for UpdateAd with any type of body
+func NewUpdateAdRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/ads/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveBuildingsFromAdRequest calls the generic RemoveBuildingsFromAd builder with application/json body
+func NewRemoveBuildingsFromAdRequest(server string, id ID, body RemoveBuildingsFromAdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRemoveBuildingsFromAdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRemoveBuildingsFromAdRequestWithBody generates requests for RemoveBuildingsFromAd with any type of body
+func NewRemoveBuildingsFromAdRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/ads/%s/buildings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListAdBuildingsRequest generates requests for ListAdBuildings
+func NewListAdBuildingsRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/ads/%s/buildings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddBuildingsToAdRequest calls the generic AddBuildingsToAd builder with application/json body
+func NewAddBuildingsToAdRequest(server string, id ID, body AddBuildingsToAdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddBuildingsToAdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddBuildingsToAdRequestWithBody generates requests for AddBuildingsToAd with any type of body
+func NewAddBuildingsToAdRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/ads/%s/buildings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdChargesRequest generates requests for GetAdCharges
+func NewGetAdChargesRequest(server string, id ID, params *GetAdChargesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/ads/%s/charges", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rate_card_id", runtime.ParamLocationQuery, params.RateCardId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdImpressionsRequest generates requests for GetAdImpressions
+func NewGetAdImpressionsRequest(server string, id ID, params *GetAdImpressionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/ads/%s/impressions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListBuildingsRequest generates requests for ListBuildings
+func NewListBuildingsRequest(server string, params *ListBuildingsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Desc != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "desc", runtime.ParamLocationQuery, *params.Desc); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBuildingRequest calls the generic CreateBuilding builder with application/json body
+func NewCreateBuildingRequest(server string, body CreateBuildingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBuildingRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBuildingRequestWithBody generates requests for CreateBuilding with any type of body
+func NewCreateBuildingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportBuildingsRequestWithBody generates requests for ImportBuildings with any type of body
+func NewImportBuildingsRequestWithBody(server string, params *ImportBuildingsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteBuildingRequest generates requests for DeleteBuilding
+func NewDeleteBuildingRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBuildingRequest generates requests for GetBuilding
+func NewGetBuildingRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateBuildingRequest calls the generic UpdateBuilding builder with application/json body
+func NewUpdateBuildingRequest(server string, id ID, body UpdateBuildingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateBuildingRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateBuildingRequestWithBody generates requests for UpdateBuilding with any type of body
+func NewUpdateBuildingRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveAdsFromBuildingRequest calls the generic RemoveAdsFromBuilding builder with application/json body
+func NewRemoveAdsFromBuildingRequest(server string, id ID, body RemoveAdsFromBuildingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRemoveAdsFromBuildingRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRemoveAdsFromBuildingRequestWithBody generates requests for RemoveAdsFromBuilding with any type of body
+func NewRemoveAdsFromBuildingRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/ads", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListBuildingAdsRequest generates requests for ListBuildingAds
+func NewListBuildingAdsRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/ads", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddAdsToBuildingRequest calls the generic AddAdsToBuilding builder with application/json body
+func NewAddAdsToBuildingRequest(server string, id ID, body AddAdsToBuildingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddAdsToBuildingRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddAdsToBuildingRequestWithBody generates requests for AddAdsToBuilding with any type of body
+func NewAddAdsToBuildingRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/ads", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAssignPlacementDaypartRequest calls the generic AssignPlacementDaypart builder with application/json body
+func NewAssignPlacementDaypartRequest(server string, id ID, adId uint, body AssignPlacementDaypartJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAssignPlacementDaypartRequestWithBody(server, id, adId, "application/json", bodyReader)
+}
+
+// NewAssignPlacementDaypartRequestWithBody generates requests for AssignPlacementDaypart with any type of body
+func NewAssignPlacementDaypartRequestWithBody(server string, id ID, adId uint, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ad_id", runtime.ParamLocationPath, adId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/ads/%s/daypart", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdatePlacementZoneRequest calls the generic UpdatePlacementZone builder with application/json body
+func NewUpdatePlacementZoneRequest(server string, id ID, adId uint, body UpdatePlacementZoneJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePlacementZoneRequestWithBody(server, id, adId, "application/json", bodyReader)
+}
+
+// NewUpdatePlacementZoneRequestWithBody generates requests for UpdatePlacementZone with any type of body
+func NewUpdatePlacementZoneRequestWithBody(server string, id ID, adId uint, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ad_id", runtime.ParamLocationPath, adId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/ads/%s/zone", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBuildingEligibilityRequest generates requests for GetBuildingEligibility
+func NewGetBuildingEligibilityRequest(server string, id ID, params *GetBuildingEligibilityParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/eligibility", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.At != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "at", runtime.ParamLocationQuery, *params.At); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListExclusivityRulesRequest generates requests for ListExclusivityRules
+func NewListExclusivityRulesRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/exclusivity-rules", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateExclusivityRuleRequest calls the generic CreateExclusivityRule builder with application/json body
+func NewCreateExclusivityRuleRequest(server string, id ID, body CreateExclusivityRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateExclusivityRuleRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateExclusivityRuleRequestWithBody generates requests for CreateExclusivityRule with any type of body
+func NewCreateExclusivityRuleRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/exclusivity-rules", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteExclusivityRuleRequest generates requests for DeleteExclusivityRule
+func NewDeleteExclusivityRuleRequest(server string, id ID, ruleId uint) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "rule_id", runtime.ParamLocationPath, ruleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/exclusivity-rules/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateExclusivityRuleRequest calls the generic UpdateExclusivityRule builder with application/json body
+func NewUpdateExclusivityRuleRequest(server string, id ID, ruleId uint, body UpdateExclusivityRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateExclusivityRuleRequestWithBody(server, id, ruleId, "application/json", bodyReader)
+}
+
+// NewUpdateExclusivityRuleRequestWithBody generates requests for UpdateExclusivityRule with any type of body
+func NewUpdateExclusivityRuleRequestWithBody(server string, id ID, ruleId uint, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "rule_id", runtime.ParamLocationPath, ruleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/exclusivity-rules/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBuildingImpressionsRequest generates requests for GetBuildingImpressions
+func NewGetBuildingImpressionsRequest(server string, id ID, params *GetBuildingImpressionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/impressions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBuildingLayoutRequest generates requests for GetBuildingLayout
+func NewGetBuildingLayoutRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/layout", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAssignBuildingLayoutRequest calls the generic AssignBuildingLayout builder with application/json body
+func NewAssignBuildingLayoutRequest(server string, id ID, body AssignBuildingLayoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAssignBuildingLayoutRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAssignBuildingLayoutRequestWithBody generates requests for AssignBuildingLayout with any type of body
+func NewAssignBuildingLayoutRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/layout", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBuildingManifestRequest generates requests for GetBuildingManifest
+func NewGetBuildingManifestRequest(server string, id ID, params *GetBuildingManifestParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/manifest", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XDeviceID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Device-ID", runtime.ParamLocationHeader, *params.XDeviceID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Device-ID", headerParam0)
+		}
+
+		if params.IfNoneMatch != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetBuildingManifestDeltaRequest generates requests for GetBuildingManifestDelta
+func NewGetBuildingManifestDeltaRequest(server string, id ID, params *GetBuildingManifestDeltaParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/manifest/delta", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XDeviceID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Device-ID", runtime.ParamLocationHeader, *params.XDeviceID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Device-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewRemoveNoticesFromBuildingRequest calls the generic RemoveNoticesFromBuilding builder with application/json body
+func NewRemoveNoticesFromBuildingRequest(server string, id ID, body RemoveNoticesFromBuildingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRemoveNoticesFromBuildingRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRemoveNoticesFromBuildingRequestWithBody generates requests for RemoveNoticesFromBuilding with any type of body
+func NewRemoveNoticesFromBuildingRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/notices", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListBuildingNoticesRequest generates requests for ListBuildingNotices
+func NewListBuildingNoticesRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/notices", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddNoticesToBuildingRequest calls the generic AddNoticesToBuilding builder with application/json body
+func NewAddNoticesToBuildingRequest(server string, id ID, body AddNoticesToBuildingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddNoticesToBuildingRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddNoticesToBuildingRequestWithBody generates requests for AddNoticesToBuilding with any type of body
+func NewAddNoticesToBuildingRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/notices", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListActiveBuildingNoticesRequest generates requests for ListActiveBuildingNotices
+func NewListActiveBuildingNoticesRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/notices/active", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBuildingPreviewRequest generates requests for GetBuildingPreview
+func NewGetBuildingPreviewRequest(server string, id ID, params *GetBuildingPreviewParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/preview", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListBuildingTrafficRequest generates requests for ListBuildingTraffic
+func NewListBuildingTrafficRequest(server string, id ID, params *ListBuildingTrafficParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/traffic", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutBuildingTrafficRequest calls the generic PutBuildingTraffic builder with application/json body
+func NewPutBuildingTrafficRequest(server string, id ID, body PutBuildingTrafficJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutBuildingTrafficRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutBuildingTrafficRequestWithBody generates requests for PutBuildingTraffic with any type of body
+func NewPutBuildingTrafficRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/traffic", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportBuildingTrafficRequestWithBody generates requests for ImportBuildingTraffic with any type of body
+func NewImportBuildingTrafficRequestWithBody(server string, id ID, params *ImportBuildingTrafficParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buildings/%s/traffic/import", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListCalendarsRequest generates requests for ListCalendars
+func NewListCalendarsRequest(server string, params *ListCalendarsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateCalendarRequest calls the generic CreateCalendar builder with application/json body
+func NewCreateCalendarRequest(server string, body CreateCalendarJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCalendarRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateCalendarRequestWithBody generates requests for CreateCalendar with any type of body
+func NewCreateCalendarRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteCalendarRequest generates requests for DeleteCalendar
+func NewDeleteCalendarRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCalendarRequest generates requests for GetCalendar
+func NewGetCalendarRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCalendarRequest calls the generic UpdateCalendar builder with application/json body
+func NewUpdateCalendarRequest(server string, id ID, body UpdateCalendarJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCalendarRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateCalendarRequestWithBody generates requests for UpdateCalendar with any type of body
+func NewUpdateCalendarRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListCalendarEventsRequest generates requests for ListCalendarEvents
+func NewListCalendarEventsRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateCalendarEventRequest calls the generic CreateCalendarEvent builder with application/json body
+func NewCreateCalendarEventRequest(server string, id ID, body CreateCalendarEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCalendarEventRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateCalendarEventRequestWithBody generates requests for CreateCalendarEvent with any type of body
+func NewCreateCalendarEventRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteCalendarEventRequest generates requests for DeleteCalendarEvent
+func NewDeleteCalendarEventRequest(server string, id ID, eventId uint) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "event_id", runtime.ParamLocationPath, eventId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/events/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportCalendarRequestWithBody generates requests for ImportCalendar with any type of body
+func NewImportCalendarRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/import", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListDaypartsRequest generates requests for ListDayparts
+func NewListDaypartsRequest(server string, params *ListDaypartsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/dayparts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDaypartRequest calls the generic CreateDaypart builder with application/json body
+func NewCreateDaypartRequest(server string, body CreateDaypartJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDaypartRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateDaypartRequestWithBody generates requests for CreateDaypart with any type of body
+func NewCreateDaypartRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/dayparts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDaypartRequest generates requests for DeleteDaypart
+func NewDeleteDaypartRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/dayparts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDaypartRequest generates requests for GetDaypart
+func NewGetDaypartRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/dayparts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDaypartRequest calls the generic UpdateDaypart builder with application/json body
+func NewUpdateDaypartRequest(server string, id ID, body UpdateDaypartJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDaypartRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateDaypartRequestWithBody generates requests for UpdateDaypart with any type of body
+func NewUpdateDaypartRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/dayparts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExportAdsRequest generates requests for ExportAds
+func NewExportAdsRequest(server string, params *ExportAdsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/exports/ads")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportAdCertificateRequest generates requests for ExportAdCertificate
+func NewExportAdCertificateRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/exports/ads/%s/certificate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportBuildingsRequest generates requests for ExportBuildings
+func NewExportBuildingsRequest(server string, params *ExportBuildingsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/exports/buildings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportInvoiceRequest generates requests for ExportInvoice
+func NewExportInvoiceRequest(server string, id ID, params *ExportInvoiceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/exports/invoices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportPlacementsRequest generates requests for ExportPlacements
+func NewExportPlacementsRequest(server string, params *ExportPlacementsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/exports/placements")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInvoicesRequest generates requests for ListInvoices
+func NewListInvoicesRequest(server string, params *ListInvoicesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AdvertisementId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "advertisement_id", runtime.ParamLocationQuery, *params.AdvertisementId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInvoiceRequest calls the generic CreateInvoice builder with application/json body
+func NewCreateInvoiceRequest(server string, body CreateInvoiceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInvoiceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateInvoiceRequestWithBody generates requests for CreateInvoice with any type of body
+func NewCreateInvoiceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInvoiceRequest generates requests for GetInvoice
+func NewGetInvoiceRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVoidInvoiceRequest generates requests for VoidInvoice
+func NewVoidInvoiceRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/void", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListLayoutsRequest generates requests for ListLayouts
+func NewListLayoutsRequest(server string, params *ListLayoutsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/layouts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateLayoutRequest calls the generic CreateLayout builder with application/json body
+func NewCreateLayoutRequest(server string, body CreateLayoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateLayoutRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateLayoutRequestWithBody generates requests for CreateLayout with any type of body
+func NewCreateLayoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/layouts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteLayoutRequest generates requests for DeleteLayout
+func NewDeleteLayoutRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/layouts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLayoutRequest generates requests for GetLayout
+func NewGetLayoutRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/layouts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateLayoutRequest calls the generic UpdateLayout builder with application/json body
+func NewUpdateLayoutRequest(server string, id ID, body UpdateLayoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateLayoutRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateLayoutRequestWithBody generates requests for UpdateLayout with any type of body
+func NewUpdateLayoutRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/layouts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetManifestPublicKeyRequest generates requests for GetManifestPublicKey
+func NewGetManifestPublicKeyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/manifest/public-key")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListNoticesRequest generates requests for ListNotices
+func NewListNoticesRequest(server string, params *ListNoticesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Desc != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "desc", runtime.ParamLocationQuery, *params.Desc); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateNoticeRequest calls the generic CreateNotice builder with application/json body
+func NewCreateNoticeRequest(server string, body CreateNoticeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateNoticeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateNoticeRequestWithBody generates requests for CreateNotice with any type of body
+func NewCreateNoticeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteNoticeRequest generates requests for DeleteNotice
+func NewDeleteNoticeRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetNoticeRequest generates requests for GetNotice
+func NewGetNoticeRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateNoticeRequest calls the generic UpdateNotice builder with application/json body
+func NewUpdateNoticeRequest(server string, id ID, body UpdateNoticeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateNoticeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateNoticeRequestWithBody generates requests for UpdateNotice with any type of body
+func NewUpdateNoticeRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveBuildingsFromNoticeRequest calls the generic RemoveBuildingsFromNotice builder with application/json body
+func NewRemoveBuildingsFromNoticeRequest(server string, id ID, body RemoveBuildingsFromNoticeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRemoveBuildingsFromNoticeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRemoveBuildingsFromNoticeRequestWithBody generates requests for RemoveBuildingsFromNotice with any type of body
+func NewRemoveBuildingsFromNoticeRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notices/%s/buildings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListNoticeBuildingsRequest generates requests for ListNoticeBuildings
+func NewListNoticeBuildingsRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notices/%s/buildings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddBuildingsToNoticeRequest calls the generic AddBuildingsToNotice builder with application/json body
+func NewAddBuildingsToNoticeRequest(server string, id ID, body AddBuildingsToNoticeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddBuildingsToNoticeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddBuildingsToNoticeRequestWithBody generates requests for AddBuildingsToNotice with any type of body
+func NewAddBuildingsToNoticeRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notices/%s/buildings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetOpenAPIRequest generates requests for GetOpenAPI
+func NewGetOpenAPIRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/openapi.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRateCardsRequest generates requests for ListRateCards
+func NewListRateCardsRequest(server string, params *ListRateCardsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rate-cards")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageNum != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageNum", runtime.ParamLocationQuery, *params.PageNum); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRateCardRequest calls the generic CreateRateCard builder with application/json body
+func NewCreateRateCardRequest(server string, body CreateRateCardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRateCardRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRateCardRequestWithBody generates requests for CreateRateCard with any type of body
+func NewCreateRateCardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rate-cards")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRateCardRequest generates requests for DeleteRateCard
+func NewDeleteRateCardRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rate-cards/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRateCardRequest generates requests for GetRateCard
+func NewGetRateCardRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rate-cards/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateRateCardRequest calls the generic UpdateRateCard builder with application/json body
+func NewUpdateRateCardRequest(server string, id ID, body UpdateRateCardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRateCardRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateRateCardRequestWithBody generates requests for UpdateRateCard with any type of body
+func NewUpdateRateCardRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rate-cards/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUploadPolicyRequest calls the generic GetUploadPolicy builder with application/json body
+func NewGetUploadPolicyRequest(server string, body GetUploadPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGetUploadPolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGetUploadPolicyRequestWithFormdataBody calls the generic GetUploadPolicy builder with application/x-www-form-urlencoded body
+func NewGetUploadPolicyRequestWithFormdataBody(server string, body GetUploadPolicyFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewGetUploadPolicyRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewGetUploadPolicyRequestWithBody generates requests for GetUploadPolicy with any type of body
+func NewGetUploadPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/upload/policy")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHealthzRequest generates requests for Healthz
+func NewHealthzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/healthz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetricsRequest generates requests for Metrics
+func NewMetricsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metrics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadyzRequest generates requests for Readyz
+func NewReadyzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/readyz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// LoginAdminWithBodyWithResponse request with any body
+	LoginAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginAdminResponse, error)
+
+	LoginAdminWithResponse(ctx context.Context, body LoginAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginAdminResponse, error)
+
+	// VerifyLoginTOTPWithBodyWithResponse request with any body
+	VerifyLoginTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyLoginTOTPResponse, error)
+
+	VerifyLoginTOTPWithResponse(ctx context.Context, body VerifyLoginTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyLoginTOTPResponse, error)
+
+	// RegisterAdminWithBodyWithResponse request with any body
+	RegisterAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterAdminResponse, error)
+
+	RegisterAdminWithResponse(ctx context.Context, body RegisterAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterAdminResponse, error)
+
+	// DisableTOTPWithBodyWithResponse request with any body
+	DisableTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error)
+
+	DisableTOTPWithResponse(ctx context.Context, body DisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error)
+
+	// EnrollTOTPWithResponse request
+	EnrollTOTPWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnrollTOTPResponse, error)
+
+	// RegenerateRecoveryCodesWithBodyWithResponse request with any body
+	RegenerateRecoveryCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error)
+
+	RegenerateRecoveryCodesWithResponse(ctx context.Context, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error)
+
+	// ConfirmTOTPWithBodyWithResponse request with any body
+	ConfirmTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTOTPResponse, error)
+
+	ConfirmTOTPWithResponse(ctx context.Context, body ConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTOTPResponse, error)
+
+	// ListAPIKeysWithResponse request
+	ListAPIKeysWithResponse(ctx context.Context, params *ListAPIKeysParams, reqEditors ...RequestEditorFn) (*ListAPIKeysResponse, error)
+
+	// CreateAPIKeyWithBodyWithResponse request with any body
+	CreateAPIKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error)
+
+	CreateAPIKeyWithResponse(ctx context.Context, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error)
+
+	// RevokeAPIKeyWithResponse request
+	RevokeAPIKeyWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*RevokeAPIKeyResponse, error)
+
+	// ListAuditLogsWithResponse request
+	ListAuditLogsWithResponse(ctx context.Context, params *ListAuditLogsParams, reqEditors ...RequestEditorFn) (*ListAuditLogsResponse, error)
+
+	// UpdateAdminPasswordWithBodyWithResponse request with any body
+	UpdateAdminPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAdminPasswordResponse, error)
+
+	UpdateAdminPasswordWithResponse(ctx context.Context, body UpdateAdminPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAdminPasswordResponse, error)
+
+	// DeleteAdminWithBodyWithResponse request with any body
+	DeleteAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteAdminResponse, error)
+
+	DeleteAdminWithResponse(ctx context.Context, body DeleteAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteAdminResponse, error)
+
+	// ListAdminsWithResponse request
+	ListAdminsWithResponse(ctx context.Context, params *ListAdminsParams, reqEditors ...RequestEditorFn) (*ListAdminsResponse, error)
+
+	// ResetAdminTOTPWithResponse request
+	ResetAdminTOTPWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ResetAdminTOTPResponse, error)
+
+	// ResetAdminPasswordWithBodyWithResponse request with any body
+	ResetAdminPasswordWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetAdminPasswordResponse, error)
+
+	ResetAdminPasswordWithResponse(ctx context.Context, id ID, body ResetAdminPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetAdminPasswordResponse, error)
+
+	// ListWebhooksWithResponse request
+	ListWebhooksWithResponse(ctx context.Context, params *ListWebhooksParams, reqEditors ...RequestEditorFn) (*ListWebhooksResponse, error)
+
+	// CreateWebhookWithBodyWithResponse request with any body
+	CreateWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	CreateWebhookWithResponse(ctx context.Context, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	// DeleteWebhookWithResponse request
+	DeleteWebhookWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error)
+
+	// UpdateWebhookWithBodyWithResponse request with any body
+	UpdateWebhookWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+
+	UpdateWebhookWithResponse(ctx context.Context, id ID, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+
+	// ListWebhookDeliveriesWithResponse request
+	ListWebhookDeliveriesWithResponse(ctx context.Context, id ID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ListWebhookDeliveriesResponse, error)
+
+	// RetryWebhookDeliveryWithResponse request
+	RetryWebhookDeliveryWithResponse(ctx context.Context, id ID, deliveryId uint, reqEditors ...RequestEditorFn) (*RetryWebhookDeliveryResponse, error)
+
+	// ListAdsWithResponse request
+	ListAdsWithResponse(ctx context.Context, params *ListAdsParams, reqEditors ...RequestEditorFn) (*ListAdsResponse, error)
+
+	// CreateAdWithBodyWithResponse request with any body
+	CreateAdWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAdResponse, error)
+
+	CreateAdWithResponse(ctx context.Context, body CreateAdJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAdResponse, error)
+
+	// DeleteAdWithResponse request
+	DeleteAdWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteAdResponse, error)
+
+	// GetAdWithResponse request
+	GetAdWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetAdResponse, error)
+
+	// UpdateAdWithBodyWithResponse request with any body
+	UpdateAdWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAdResponse, error)
+
+	UpdateAdWithResponse(ctx context.Context, id ID, body UpdateAdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAdResponse, error)
+
+	// RemoveBuildingsFromAdWithBodyWithResponse request with any body
+	RemoveBuildingsFromAdWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveBuildingsFromAdResponse, error)
+
+	RemoveBuildingsFromAdWithResponse(ctx context.Context, id ID, body RemoveBuildingsFromAdJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveBuildingsFromAdResponse, error)
+
+	// ListAdBuildingsWithResponse request
+	ListAdBuildingsWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListAdBuildingsResponse, error)
+
+	// AddBuildingsToAdWithBodyWithResponse request with any body
+	AddBuildingsToAdWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddBuildingsToAdResponse, error)
+
+	AddBuildingsToAdWithResponse(ctx context.Context, id ID, body AddBuildingsToAdJSONRequestBody, reqEditors ...RequestEditorFn) (*AddBuildingsToAdResponse, error)
+
+	// GetAdChargesWithResponse request
+	GetAdChargesWithResponse(ctx context.Context, id ID, params *GetAdChargesParams, reqEditors ...RequestEditorFn) (*GetAdChargesResponse, error)
+
+	// GetAdImpressionsWithResponse request
+	GetAdImpressionsWithResponse(ctx context.Context, id ID, params *GetAdImpressionsParams, reqEditors ...RequestEditorFn) (*GetAdImpressionsResponse, error)
+
+	// ListBuildingsWithResponse request
+	ListBuildingsWithResponse(ctx context.Context, params *ListBuildingsParams, reqEditors ...RequestEditorFn) (*ListBuildingsResponse, error)
+
+	// CreateBuildingWithBodyWithResponse request with any body
+	CreateBuildingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBuildingResponse, error)
+
+	CreateBuildingWithResponse(ctx context.Context, body CreateBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBuildingResponse, error)
+
+	// ImportBuildingsWithBodyWithResponse request with any body
+	ImportBuildingsWithBodyWithResponse(ctx context.Context, params *ImportBuildingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportBuildingsResponse, error)
+
+	// DeleteBuildingWithResponse request
+	DeleteBuildingWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteBuildingResponse, error)
+
+	// GetBuildingWithResponse request
+	GetBuildingWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetBuildingResponse, error)
+
+	// UpdateBuildingWithBodyWithResponse request with any body
+	UpdateBuildingWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBuildingResponse, error)
+
+	UpdateBuildingWithResponse(ctx context.Context, id ID, body UpdateBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBuildingResponse, error)
+
+	// RemoveAdsFromBuildingWithBodyWithResponse request with any body
+	RemoveAdsFromBuildingWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveAdsFromBuildingResponse, error)
+
+	RemoveAdsFromBuildingWithResponse(ctx context.Context, id ID, body RemoveAdsFromBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveAdsFromBuildingResponse, error)
+
+	// ListBuildingAdsWithResponse request
+	ListBuildingAdsWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListBuildingAdsResponse, error)
+
+	// AddAdsToBuildingWithBodyWithResponse request with any body
+	AddAdsToBuildingWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAdsToBuildingResponse, error)
+
+	AddAdsToBuildingWithResponse(ctx context.Context, id ID, body AddAdsToBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddAdsToBuildingResponse, error)
+
+	// AssignPlacementDaypartWithBodyWithResponse request with any body
+	AssignPlacementDaypartWithBodyWithResponse(ctx context.Context, id ID, adId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignPlacementDaypartResponse, error)
+
+	AssignPlacementDaypartWithResponse(ctx context.Context, id ID, adId uint, body AssignPlacementDaypartJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignPlacementDaypartResponse, error)
+
+	// UpdatePlacementZoneWithBodyWithResponse request with any body
+	UpdatePlacementZoneWithBodyWithResponse(ctx context.Context, id ID, adId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePlacementZoneResponse, error)
+
+	UpdatePlacementZoneWithResponse(ctx context.Context, id ID, adId uint, body UpdatePlacementZoneJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePlacementZoneResponse, error)
+
+	// GetBuildingEligibilityWithResponse request
+	GetBuildingEligibilityWithResponse(ctx context.Context, id ID, params *GetBuildingEligibilityParams, reqEditors ...RequestEditorFn) (*GetBuildingEligibilityResponse, error)
+
+	// ListExclusivityRulesWithResponse request
+	ListExclusivityRulesWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListExclusivityRulesResponse, error)
+
+	// CreateExclusivityRuleWithBodyWithResponse request with any body
+	CreateExclusivityRuleWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateExclusivityRuleResponse, error)
+
+	CreateExclusivityRuleWithResponse(ctx context.Context, id ID, body CreateExclusivityRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateExclusivityRuleResponse, error)
+
+	// DeleteExclusivityRuleWithResponse request
+	DeleteExclusivityRuleWithResponse(ctx context.Context, id ID, ruleId uint, reqEditors ...RequestEditorFn) (*DeleteExclusivityRuleResponse, error)
+
+	// UpdateExclusivityRuleWithBodyWithResponse request with any body
+	UpdateExclusivityRuleWithBodyWithResponse(ctx context.Context, id ID, ruleId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateExclusivityRuleResponse, error)
+
+	UpdateExclusivityRuleWithResponse(ctx context.Context, id ID, ruleId uint, body UpdateExclusivityRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateExclusivityRuleResponse, error)
+
+	// GetBuildingImpressionsWithResponse request
+	GetBuildingImpressionsWithResponse(ctx context.Context, id ID, params *GetBuildingImpressionsParams, reqEditors ...RequestEditorFn) (*GetBuildingImpressionsResponse, error)
+
+	// GetBuildingLayoutWithResponse request
+	GetBuildingLayoutWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetBuildingLayoutResponse, error)
+
+	// AssignBuildingLayoutWithBodyWithResponse request with any body
+	AssignBuildingLayoutWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignBuildingLayoutResponse, error)
+
+	AssignBuildingLayoutWithResponse(ctx context.Context, id ID, body AssignBuildingLayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignBuildingLayoutResponse, error)
+
+	// GetBuildingManifestWithResponse request
+	GetBuildingManifestWithResponse(ctx context.Context, id ID, params *GetBuildingManifestParams, reqEditors ...RequestEditorFn) (*GetBuildingManifestResponse, error)
+
+	// GetBuildingManifestDeltaWithResponse request
+	GetBuildingManifestDeltaWithResponse(ctx context.Context, id ID, params *GetBuildingManifestDeltaParams, reqEditors ...RequestEditorFn) (*GetBuildingManifestDeltaResponse, error)
+
+	// RemoveNoticesFromBuildingWithBodyWithResponse request with any body
+	RemoveNoticesFromBuildingWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveNoticesFromBuildingResponse, error)
+
+	RemoveNoticesFromBuildingWithResponse(ctx context.Context, id ID, body RemoveNoticesFromBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveNoticesFromBuildingResponse, error)
+
+	// ListBuildingNoticesWithResponse request
+	ListBuildingNoticesWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListBuildingNoticesResponse, error)
+
+	// AddNoticesToBuildingWithBodyWithResponse request with any body
+	AddNoticesToBuildingWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddNoticesToBuildingResponse, error)
+
+	AddNoticesToBuildingWithResponse(ctx context.Context, id ID, body AddNoticesToBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddNoticesToBuildingResponse, error)
+
+	// ListActiveBuildingNoticesWithResponse request
+	ListActiveBuildingNoticesWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListActiveBuildingNoticesResponse, error)
+
+	// GetBuildingPreviewWithResponse request
+	GetBuildingPreviewWithResponse(ctx context.Context, id ID, params *GetBuildingPreviewParams, reqEditors ...RequestEditorFn) (*GetBuildingPreviewResponse, error)
+
+	// ListBuildingTrafficWithResponse request
+	ListBuildingTrafficWithResponse(ctx context.Context, id ID, params *ListBuildingTrafficParams, reqEditors ...RequestEditorFn) (*ListBuildingTrafficResponse, error)
+
+	// PutBuildingTrafficWithBodyWithResponse request with any body
+	PutBuildingTrafficWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBuildingTrafficResponse, error)
+
+	PutBuildingTrafficWithResponse(ctx context.Context, id ID, body PutBuildingTrafficJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBuildingTrafficResponse, error)
+
+	// ImportBuildingTrafficWithBodyWithResponse request with any body
+	ImportBuildingTrafficWithBodyWithResponse(ctx context.Context, id ID, params *ImportBuildingTrafficParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportBuildingTrafficResponse, error)
+
+	// ListCalendarsWithResponse request
+	ListCalendarsWithResponse(ctx context.Context, params *ListCalendarsParams, reqEditors ...RequestEditorFn) (*ListCalendarsResponse, error)
+
+	// CreateCalendarWithBodyWithResponse request with any body
+	CreateCalendarWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCalendarResponse, error)
+
+	CreateCalendarWithResponse(ctx context.Context, body CreateCalendarJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCalendarResponse, error)
+
+	// DeleteCalendarWithResponse request
+	DeleteCalendarWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteCalendarResponse, error)
+
+	// GetCalendarWithResponse request
+	GetCalendarWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetCalendarResponse, error)
+
+	// UpdateCalendarWithBodyWithResponse request with any body
+	UpdateCalendarWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCalendarResponse, error)
+
+	UpdateCalendarWithResponse(ctx context.Context, id ID, body UpdateCalendarJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCalendarResponse, error)
+
+	// ListCalendarEventsWithResponse request
+	ListCalendarEventsWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListCalendarEventsResponse, error)
+
+	// CreateCalendarEventWithBodyWithResponse request with any body
+	CreateCalendarEventWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCalendarEventResponse, error)
+
+	CreateCalendarEventWithResponse(ctx context.Context, id ID, body CreateCalendarEventJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCalendarEventResponse, error)
+
+	// DeleteCalendarEventWithResponse request
+	DeleteCalendarEventWithResponse(ctx context.Context, id ID, eventId uint, reqEditors ...RequestEditorFn) (*DeleteCalendarEventResponse, error)
+
+	// ImportCalendarWithBodyWithResponse request with any body
+	ImportCalendarWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportCalendarResponse, error)
+
+	// ListDaypartsWithResponse request
+	ListDaypartsWithResponse(ctx context.Context, params *ListDaypartsParams, reqEditors ...RequestEditorFn) (*ListDaypartsResponse, error)
+
+	// CreateDaypartWithBodyWithResponse request with any body
+	CreateDaypartWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDaypartResponse, error)
+
+	CreateDaypartWithResponse(ctx context.Context, body CreateDaypartJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDaypartResponse, error)
+
+	// DeleteDaypartWithResponse request
+	DeleteDaypartWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteDaypartResponse, error)
+
+	// GetDaypartWithResponse request
+	GetDaypartWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetDaypartResponse, error)
+
+	// UpdateDaypartWithBodyWithResponse request with any body
+	UpdateDaypartWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDaypartResponse, error)
+
+	UpdateDaypartWithResponse(ctx context.Context, id ID, body UpdateDaypartJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDaypartResponse, error)
+
+	// ExportAdsWithResponse request
+	ExportAdsWithResponse(ctx context.Context, params *ExportAdsParams, reqEditors ...RequestEditorFn) (*ExportAdsResponse, error)
+
+	// ExportAdCertificateWithResponse request
+	ExportAdCertificateWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ExportAdCertificateResponse, error)
+
+	// ExportBuildingsWithResponse request
+	ExportBuildingsWithResponse(ctx context.Context, params *ExportBuildingsParams, reqEditors ...RequestEditorFn) (*ExportBuildingsResponse, error)
+
+	// ExportInvoiceWithResponse request
+	ExportInvoiceWithResponse(ctx context.Context, id ID, params *ExportInvoiceParams, reqEditors ...RequestEditorFn) (*ExportInvoiceResponse, error)
+
+	// ExportPlacementsWithResponse request
+	ExportPlacementsWithResponse(ctx context.Context, params *ExportPlacementsParams, reqEditors ...RequestEditorFn) (*ExportPlacementsResponse, error)
+
+	// ListInvoicesWithResponse request
+	ListInvoicesWithResponse(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*ListInvoicesResponse, error)
+
+	// CreateInvoiceWithBodyWithResponse request with any body
+	CreateInvoiceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error)
+
+	CreateInvoiceWithResponse(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error)
+
+	// GetInvoiceWithResponse request
+	GetInvoiceWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetInvoiceResponse, error)
+
+	// VoidInvoiceWithResponse request
+	VoidInvoiceWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*VoidInvoiceResponse, error)
+
+	// ListLayoutsWithResponse request
+	ListLayoutsWithResponse(ctx context.Context, params *ListLayoutsParams, reqEditors ...RequestEditorFn) (*ListLayoutsResponse, error)
+
+	// CreateLayoutWithBodyWithResponse request with any body
+	CreateLayoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLayoutResponse, error)
+
+	CreateLayoutWithResponse(ctx context.Context, body CreateLayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLayoutResponse, error)
+
+	// DeleteLayoutWithResponse request
+	DeleteLayoutWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteLayoutResponse, error)
+
+	// GetLayoutWithResponse request
+	GetLayoutWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetLayoutResponse, error)
+
+	// UpdateLayoutWithBodyWithResponse request with any body
+	UpdateLayoutWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLayoutResponse, error)
+
+	UpdateLayoutWithResponse(ctx context.Context, id ID, body UpdateLayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLayoutResponse, error)
+
+	// GetManifestPublicKeyWithResponse request
+	GetManifestPublicKeyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetManifestPublicKeyResponse, error)
+
+	// ListNoticesWithResponse request
+	ListNoticesWithResponse(ctx context.Context, params *ListNoticesParams, reqEditors ...RequestEditorFn) (*ListNoticesResponse, error)
+
+	// CreateNoticeWithBodyWithResponse request with any body
+	CreateNoticeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNoticeResponse, error)
+
+	CreateNoticeWithResponse(ctx context.Context, body CreateNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateNoticeResponse, error)
+
+	// DeleteNoticeWithResponse request
+	DeleteNoticeWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteNoticeResponse, error)
+
+	// GetNoticeWithResponse request
+	GetNoticeWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetNoticeResponse, error)
+
+	// UpdateNoticeWithBodyWithResponse request with any body
+	UpdateNoticeWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNoticeResponse, error)
+
+	UpdateNoticeWithResponse(ctx context.Context, id ID, body UpdateNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNoticeResponse, error)
+
+	// RemoveBuildingsFromNoticeWithBodyWithResponse request with any body
+	RemoveBuildingsFromNoticeWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveBuildingsFromNoticeResponse, error)
+
+	RemoveBuildingsFromNoticeWithResponse(ctx context.Context, id ID, body RemoveBuildingsFromNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveBuildingsFromNoticeResponse, error)
+
+	// ListNoticeBuildingsWithResponse request
+	ListNoticeBuildingsWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListNoticeBuildingsResponse, error)
+
+	// AddBuildingsToNoticeWithBodyWithResponse request with any body
+	AddBuildingsToNoticeWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddBuildingsToNoticeResponse, error)
+
+	AddBuildingsToNoticeWithResponse(ctx context.Context, id ID, body AddBuildingsToNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*AddBuildingsToNoticeResponse, error)
+
+	// GetOpenAPIWithResponse request
+	GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error)
+
+	// ListRateCardsWithResponse request
+	ListRateCardsWithResponse(ctx context.Context, params *ListRateCardsParams, reqEditors ...RequestEditorFn) (*ListRateCardsResponse, error)
+
+	// CreateRateCardWithBodyWithResponse request with any body
+	CreateRateCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRateCardResponse, error)
+
+	CreateRateCardWithResponse(ctx context.Context, body CreateRateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRateCardResponse, error)
+
+	// DeleteRateCardWithResponse request
+	DeleteRateCardWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteRateCardResponse, error)
+
+	// GetRateCardWithResponse request
+	GetRateCardWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetRateCardResponse, error)
+
+	// UpdateRateCardWithBodyWithResponse request with any body
+	UpdateRateCardWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRateCardResponse, error)
+
+	UpdateRateCardWithResponse(ctx context.Context, id ID, body UpdateRateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRateCardResponse, error)
+
+	// GetUploadPolicyWithBodyWithResponse request with any body
+	GetUploadPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetUploadPolicyResponse, error)
+
+	GetUploadPolicyWithResponse(ctx context.Context, body GetUploadPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*GetUploadPolicyResponse, error)
+
+	GetUploadPolicyWithFormdataBodyWithResponse(ctx context.Context, body GetUploadPolicyFormdataRequestBody, reqEditors ...RequestEditorFn) (*GetUploadPolicyResponse, error)
+
+	// HealthzWithResponse request
+	HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error)
+
+	// MetricsWithResponse request
+	MetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetricsResponse, error)
+
+	// ReadyzWithResponse request
+	ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResponse, error)
+}
+
+type LoginAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON423      *Locked
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyLoginTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON423      *Locked
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyLoginTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyLoginTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Message
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisableTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnrollTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TOTPEnrollment
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r EnrollTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnrollTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegenerateRecoveryCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodes
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RegenerateRecoveryCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegenerateRecoveryCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodes
+	JSON400      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAPIKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIKeyPage
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAPIKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAPIKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAPIKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *APIKeyCreated
+	JSON400      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAPIKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAPIKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeAPIKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeAPIKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeAPIKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAuditLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditLogPage
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuditLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuditLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAdminPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAdminPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAdminPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAdminsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdministratorPage
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAdminsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAdminsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetAdminTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetAdminTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetAdminTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetAdminPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetAdminPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetAdminPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookPage
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WebhookCreated
+	JSON400      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDeliveryPage
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhookDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RetryWebhookDeliveryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDelivery
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RetryWebhookDeliveryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RetryWebhookDeliveryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAdsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdvertisementPage
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAdsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAdsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Advertisement
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Advertisement
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Advertisement
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *ExclusivityConflictError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveBuildingsFromAdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveBuildingsFromAdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveBuildingsFromAdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAdBuildingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BuildingList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAdBuildingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAdBuildingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddBuildingsToAdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *ExclusivityConflictError
+}
+
+// Status returns HTTPResponse.Status
+func (r AddBuildingsToAdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddBuildingsToAdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdChargesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Charges
+	JSON400      *Error
+	JSON404      *Error
+	JSON422      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdChargesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdChargesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdImpressionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdImpressionReport
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdImpressionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdImpressionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBuildingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BuildingPage
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBuildingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBuildingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBuildingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Building
+	JSON400      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBuildingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBuildingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportBuildingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BuildingImportReport
+	JSON201      *BuildingImportReport
+	JSON400      *Error
+	JSON409      *Error
+	JSON422      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportBuildingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportBuildingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBuildingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBuildingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBuildingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Building
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBuildingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Building
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateBuildingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBuildingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveAdsFromBuildingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveAdsFromBuildingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveAdsFromBuildingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBuildingAdsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdvertisementList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBuildingAdsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBuildingAdsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddAdsToBuildingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *ExclusivityConflictError
+}
+
+// Status returns HTTPResponse.Status
+func (r AddAdsToBuildingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddAdsToBuildingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AssignPlacementDaypartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdvertisementBuilding
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AssignPlacementDaypartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AssignPlacementDaypartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePlacementZoneResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdvertisementBuilding
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePlacementZoneResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePlacementZoneResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildingEligibilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BuildingEligibility
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildingEligibilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildingEligibilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListExclusivityRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExclusivityRuleList
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListExclusivityRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListExclusivityRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateExclusivityRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ExclusivityRule
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *ExclusivityConflictError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateExclusivityRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateExclusivityRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteExclusivityRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteExclusivityRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteExclusivityRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateExclusivityRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExclusivityRule
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *ExclusivityConflictError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateExclusivityRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateExclusivityRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildingImpressionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BuildingImpressionReport
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildingImpressionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildingImpressionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildingLayoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BuildingLayout
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildingLayoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildingLayoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AssignBuildingLayoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Building
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AssignBuildingLayoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AssignBuildingLayoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildingManifestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Manifest
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildingManifestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildingManifestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildingManifestDeltaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ManifestDelta
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildingManifestDeltaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildingManifestDeltaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveNoticesFromBuildingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveNoticesFromBuildingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveNoticesFromBuildingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBuildingNoticesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NoticeList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBuildingNoticesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBuildingNoticesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddNoticesToBuildingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AddNoticesToBuildingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddNoticesToBuildingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListActiveBuildingNoticesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ActiveNoticeList
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListActiveBuildingNoticesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListActiveBuildingNoticesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildingPreviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BuildingPreview
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildingPreviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildingPreviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBuildingTrafficResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TrafficList
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBuildingTrafficResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBuildingTrafficResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutBuildingTrafficResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count   int    `json:"count"`
+		Message string `json:"message"`
+	}
+	JSON400 *Error
+	JSON404 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutBuildingTrafficResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutBuildingTrafficResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportBuildingTrafficResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TrafficImportReport
+	JSON201      *TrafficImportReport
+	JSON400      *Error
+	JSON404      *Error
+	JSON422      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportBuildingTrafficResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportBuildingTrafficResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCalendarsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarPage
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCalendarsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCalendarsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCalendarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Calendar
+	JSON400      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCalendarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCalendarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCalendarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCalendarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCalendarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Calendar
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCalendarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Calendar
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCalendarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCalendarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCalendarEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarEventList
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCalendarEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCalendarEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCalendarEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CalendarEvent
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCalendarEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCalendarEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCalendarEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCalendarEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCalendarEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportCalendarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarImportReport
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportCalendarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportCalendarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDaypartsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DaypartPage
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDaypartsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDaypartsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDaypartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Daypart
+	JSON400      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDaypartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDaypartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDaypartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDaypartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDaypartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDaypartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Daypart
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDaypartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDaypartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDaypartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Daypart
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDaypartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDaypartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportAdsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportAdsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportAdsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportAdCertificateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportAdCertificateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportAdCertificateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportBuildingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportBuildingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportBuildingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportPlacementsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportPlacementsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportPlacementsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInvoicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvoicePage
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInvoicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInvoicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Invoice
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON422      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invoice
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VoidInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invoice
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r VoidInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VoidInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLayoutsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LayoutPage
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLayoutsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLayoutsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateLayoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Layout
+	JSON400      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateLayoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateLayoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteLayoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteLayoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteLayoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLayoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Layout
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLayoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLayoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateLayoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Layout
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateLayoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateLayoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetManifestPublicKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ManifestPublicKey
+}
+
+// Status returns HTTPResponse.Status
+func (r GetManifestPublicKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetManifestPublicKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNoticesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NoticePage
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNoticesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNoticesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateNoticeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Notice
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateNoticeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateNoticeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteNoticeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteNoticeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteNoticeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNoticeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Notice
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNoticeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNoticeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateNoticeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Notice
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateNoticeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateNoticeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveBuildingsFromNoticeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveBuildingsFromNoticeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveBuildingsFromNoticeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNoticeBuildingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BuildingList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNoticeBuildingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNoticeBuildingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddBuildingsToNoticeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AddBuildingsToNoticeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddBuildingsToNoticeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenAPIResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenAPIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenAPIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRateCardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RateCardPage
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRateCardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRateCardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRateCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RateCard
+	JSON400      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRateCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRateCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRateCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRateCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRateCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRateCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RateCard
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRateCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRateCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateRateCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RateCard
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRateCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRateCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUploadPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PolicyToken
+	JSON400      *Error
+	JSON401      *Error
+	JSON429      *TooManyRequests
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUploadPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUploadPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r MetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadyzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthStatus
+	JSON503      *HealthStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadyzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadyzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// LoginAdminWithBodyWithResponse request with arbitrary body returning *LoginAdminResponse
+func (c *ClientWithResponses) LoginAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginAdminResponse, error) {
+	rsp, err := c.LoginAdminWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginAdminResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginAdminWithResponse(ctx context.Context, body LoginAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginAdminResponse, error) {
+	rsp, err := c.LoginAdmin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginAdminResponse(rsp)
+}
+
+// VerifyLoginTOTPWithBodyWithResponse request with arbitrary body returning *VerifyLoginTOTPResponse
+func (c *ClientWithResponses) VerifyLoginTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyLoginTOTPResponse, error) {
+	rsp, err := c.VerifyLoginTOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyLoginTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) VerifyLoginTOTPWithResponse(ctx context.Context, body VerifyLoginTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyLoginTOTPResponse, error) {
+	rsp, err := c.VerifyLoginTOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyLoginTOTPResponse(rsp)
+}
+
+// RegisterAdminWithBodyWithResponse request with arbitrary body returning *RegisterAdminResponse
+func (c *ClientWithResponses) RegisterAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterAdminResponse, error) {
+	rsp, err := c.RegisterAdminWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterAdminResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegisterAdminWithResponse(ctx context.Context, body RegisterAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterAdminResponse, error) {
+	rsp, err := c.RegisterAdmin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterAdminResponse(rsp)
+}
+
+// DisableTOTPWithBodyWithResponse request with arbitrary body returning *DisableTOTPResponse
+func (c *ClientWithResponses) DisableTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error) {
+	rsp, err := c.DisableTOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) DisableTOTPWithResponse(ctx context.Context, body DisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error) {
+	rsp, err := c.DisableTOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTOTPResponse(rsp)
+}
+
+// EnrollTOTPWithResponse request returning *EnrollTOTPResponse
+func (c *ClientWithResponses) EnrollTOTPWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnrollTOTPResponse, error) {
+	rsp, err := c.EnrollTOTP(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrollTOTPResponse(rsp)
+}
+
+// RegenerateRecoveryCodesWithBodyWithResponse request with arbitrary body returning *RegenerateRecoveryCodesResponse
+func (c *ClientWithResponses) RegenerateRecoveryCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error) {
+	rsp, err := c.RegenerateRecoveryCodesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateRecoveryCodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegenerateRecoveryCodesWithResponse(ctx context.Context, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error) {
+	rsp, err := c.RegenerateRecoveryCodes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateRecoveryCodesResponse(rsp)
+}
+
+// ConfirmTOTPWithBodyWithResponse request with arbitrary body returning *ConfirmTOTPResponse
+func (c *ClientWithResponses) ConfirmTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTOTPResponse, error) {
+	rsp, err := c.ConfirmTOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmTOTPWithResponse(ctx context.Context, body ConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTOTPResponse, error) {
+	rsp, err := c.ConfirmTOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTOTPResponse(rsp)
+}
+
+// ListAPIKeysWithResponse request returning *ListAPIKeysResponse
+func (c *ClientWithResponses) ListAPIKeysWithResponse(ctx context.Context, params *ListAPIKeysParams, reqEditors ...RequestEditorFn) (*ListAPIKeysResponse, error) {
+	rsp, err := c.ListAPIKeys(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAPIKeysResponse(rsp)
+}
+
+// CreateAPIKeyWithBodyWithResponse request with arbitrary body returning *CreateAPIKeyResponse
+func (c *ClientWithResponses) CreateAPIKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error) {
+	rsp, err := c.CreateAPIKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAPIKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAPIKeyWithResponse(ctx context.Context, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error) {
+	rsp, err := c.CreateAPIKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAPIKeyResponse(rsp)
+}
+
+// RevokeAPIKeyWithResponse request returning *RevokeAPIKeyResponse
+func (c *ClientWithResponses) RevokeAPIKeyWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*RevokeAPIKeyResponse, error) {
+	rsp, err := c.RevokeAPIKey(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeAPIKeyResponse(rsp)
+}
+
+// ListAuditLogsWithResponse request returning *ListAuditLogsResponse
+func (c *ClientWithResponses) ListAuditLogsWithResponse(ctx context.Context, params *ListAuditLogsParams, reqEditors ...RequestEditorFn) (*ListAuditLogsResponse, error) {
+	rsp, err := c.ListAuditLogs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuditLogsResponse(rsp)
+}
+
+// UpdateAdminPasswordWithBodyWithResponse request with arbitrary body returning *UpdateAdminPasswordResponse
+func (c *ClientWithResponses) UpdateAdminPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAdminPasswordResponse, error) {
+	rsp, err := c.UpdateAdminPasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAdminPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAdminPasswordWithResponse(ctx context.Context, body UpdateAdminPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAdminPasswordResponse, error) {
+	rsp, err := c.UpdateAdminPassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAdminPasswordResponse(rsp)
+}
+
+// DeleteAdminWithBodyWithResponse request with arbitrary body returning *DeleteAdminResponse
+func (c *ClientWithResponses) DeleteAdminWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteAdminResponse, error) {
+	rsp, err := c.DeleteAdminWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteAdminWithResponse(ctx context.Context, body DeleteAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteAdminResponse, error) {
+	rsp, err := c.DeleteAdmin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminResponse(rsp)
+}
+
+// ListAdminsWithResponse request returning *ListAdminsResponse
+func (c *ClientWithResponses) ListAdminsWithResponse(ctx context.Context, params *ListAdminsParams, reqEditors ...RequestEditorFn) (*ListAdminsResponse, error) {
+	rsp, err := c.ListAdmins(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAdminsResponse(rsp)
+}
+
+// ResetAdminTOTPWithResponse request returning *ResetAdminTOTPResponse
+func (c *ClientWithResponses) ResetAdminTOTPWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ResetAdminTOTPResponse, error) {
+	rsp, err := c.ResetAdminTOTP(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetAdminTOTPResponse(rsp)
+}
+
+// ResetAdminPasswordWithBodyWithResponse request with arbitrary body returning *ResetAdminPasswordResponse
+func (c *ClientWithResponses) ResetAdminPasswordWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetAdminPasswordResponse, error) {
+	rsp, err := c.ResetAdminPasswordWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetAdminPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) ResetAdminPasswordWithResponse(ctx context.Context, id ID, body ResetAdminPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetAdminPasswordResponse, error) {
+	rsp, err := c.ResetAdminPassword(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetAdminPasswordResponse(rsp)
+}
+
+// ListWebhooksWithResponse request returning *ListWebhooksResponse
+func (c *ClientWithResponses) ListWebhooksWithResponse(ctx context.Context, params *ListWebhooksParams, reqEditors ...RequestEditorFn) (*ListWebhooksResponse, error) {
+	rsp, err := c.ListWebhooks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhooksResponse(rsp)
+}
+
+// CreateWebhookWithBodyWithResponse request with arbitrary body returning *CreateWebhookResponse
+func (c *ClientWithResponses) CreateWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhookWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebhookWithResponse(ctx context.Context, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhook(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+// DeleteWebhookWithResponse request returning *DeleteWebhookResponse
+func (c *ClientWithResponses) DeleteWebhookWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error) {
+	rsp, err := c.DeleteWebhook(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhookResponse(rsp)
+}
+
+// UpdateWebhookWithBodyWithResponse request with arbitrary body returning *UpdateWebhookResponse
+func (c *ClientWithResponses) UpdateWebhookWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
+	rsp, err := c.UpdateWebhookWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWebhookWithResponse(ctx context.Context, id ID, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
+	rsp, err := c.UpdateWebhook(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookResponse(rsp)
+}
+
+// ListWebhookDeliveriesWithResponse request returning *ListWebhookDeliveriesResponse
+func (c *ClientWithResponses) ListWebhookDeliveriesWithResponse(ctx context.Context, id ID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ListWebhookDeliveriesResponse, error) {
+	rsp, err := c.ListWebhookDeliveries(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhookDeliveriesResponse(rsp)
+}
+
+// RetryWebhookDeliveryWithResponse request returning *RetryWebhookDeliveryResponse
+func (c *ClientWithResponses) RetryWebhookDeliveryWithResponse(ctx context.Context, id ID, deliveryId uint, reqEditors ...RequestEditorFn) (*RetryWebhookDeliveryResponse, error) {
+	rsp, err := c.RetryWebhookDelivery(ctx, id, deliveryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetryWebhookDeliveryResponse(rsp)
+}
+
+// ListAdsWithResponse request returning *ListAdsResponse
+func (c *ClientWithResponses) ListAdsWithResponse(ctx context.Context, params *ListAdsParams, reqEditors ...RequestEditorFn) (*ListAdsResponse, error) {
+	rsp, err := c.ListAds(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAdsResponse(rsp)
+}
+
+// CreateAdWithBodyWithResponse request with arbitrary body returning *CreateAdResponse
+func (c *ClientWithResponses) CreateAdWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAdResponse, error) {
+	rsp, err := c.CreateAdWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAdResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAdWithResponse(ctx context.Context, body CreateAdJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAdResponse, error) {
+	rsp, err := c.CreateAd(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAdResponse(rsp)
+}
+
+// DeleteAdWithResponse request returning *DeleteAdResponse
+func (c *ClientWithResponses) DeleteAdWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteAdResponse, error) {
+	rsp, err := c.DeleteAd(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdResponse(rsp)
+}
+
+// GetAdWithResponse request returning *GetAdResponse
+func (c *ClientWithResponses) GetAdWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetAdResponse, error) {
+	rsp, err := c.GetAd(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdResponse(rsp)
+}
+
+// UpdateAdWithBodyWithResponse request with arbitrary body returning *UpdateAdResponse
+func (c *ClientWithResponses) UpdateAdWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAdResponse, error) {
+	rsp, err := c.UpdateAdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAdWithResponse(ctx context.Context, id ID, body UpdateAdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAdResponse, error) {
+	rsp, err := c.UpdateAd(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAdResponse(rsp)
+}
+
+// RemoveBuildingsFromAdWithBodyWithResponse request with arbitrary body returning *RemoveBuildingsFromAdResponse
+func (c *ClientWithResponses) RemoveBuildingsFromAdWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveBuildingsFromAdResponse, error) {
+	rsp, err := c.RemoveBuildingsFromAdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveBuildingsFromAdResponse(rsp)
+}
+
+func (c *ClientWithResponses) RemoveBuildingsFromAdWithResponse(ctx context.Context, id ID, body RemoveBuildingsFromAdJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveBuildingsFromAdResponse, error) {
+	rsp, err := c.RemoveBuildingsFromAd(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveBuildingsFromAdResponse(rsp)
+}
+
+// ListAdBuildingsWithResponse request returning *ListAdBuildingsResponse
+func (c *ClientWithResponses) ListAdBuildingsWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListAdBuildingsResponse, error) {
+	rsp, err := c.ListAdBuildings(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAdBuildingsResponse(rsp)
+}
+
+// AddBuildingsToAdWithBodyWithResponse request with arbitrary body returning *AddBuildingsToAdResponse
+func (c *ClientWithResponses) AddBuildingsToAdWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddBuildingsToAdResponse, error) {
+	rsp, err := c.AddBuildingsToAdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddBuildingsToAdResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddBuildingsToAdWithResponse(ctx context.Context, id ID, body AddBuildingsToAdJSONRequestBody, reqEditors ...RequestEditorFn) (*AddBuildingsToAdResponse, error) {
+	rsp, err := c.AddBuildingsToAd(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddBuildingsToAdResponse(rsp)
+}
+
+// GetAdChargesWithResponse request returning *GetAdChargesResponse
+func (c *ClientWithResponses) GetAdChargesWithResponse(ctx context.Context, id ID, params *GetAdChargesParams, reqEditors ...RequestEditorFn) (*GetAdChargesResponse, error) {
+	rsp, err := c.GetAdCharges(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdChargesResponse(rsp)
+}
+
+// GetAdImpressionsWithResponse request returning *GetAdImpressionsResponse
+func (c *ClientWithResponses) GetAdImpressionsWithResponse(ctx context.Context, id ID, params *GetAdImpressionsParams, reqEditors ...RequestEditorFn) (*GetAdImpressionsResponse, error) {
+	rsp, err := c.GetAdImpressions(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdImpressionsResponse(rsp)
+}
+
+// ListBuildingsWithResponse request returning *ListBuildingsResponse
+func (c *ClientWithResponses) ListBuildingsWithResponse(ctx context.Context, params *ListBuildingsParams, reqEditors ...RequestEditorFn) (*ListBuildingsResponse, error) {
+	rsp, err := c.ListBuildings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBuildingsResponse(rsp)
+}
+
+// CreateBuildingWithBodyWithResponse request with arbitrary body returning *CreateBuildingResponse
+func (c *ClientWithResponses) CreateBuildingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBuildingResponse, error) {
+	rsp, err := c.CreateBuildingWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBuildingResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBuildingWithResponse(ctx context.Context, body CreateBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBuildingResponse, error) {
+	rsp, err := c.CreateBuilding(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBuildingResponse(rsp)
+}
+
+// ImportBuildingsWithBodyWithResponse request with arbitrary body returning *ImportBuildingsResponse
+func (c *ClientWithResponses) ImportBuildingsWithBodyWithResponse(ctx context.Context, params *ImportBuildingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportBuildingsResponse, error) {
+	rsp, err := c.ImportBuildingsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportBuildingsResponse(rsp)
+}
+
+// DeleteBuildingWithResponse request returning *DeleteBuildingResponse
+func (c *ClientWithResponses) DeleteBuildingWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteBuildingResponse, error) {
+	rsp, err := c.DeleteBuilding(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBuildingResponse(rsp)
+}
+
+// GetBuildingWithResponse request returning *GetBuildingResponse
+func (c *ClientWithResponses) GetBuildingWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetBuildingResponse, error) {
+	rsp, err := c.GetBuilding(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildingResponse(rsp)
+}
+
+// UpdateBuildingWithBodyWithResponse request with arbitrary body returning *UpdateBuildingResponse
+func (c *ClientWithResponses) UpdateBuildingWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBuildingResponse, error) {
+	rsp, err := c.UpdateBuildingWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBuildingResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateBuildingWithResponse(ctx context.Context, id ID, body UpdateBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBuildingResponse, error) {
+	rsp, err := c.UpdateBuilding(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBuildingResponse(rsp)
+}
+
+// RemoveAdsFromBuildingWithBodyWithResponse request with arbitrary body returning *RemoveAdsFromBuildingResponse
+func (c *ClientWithResponses) RemoveAdsFromBuildingWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveAdsFromBuildingResponse, error) {
+	rsp, err := c.RemoveAdsFromBuildingWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveAdsFromBuildingResponse(rsp)
+}
+
+func (c *ClientWithResponses) RemoveAdsFromBuildingWithResponse(ctx context.Context, id ID, body RemoveAdsFromBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveAdsFromBuildingResponse, error) {
+	rsp, err := c.RemoveAdsFromBuilding(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveAdsFromBuildingResponse(rsp)
+}
+
+// ListBuildingAdsWithResponse request returning *ListBuildingAdsResponse
+func (c *ClientWithResponses) ListBuildingAdsWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListBuildingAdsResponse, error) {
+	rsp, err := c.ListBuildingAds(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBuildingAdsResponse(rsp)
+}
+
+// AddAdsToBuildingWithBodyWithResponse request with arbitrary body returning *AddAdsToBuildingResponse
+func (c *ClientWithResponses) AddAdsToBuildingWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAdsToBuildingResponse, error) {
+	rsp, err := c.AddAdsToBuildingWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddAdsToBuildingResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddAdsToBuildingWithResponse(ctx context.Context, id ID, body AddAdsToBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddAdsToBuildingResponse, error) {
+	rsp, err := c.AddAdsToBuilding(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddAdsToBuildingResponse(rsp)
+}
+
+// AssignPlacementDaypartWithBodyWithResponse request with arbitrary body returning *AssignPlacementDaypartResponse
+func (c *ClientWithResponses) AssignPlacementDaypartWithBodyWithResponse(ctx context.Context, id ID, adId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignPlacementDaypartResponse, error) {
+	rsp, err := c.AssignPlacementDaypartWithBody(ctx, id, adId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignPlacementDaypartResponse(rsp)
+}
+
+func (c *ClientWithResponses) AssignPlacementDaypartWithResponse(ctx context.Context, id ID, adId uint, body AssignPlacementDaypartJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignPlacementDaypartResponse, error) {
+	rsp, err := c.AssignPlacementDaypart(ctx, id, adId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignPlacementDaypartResponse(rsp)
+}
+
+// UpdatePlacementZoneWithBodyWithResponse request with arbitrary body returning *UpdatePlacementZoneResponse
+func (c *ClientWithResponses) UpdatePlacementZoneWithBodyWithResponse(ctx context.Context, id ID, adId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePlacementZoneResponse, error) {
+	rsp, err := c.UpdatePlacementZoneWithBody(ctx, id, adId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePlacementZoneResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePlacementZoneWithResponse(ctx context.Context, id ID, adId uint, body UpdatePlacementZoneJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePlacementZoneResponse, error) {
+	rsp, err := c.UpdatePlacementZone(ctx, id, adId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePlacementZoneResponse(rsp)
+}
+
+// GetBuildingEligibilityWithResponse request returning *GetBuildingEligibilityResponse
+func (c *ClientWithResponses) GetBuildingEligibilityWithResponse(ctx context.Context, id ID, params *GetBuildingEligibilityParams, reqEditors ...RequestEditorFn) (*GetBuildingEligibilityResponse, error) {
+	rsp, err := c.GetBuildingEligibility(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildingEligibilityResponse(rsp)
+}
+
+// ListExclusivityRulesWithResponse request returning *ListExclusivityRulesResponse
+func (c *ClientWithResponses) ListExclusivityRulesWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListExclusivityRulesResponse, error) {
+	rsp, err := c.ListExclusivityRules(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListExclusivityRulesResponse(rsp)
+}
+
+// CreateExclusivityRuleWithBodyWithResponse request with arbitrary body returning *CreateExclusivityRuleResponse
+func (c *ClientWithResponses) CreateExclusivityRuleWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateExclusivityRuleResponse, error) {
+	rsp, err := c.CreateExclusivityRuleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateExclusivityRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateExclusivityRuleWithResponse(ctx context.Context, id ID, body CreateExclusivityRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateExclusivityRuleResponse, error) {
+	rsp, err := c.CreateExclusivityRule(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateExclusivityRuleResponse(rsp)
+}
+
+// DeleteExclusivityRuleWithResponse request returning *DeleteExclusivityRuleResponse
+func (c *ClientWithResponses) DeleteExclusivityRuleWithResponse(ctx context.Context, id ID, ruleId uint, reqEditors ...RequestEditorFn) (*DeleteExclusivityRuleResponse, error) {
+	rsp, err := c.DeleteExclusivityRule(ctx, id, ruleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteExclusivityRuleResponse(rsp)
+}
+
+// UpdateExclusivityRuleWithBodyWithResponse request with arbitrary body returning *UpdateExclusivityRuleResponse
+func (c *ClientWithResponses) UpdateExclusivityRuleWithBodyWithResponse(ctx context.Context, id ID, ruleId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateExclusivityRuleResponse, error) {
+	rsp, err := c.UpdateExclusivityRuleWithBody(ctx, id, ruleId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateExclusivityRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateExclusivityRuleWithResponse(ctx context.Context, id ID, ruleId uint, body UpdateExclusivityRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateExclusivityRuleResponse, error) {
+	rsp, err := c.UpdateExclusivityRule(ctx, id, ruleId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateExclusivityRuleResponse(rsp)
+}
+
+// GetBuildingImpressionsWithResponse request returning *GetBuildingImpressionsResponse
+func (c *ClientWithResponses) GetBuildingImpressionsWithResponse(ctx context.Context, id ID, params *GetBuildingImpressionsParams, reqEditors ...RequestEditorFn) (*GetBuildingImpressionsResponse, error) {
+	rsp, err := c.GetBuildingImpressions(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildingImpressionsResponse(rsp)
+}
+
+// GetBuildingLayoutWithResponse request returning *GetBuildingLayoutResponse
+func (c *ClientWithResponses) GetBuildingLayoutWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetBuildingLayoutResponse, error) {
+	rsp, err := c.GetBuildingLayout(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildingLayoutResponse(rsp)
+}
+
+// AssignBuildingLayoutWithBodyWithResponse request with arbitrary body returning *AssignBuildingLayoutResponse
+func (c *ClientWithResponses) AssignBuildingLayoutWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignBuildingLayoutResponse, error) {
+	rsp, err := c.AssignBuildingLayoutWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignBuildingLayoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) AssignBuildingLayoutWithResponse(ctx context.Context, id ID, body AssignBuildingLayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignBuildingLayoutResponse, error) {
+	rsp, err := c.AssignBuildingLayout(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignBuildingLayoutResponse(rsp)
+}
+
+// GetBuildingManifestWithResponse request returning *GetBuildingManifestResponse
+func (c *ClientWithResponses) GetBuildingManifestWithResponse(ctx context.Context, id ID, params *GetBuildingManifestParams, reqEditors ...RequestEditorFn) (*GetBuildingManifestResponse, error) {
+	rsp, err := c.GetBuildingManifest(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildingManifestResponse(rsp)
+}
+
+// GetBuildingManifestDeltaWithResponse request returning *GetBuildingManifestDeltaResponse
+func (c *ClientWithResponses) GetBuildingManifestDeltaWithResponse(ctx context.Context, id ID, params *GetBuildingManifestDeltaParams, reqEditors ...RequestEditorFn) (*GetBuildingManifestDeltaResponse, error) {
+	rsp, err := c.GetBuildingManifestDelta(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildingManifestDeltaResponse(rsp)
+}
+
+// RemoveNoticesFromBuildingWithBodyWithResponse request with arbitrary body returning *RemoveNoticesFromBuildingResponse
+func (c *ClientWithResponses) RemoveNoticesFromBuildingWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveNoticesFromBuildingResponse, error) {
+	rsp, err := c.RemoveNoticesFromBuildingWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveNoticesFromBuildingResponse(rsp)
+}
+
+func (c *ClientWithResponses) RemoveNoticesFromBuildingWithResponse(ctx context.Context, id ID, body RemoveNoticesFromBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveNoticesFromBuildingResponse, error) {
+	rsp, err := c.RemoveNoticesFromBuilding(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveNoticesFromBuildingResponse(rsp)
+}
+
+// ListBuildingNoticesWithResponse request returning *ListBuildingNoticesResponse
+func (c *ClientWithResponses) ListBuildingNoticesWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListBuildingNoticesResponse, error) {
+	rsp, err := c.ListBuildingNotices(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBuildingNoticesResponse(rsp)
+}
+
+// AddNoticesToBuildingWithBodyWithResponse request with arbitrary body returning *AddNoticesToBuildingResponse
+func (c *ClientWithResponses) AddNoticesToBuildingWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddNoticesToBuildingResponse, error) {
+	rsp, err := c.AddNoticesToBuildingWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddNoticesToBuildingResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddNoticesToBuildingWithResponse(ctx context.Context, id ID, body AddNoticesToBuildingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddNoticesToBuildingResponse, error) {
+	rsp, err := c.AddNoticesToBuilding(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddNoticesToBuildingResponse(rsp)
+}
+
+// ListActiveBuildingNoticesWithResponse request returning *ListActiveBuildingNoticesResponse
+func (c *ClientWithResponses) ListActiveBuildingNoticesWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListActiveBuildingNoticesResponse, error) {
+	rsp, err := c.ListActiveBuildingNotices(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListActiveBuildingNoticesResponse(rsp)
+}
+
+// GetBuildingPreviewWithResponse request returning *GetBuildingPreviewResponse
+func (c *ClientWithResponses) GetBuildingPreviewWithResponse(ctx context.Context, id ID, params *GetBuildingPreviewParams, reqEditors ...RequestEditorFn) (*GetBuildingPreviewResponse, error) {
+	rsp, err := c.GetBuildingPreview(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildingPreviewResponse(rsp)
+}
+
+// ListBuildingTrafficWithResponse request returning *ListBuildingTrafficResponse
+func (c *ClientWithResponses) ListBuildingTrafficWithResponse(ctx context.Context, id ID, params *ListBuildingTrafficParams, reqEditors ...RequestEditorFn) (*ListBuildingTrafficResponse, error) {
+	rsp, err := c.ListBuildingTraffic(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBuildingTrafficResponse(rsp)
+}
+
+// PutBuildingTrafficWithBodyWithResponse request with arbitrary body returning *PutBuildingTrafficResponse
+func (c *ClientWithResponses) PutBuildingTrafficWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBuildingTrafficResponse, error) {
+	rsp, err := c.PutBuildingTrafficWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBuildingTrafficResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutBuildingTrafficWithResponse(ctx context.Context, id ID, body PutBuildingTrafficJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBuildingTrafficResponse, error) {
+	rsp, err := c.PutBuildingTraffic(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBuildingTrafficResponse(rsp)
+}
+
+// ImportBuildingTrafficWithBodyWithResponse request with arbitrary body returning *ImportBuildingTrafficResponse
+func (c *ClientWithResponses) ImportBuildingTrafficWithBodyWithResponse(ctx context.Context, id ID, params *ImportBuildingTrafficParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportBuildingTrafficResponse, error) {
+	rsp, err := c.ImportBuildingTrafficWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportBuildingTrafficResponse(rsp)
+}
+
+// ListCalendarsWithResponse request returning *ListCalendarsResponse
+func (c *ClientWithResponses) ListCalendarsWithResponse(ctx context.Context, params *ListCalendarsParams, reqEditors ...RequestEditorFn) (*ListCalendarsResponse, error) {
+	rsp, err := c.ListCalendars(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCalendarsResponse(rsp)
+}
+
+// CreateCalendarWithBodyWithResponse request with arbitrary body returning *CreateCalendarResponse
+func (c *ClientWithResponses) CreateCalendarWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCalendarResponse, error) {
+	rsp, err := c.CreateCalendarWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCalendarResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCalendarWithResponse(ctx context.Context, body CreateCalendarJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCalendarResponse, error) {
+	rsp, err := c.CreateCalendar(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCalendarResponse(rsp)
+}
+
+// DeleteCalendarWithResponse request returning *DeleteCalendarResponse
+func (c *ClientWithResponses) DeleteCalendarWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteCalendarResponse, error) {
+	rsp, err := c.DeleteCalendar(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCalendarResponse(rsp)
+}
+
+// GetCalendarWithResponse request returning *GetCalendarResponse
+func (c *ClientWithResponses) GetCalendarWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetCalendarResponse, error) {
+	rsp, err := c.GetCalendar(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarResponse(rsp)
+}
+
+// UpdateCalendarWithBodyWithResponse request with arbitrary body returning *UpdateCalendarResponse
+func (c *ClientWithResponses) UpdateCalendarWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCalendarResponse, error) {
+	rsp, err := c.UpdateCalendarWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCalendarResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCalendarWithResponse(ctx context.Context, id ID, body UpdateCalendarJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCalendarResponse, error) {
+	rsp, err := c.UpdateCalendar(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCalendarResponse(rsp)
+}
+
+// ListCalendarEventsWithResponse request returning *ListCalendarEventsResponse
+func (c *ClientWithResponses) ListCalendarEventsWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListCalendarEventsResponse, error) {
+	rsp, err := c.ListCalendarEvents(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCalendarEventsResponse(rsp)
+}
+
+// CreateCalendarEventWithBodyWithResponse request with arbitrary body returning *CreateCalendarEventResponse
+func (c *ClientWithResponses) CreateCalendarEventWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCalendarEventResponse, error) {
+	rsp, err := c.CreateCalendarEventWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCalendarEventResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCalendarEventWithResponse(ctx context.Context, id ID, body CreateCalendarEventJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCalendarEventResponse, error) {
+	rsp, err := c.CreateCalendarEvent(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCalendarEventResponse(rsp)
+}
+
+// DeleteCalendarEventWithResponse request returning *DeleteCalendarEventResponse
+func (c *ClientWithResponses) DeleteCalendarEventWithResponse(ctx context.Context, id ID, eventId uint, reqEditors ...RequestEditorFn) (*DeleteCalendarEventResponse, error) {
+	rsp, err := c.DeleteCalendarEvent(ctx, id, eventId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCalendarEventResponse(rsp)
+}
+
+// ImportCalendarWithBodyWithResponse request with arbitrary body returning *ImportCalendarResponse
+func (c *ClientWithResponses) ImportCalendarWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportCalendarResponse, error) {
+	rsp, err := c.ImportCalendarWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportCalendarResponse(rsp)
+}
+
+// ListDaypartsWithResponse request returning *ListDaypartsResponse
+func (c *ClientWithResponses) ListDaypartsWithResponse(ctx context.Context, params *ListDaypartsParams, reqEditors ...RequestEditorFn) (*ListDaypartsResponse, error) {
+	rsp, err := c.ListDayparts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDaypartsResponse(rsp)
+}
+
+// CreateDaypartWithBodyWithResponse request with arbitrary body returning *CreateDaypartResponse
+func (c *ClientWithResponses) CreateDaypartWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDaypartResponse, error) {
+	rsp, err := c.CreateDaypartWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDaypartResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDaypartWithResponse(ctx context.Context, body CreateDaypartJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDaypartResponse, error) {
+	rsp, err := c.CreateDaypart(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDaypartResponse(rsp)
+}
+
+// DeleteDaypartWithResponse request returning *DeleteDaypartResponse
+func (c *ClientWithResponses) DeleteDaypartWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteDaypartResponse, error) {
+	rsp, err := c.DeleteDaypart(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDaypartResponse(rsp)
+}
+
+// GetDaypartWithResponse request returning *GetDaypartResponse
+func (c *ClientWithResponses) GetDaypartWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetDaypartResponse, error) {
+	rsp, err := c.GetDaypart(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDaypartResponse(rsp)
+}
+
+// UpdateDaypartWithBodyWithResponse request with arbitrary body returning *UpdateDaypartResponse
+func (c *ClientWithResponses) UpdateDaypartWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDaypartResponse, error) {
+	rsp, err := c.UpdateDaypartWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDaypartResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDaypartWithResponse(ctx context.Context, id ID, body UpdateDaypartJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDaypartResponse, error) {
+	rsp, err := c.UpdateDaypart(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDaypartResponse(rsp)
+}
+
+// ExportAdsWithResponse request returning *ExportAdsResponse
+func (c *ClientWithResponses) ExportAdsWithResponse(ctx context.Context, params *ExportAdsParams, reqEditors ...RequestEditorFn) (*ExportAdsResponse, error) {
+	rsp, err := c.ExportAds(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportAdsResponse(rsp)
+}
+
+// ExportAdCertificateWithResponse request returning *ExportAdCertificateResponse
+func (c *ClientWithResponses) ExportAdCertificateWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ExportAdCertificateResponse, error) {
+	rsp, err := c.ExportAdCertificate(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportAdCertificateResponse(rsp)
+}
+
+// ExportBuildingsWithResponse request returning *ExportBuildingsResponse
+func (c *ClientWithResponses) ExportBuildingsWithResponse(ctx context.Context, params *ExportBuildingsParams, reqEditors ...RequestEditorFn) (*ExportBuildingsResponse, error) {
+	rsp, err := c.ExportBuildings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportBuildingsResponse(rsp)
+}
+
+// ExportInvoiceWithResponse request returning *ExportInvoiceResponse
+func (c *ClientWithResponses) ExportInvoiceWithResponse(ctx context.Context, id ID, params *ExportInvoiceParams, reqEditors ...RequestEditorFn) (*ExportInvoiceResponse, error) {
+	rsp, err := c.ExportInvoice(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportInvoiceResponse(rsp)
+}
+
+// ExportPlacementsWithResponse request returning *ExportPlacementsResponse
+func (c *ClientWithResponses) ExportPlacementsWithResponse(ctx context.Context, params *ExportPlacementsParams, reqEditors ...RequestEditorFn) (*ExportPlacementsResponse, error) {
+	rsp, err := c.ExportPlacements(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportPlacementsResponse(rsp)
+}
+
+// ListInvoicesWithResponse request returning *ListInvoicesResponse
+func (c *ClientWithResponses) ListInvoicesWithResponse(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*ListInvoicesResponse, error) {
+	rsp, err := c.ListInvoices(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInvoicesResponse(rsp)
+}
+
+// CreateInvoiceWithBodyWithResponse request with arbitrary body returning *CreateInvoiceResponse
+func (c *ClientWithResponses) CreateInvoiceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error) {
+	rsp, err := c.CreateInvoiceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInvoiceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInvoiceWithResponse(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error) {
+	rsp, err := c.CreateInvoice(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInvoiceResponse(rsp)
+}
+
+// GetInvoiceWithResponse request returning *GetInvoiceResponse
+func (c *ClientWithResponses) GetInvoiceWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetInvoiceResponse, error) {
+	rsp, err := c.GetInvoice(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInvoiceResponse(rsp)
+}
+
+// VoidInvoiceWithResponse request returning *VoidInvoiceResponse
+func (c *ClientWithResponses) VoidInvoiceWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*VoidInvoiceResponse, error) {
+	rsp, err := c.VoidInvoice(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVoidInvoiceResponse(rsp)
+}
+
+// ListLayoutsWithResponse request returning *ListLayoutsResponse
+func (c *ClientWithResponses) ListLayoutsWithResponse(ctx context.Context, params *ListLayoutsParams, reqEditors ...RequestEditorFn) (*ListLayoutsResponse, error) {
+	rsp, err := c.ListLayouts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLayoutsResponse(rsp)
+}
+
+// CreateLayoutWithBodyWithResponse request with arbitrary body returning *CreateLayoutResponse
+func (c *ClientWithResponses) CreateLayoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLayoutResponse, error) {
+	rsp, err := c.CreateLayoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLayoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateLayoutWithResponse(ctx context.Context, body CreateLayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLayoutResponse, error) {
+	rsp, err := c.CreateLayout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLayoutResponse(rsp)
+}
+
+// DeleteLayoutWithResponse request returning *DeleteLayoutResponse
+func (c *ClientWithResponses) DeleteLayoutWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteLayoutResponse, error) {
+	rsp, err := c.DeleteLayout(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteLayoutResponse(rsp)
+}
+
+// GetLayoutWithResponse request returning *GetLayoutResponse
+func (c *ClientWithResponses) GetLayoutWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetLayoutResponse, error) {
+	rsp, err := c.GetLayout(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLayoutResponse(rsp)
+}
+
+// UpdateLayoutWithBodyWithResponse request with arbitrary body returning *UpdateLayoutResponse
+func (c *ClientWithResponses) UpdateLayoutWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLayoutResponse, error) {
+	rsp, err := c.UpdateLayoutWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLayoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateLayoutWithResponse(ctx context.Context, id ID, body UpdateLayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLayoutResponse, error) {
+	rsp, err := c.UpdateLayout(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLayoutResponse(rsp)
+}
+
+// GetManifestPublicKeyWithResponse request returning *GetManifestPublicKeyResponse
+func (c *ClientWithResponses) GetManifestPublicKeyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetManifestPublicKeyResponse, error) {
+	rsp, err := c.GetManifestPublicKey(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetManifestPublicKeyResponse(rsp)
+}
+
+// ListNoticesWithResponse request returning *ListNoticesResponse
+func (c *ClientWithResponses) ListNoticesWithResponse(ctx context.Context, params *ListNoticesParams, reqEditors ...RequestEditorFn) (*ListNoticesResponse, error) {
+	rsp, err := c.ListNotices(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNoticesResponse(rsp)
+}
+
+// CreateNoticeWithBodyWithResponse request with arbitrary body returning *CreateNoticeResponse
+func (c *ClientWithResponses) CreateNoticeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNoticeResponse, error) {
+	rsp, err := c.CreateNoticeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateNoticeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateNoticeWithResponse(ctx context.Context, body CreateNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateNoticeResponse, error) {
+	rsp, err := c.CreateNotice(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateNoticeResponse(rsp)
+}
+
+// DeleteNoticeWithResponse request returning *DeleteNoticeResponse
+func (c *ClientWithResponses) DeleteNoticeWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteNoticeResponse, error) {
+	rsp, err := c.DeleteNotice(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteNoticeResponse(rsp)
+}
+
+// GetNoticeWithResponse request returning *GetNoticeResponse
+func (c *ClientWithResponses) GetNoticeWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetNoticeResponse, error) {
+	rsp, err := c.GetNotice(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNoticeResponse(rsp)
+}
+
+// UpdateNoticeWithBodyWithResponse request with arbitrary body returning *UpdateNoticeResponse
+func (c *ClientWithResponses) UpdateNoticeWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNoticeResponse, error) {
+	rsp, err := c.UpdateNoticeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNoticeResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNoticeWithResponse(ctx context.Context, id ID, body UpdateNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNoticeResponse, error) {
+	rsp, err := c.UpdateNotice(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNoticeResponse(rsp)
+}
+
+// RemoveBuildingsFromNoticeWithBodyWithResponse request with arbitrary body returning *RemoveBuildingsFromNoticeResponse
+func (c *ClientWithResponses) RemoveBuildingsFromNoticeWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveBuildingsFromNoticeResponse, error) {
+	rsp, err := c.RemoveBuildingsFromNoticeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveBuildingsFromNoticeResponse(rsp)
+}
+
+func (c *ClientWithResponses) RemoveBuildingsFromNoticeWithResponse(ctx context.Context, id ID, body RemoveBuildingsFromNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveBuildingsFromNoticeResponse, error) {
+	rsp, err := c.RemoveBuildingsFromNotice(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveBuildingsFromNoticeResponse(rsp)
+}
+
+// ListNoticeBuildingsWithResponse request returning *ListNoticeBuildingsResponse
+func (c *ClientWithResponses) ListNoticeBuildingsWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ListNoticeBuildingsResponse, error) {
+	rsp, err := c.ListNoticeBuildings(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNoticeBuildingsResponse(rsp)
+}
+
+// AddBuildingsToNoticeWithBodyWithResponse request with arbitrary body returning *AddBuildingsToNoticeResponse
+func (c *ClientWithResponses) AddBuildingsToNoticeWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddBuildingsToNoticeResponse, error) {
+	rsp, err := c.AddBuildingsToNoticeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddBuildingsToNoticeResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddBuildingsToNoticeWithResponse(ctx context.Context, id ID, body AddBuildingsToNoticeJSONRequestBody, reqEditors ...RequestEditorFn) (*AddBuildingsToNoticeResponse, error) {
+	rsp, err := c.AddBuildingsToNotice(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddBuildingsToNoticeResponse(rsp)
+}
+
+// GetOpenAPIWithResponse request returning *GetOpenAPIResponse
+func (c *ClientWithResponses) GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error) {
+	rsp, err := c.GetOpenAPI(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenAPIResponse(rsp)
+}
+
+// ListRateCardsWithResponse request returning *ListRateCardsResponse
+func (c *ClientWithResponses) ListRateCardsWithResponse(ctx context.Context, params *ListRateCardsParams, reqEditors ...RequestEditorFn) (*ListRateCardsResponse, error) {
+	rsp, err := c.ListRateCards(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRateCardsResponse(rsp)
+}
+
+// CreateRateCardWithBodyWithResponse request with arbitrary body returning *CreateRateCardResponse
+func (c *ClientWithResponses) CreateRateCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRateCardResponse, error) {
+	rsp, err := c.CreateRateCardWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRateCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRateCardWithResponse(ctx context.Context, body CreateRateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRateCardResponse, error) {
+	rsp, err := c.CreateRateCard(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRateCardResponse(rsp)
+}
+
+// DeleteRateCardWithResponse request returning *DeleteRateCardResponse
+func (c *ClientWithResponses) DeleteRateCardWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteRateCardResponse, error) {
+	rsp, err := c.DeleteRateCard(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRateCardResponse(rsp)
+}
+
+// GetRateCardWithResponse request returning *GetRateCardResponse
+func (c *ClientWithResponses) GetRateCardWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetRateCardResponse, error) {
+	rsp, err := c.GetRateCard(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRateCardResponse(rsp)
+}
+
+// UpdateRateCardWithBodyWithResponse request with arbitrary body returning *UpdateRateCardResponse
+func (c *ClientWithResponses) UpdateRateCardWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRateCardResponse, error) {
+	rsp, err := c.UpdateRateCardWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRateCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRateCardWithResponse(ctx context.Context, id ID, body UpdateRateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRateCardResponse, error) {
+	rsp, err := c.UpdateRateCard(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRateCardResponse(rsp)
+}
+
+// GetUploadPolicyWithBodyWithResponse request with arbitrary body returning *GetUploadPolicyResponse
+func (c *ClientWithResponses) GetUploadPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetUploadPolicyResponse, error) {
+	rsp, err := c.GetUploadPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUploadPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetUploadPolicyWithResponse(ctx context.Context, body GetUploadPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*GetUploadPolicyResponse, error) {
+	rsp, err := c.GetUploadPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUploadPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetUploadPolicyWithFormdataBodyWithResponse(ctx context.Context, body GetUploadPolicyFormdataRequestBody, reqEditors ...RequestEditorFn) (*GetUploadPolicyResponse, error) {
+	rsp, err := c.GetUploadPolicyWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUploadPolicyResponse(rsp)
+}
+
+// HealthzWithResponse request returning *HealthzResponse
+func (c *ClientWithResponses) HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error) {
+	rsp, err := c.Healthz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthzResponse(rsp)
+}
+
+// MetricsWithResponse request returning *MetricsResponse
+func (c *ClientWithResponses) MetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetricsResponse, error) {
+	rsp, err := c.Metrics(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetricsResponse(rsp)
+}
+
+// ReadyzWithResponse request returning *ReadyzResponse
+func (c *ClientWithResponses) ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResponse, error) {
+	rsp, err := c.Readyz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadyzResponse(rsp)
+}
+
+// ParseLoginAdminResponse parses an HTTP response from a LoginAdminWithResponse call
+func ParseLoginAdminResponse(rsp *http.Response) (*LoginAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 423:
+		var dest Locked
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON423 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyLoginTOTPResponse parses an HTTP response from a VerifyLoginTOTPWithResponse call
+func ParseVerifyLoginTOTPResponse(rsp *http.Response) (*VerifyLoginTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyLoginTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 423:
+		var dest Locked
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON423 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegisterAdminResponse parses an HTTP response from a RegisterAdminWithResponse call
+func ParseRegisterAdminResponse(rsp *http.Response) (*RegisterAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegisterAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDisableTOTPResponse parses an HTTP response from a DisableTOTPWithResponse call
+func ParseDisableTOTPResponse(rsp *http.Response) (*DisableTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DisableTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEnrollTOTPResponse parses an HTTP response from a EnrollTOTPWithResponse call
+func ParseEnrollTOTPResponse(rsp *http.Response) (*EnrollTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EnrollTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TOTPEnrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegenerateRecoveryCodesResponse parses an HTTP response from a RegenerateRecoveryCodesWithResponse call
+func ParseRegenerateRecoveryCodesResponse(rsp *http.Response) (*RegenerateRecoveryCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegenerateRecoveryCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmTOTPResponse parses an HTTP response from a ConfirmTOTPWithResponse call
+func ParseConfirmTOTPResponse(rsp *http.Response) (*ConfirmTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAPIKeysResponse parses an HTTP response from a ListAPIKeysWithResponse call
+func ParseListAPIKeysResponse(rsp *http.Response) (*ListAPIKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAPIKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIKeyPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAPIKeyResponse parses an HTTP response from a CreateAPIKeyWithResponse call
+func ParseCreateAPIKeyResponse(rsp *http.Response) (*CreateAPIKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAPIKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest APIKeyCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeAPIKeyResponse parses an HTTP response from a RevokeAPIKeyWithResponse call
+func ParseRevokeAPIKeyResponse(rsp *http.Response) (*RevokeAPIKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeAPIKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAuditLogsResponse parses an HTTP response from a ListAuditLogsWithResponse call
+func ParseListAuditLogsResponse(rsp *http.Response) (*ListAuditLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAuditLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditLogPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAdminPasswordResponse parses an HTTP response from a UpdateAdminPasswordWithResponse call
+func ParseUpdateAdminPasswordResponse(rsp *http.Response) (*UpdateAdminPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAdminPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminResponse parses an HTTP response from a DeleteAdminWithResponse call
+func ParseDeleteAdminResponse(rsp *http.Response) (*DeleteAdminResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAdminsResponse parses an HTTP response from a ListAdminsWithResponse call
+func ParseListAdminsResponse(rsp *http.Response) (*ListAdminsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAdminsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdministratorPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetAdminTOTPResponse parses an HTTP response from a ResetAdminTOTPWithResponse call
+func ParseResetAdminTOTPResponse(rsp *http.Response) (*ResetAdminTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetAdminTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetAdminPasswordResponse parses an HTTP response from a ResetAdminPasswordWithResponse call
+func ParseResetAdminPasswordResponse(rsp *http.Response) (*ResetAdminPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetAdminPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhooksResponse parses an HTTP response from a ListWebhooksWithResponse call
+func ParseListWebhooksResponse(rsp *http.Response) (*ListWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWebhookResponse parses an HTTP response from a CreateWebhookWithResponse call
+func ParseCreateWebhookResponse(rsp *http.Response) (*CreateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhookResponse parses an HTTP response from a DeleteWebhookWithResponse call
+func ParseDeleteWebhookResponse(rsp *http.Response) (*DeleteWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateWebhookResponse parses an HTTP response from a UpdateWebhookWithResponse call
+func ParseUpdateWebhookResponse(rsp *http.Response) (*UpdateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhookDeliveriesResponse parses an HTTP response from a ListWebhookDeliveriesWithResponse call
+func ParseListWebhookDeliveriesResponse(rsp *http.Response) (*ListWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRetryWebhookDeliveryResponse parses an HTTP response from a RetryWebhookDeliveryWithResponse call
+func ParseRetryWebhookDeliveryResponse(rsp *http.Response) (*RetryWebhookDeliveryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RetryWebhookDeliveryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAdsResponse parses an HTTP response from a ListAdsWithResponse call
+func ParseListAdsResponse(rsp *http.Response) (*ListAdsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAdsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdvertisementPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAdResponse parses an HTTP response from a CreateAdWithResponse call
+func ParseCreateAdResponse(rsp *http.Response) (*CreateAdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Advertisement
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdResponse parses an HTTP response from a DeleteAdWithResponse call
+func ParseDeleteAdResponse(rsp *http.Response) (*DeleteAdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdResponse parses an HTTP response from a GetAdWithResponse call
+func ParseGetAdResponse(rsp *http.Response) (*GetAdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Advertisement
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAdResponse parses an HTTP response from a UpdateAdWithResponse call
+func ParseUpdateAdResponse(rsp *http.Response) (*UpdateAdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Advertisement
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ExclusivityConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveBuildingsFromAdResponse parses an HTTP response from a RemoveBuildingsFromAdWithResponse call
+func ParseRemoveBuildingsFromAdResponse(rsp *http.Response) (*RemoveBuildingsFromAdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveBuildingsFromAdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAdBuildingsResponse parses an HTTP response from a ListAdBuildingsWithResponse call
+func ParseListAdBuildingsResponse(rsp *http.Response) (*ListAdBuildingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAdBuildingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BuildingList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddBuildingsToAdResponse parses an HTTP response from a AddBuildingsToAdWithResponse call
+func ParseAddBuildingsToAdResponse(rsp *http.Response) (*AddBuildingsToAdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddBuildingsToAdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ExclusivityConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdChargesResponse parses an HTTP response from a GetAdChargesWithResponse call
+func ParseGetAdChargesResponse(rsp *http.Response) (*GetAdChargesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdChargesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Charges
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdImpressionsResponse parses an HTTP response from a GetAdImpressionsWithResponse call
+func ParseGetAdImpressionsResponse(rsp *http.Response) (*GetAdImpressionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdImpressionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdImpressionReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBuildingsResponse parses an HTTP response from a ListBuildingsWithResponse call
+func ParseListBuildingsResponse(rsp *http.Response) (*ListBuildingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBuildingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BuildingPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBuildingResponse parses an HTTP response from a CreateBuildingWithResponse call
+func ParseCreateBuildingResponse(rsp *http.Response) (*CreateBuildingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBuildingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Building
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportBuildingsResponse parses an HTTP response from a ImportBuildingsWithResponse call
+func ParseImportBuildingsResponse(rsp *http.Response) (*ImportBuildingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportBuildingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BuildingImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BuildingImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBuildingResponse parses an HTTP response from a DeleteBuildingWithResponse call
+func ParseDeleteBuildingResponse(rsp *http.Response) (*DeleteBuildingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBuildingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildingResponse parses an HTTP response from a GetBuildingWithResponse call
+func ParseGetBuildingResponse(rsp *http.Response) (*GetBuildingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Building
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateBuildingResponse parses an HTTP response from a UpdateBuildingWithResponse call
+func ParseUpdateBuildingResponse(rsp *http.Response) (*UpdateBuildingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateBuildingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Building
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveAdsFromBuildingResponse parses an HTTP response from a RemoveAdsFromBuildingWithResponse call
+func ParseRemoveAdsFromBuildingResponse(rsp *http.Response) (*RemoveAdsFromBuildingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveAdsFromBuildingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBuildingAdsResponse parses an HTTP response from a ListBuildingAdsWithResponse call
+func ParseListBuildingAdsResponse(rsp *http.Response) (*ListBuildingAdsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBuildingAdsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdvertisementList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddAdsToBuildingResponse parses an HTTP response from a AddAdsToBuildingWithResponse call
+func ParseAddAdsToBuildingResponse(rsp *http.Response) (*AddAdsToBuildingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddAdsToBuildingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ExclusivityConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAssignPlacementDaypartResponse parses an HTTP response from a AssignPlacementDaypartWithResponse call
+func ParseAssignPlacementDaypartResponse(rsp *http.Response) (*AssignPlacementDaypartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AssignPlacementDaypartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdvertisementBuilding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePlacementZoneResponse parses an HTTP response from a UpdatePlacementZoneWithResponse call
+func ParseUpdatePlacementZoneResponse(rsp *http.Response) (*UpdatePlacementZoneResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePlacementZoneResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdvertisementBuilding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildingEligibilityResponse parses an HTTP response from a GetBuildingEligibilityWithResponse call
+func ParseGetBuildingEligibilityResponse(rsp *http.Response) (*GetBuildingEligibilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildingEligibilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BuildingEligibility
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListExclusivityRulesResponse parses an HTTP response from a ListExclusivityRulesWithResponse call
+func ParseListExclusivityRulesResponse(rsp *http.Response) (*ListExclusivityRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListExclusivityRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExclusivityRuleList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateExclusivityRuleResponse parses an HTTP response from a CreateExclusivityRuleWithResponse call
+func ParseCreateExclusivityRuleResponse(rsp *http.Response) (*CreateExclusivityRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateExclusivityRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ExclusivityRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ExclusivityConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteExclusivityRuleResponse parses an HTTP response from a DeleteExclusivityRuleWithResponse call
+func ParseDeleteExclusivityRuleResponse(rsp *http.Response) (*DeleteExclusivityRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteExclusivityRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateExclusivityRuleResponse parses an HTTP response from a UpdateExclusivityRuleWithResponse call
+func ParseUpdateExclusivityRuleResponse(rsp *http.Response) (*UpdateExclusivityRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateExclusivityRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExclusivityRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ExclusivityConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildingImpressionsResponse parses an HTTP response from a GetBuildingImpressionsWithResponse call
+func ParseGetBuildingImpressionsResponse(rsp *http.Response) (*GetBuildingImpressionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildingImpressionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BuildingImpressionReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildingLayoutResponse parses an HTTP response from a GetBuildingLayoutWithResponse call
+func ParseGetBuildingLayoutResponse(rsp *http.Response) (*GetBuildingLayoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildingLayoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BuildingLayout
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAssignBuildingLayoutResponse parses an HTTP response from a AssignBuildingLayoutWithResponse call
+func ParseAssignBuildingLayoutResponse(rsp *http.Response) (*AssignBuildingLayoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AssignBuildingLayoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Building
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildingManifestResponse parses an HTTP response from a GetBuildingManifestWithResponse call
+func ParseGetBuildingManifestResponse(rsp *http.Response) (*GetBuildingManifestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildingManifestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Manifest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildingManifestDeltaResponse parses an HTTP response from a GetBuildingManifestDeltaWithResponse call
+func ParseGetBuildingManifestDeltaResponse(rsp *http.Response) (*GetBuildingManifestDeltaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildingManifestDeltaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ManifestDelta
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveNoticesFromBuildingResponse parses an HTTP response from a RemoveNoticesFromBuildingWithResponse call
+func ParseRemoveNoticesFromBuildingResponse(rsp *http.Response) (*RemoveNoticesFromBuildingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveNoticesFromBuildingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBuildingNoticesResponse parses an HTTP response from a ListBuildingNoticesWithResponse call
+func ParseListBuildingNoticesResponse(rsp *http.Response) (*ListBuildingNoticesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBuildingNoticesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NoticeList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddNoticesToBuildingResponse parses an HTTP response from a AddNoticesToBuildingWithResponse call
+func ParseAddNoticesToBuildingResponse(rsp *http.Response) (*AddNoticesToBuildingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddNoticesToBuildingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListActiveBuildingNoticesResponse parses an HTTP response from a ListActiveBuildingNoticesWithResponse call
+func ParseListActiveBuildingNoticesResponse(rsp *http.Response) (*ListActiveBuildingNoticesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListActiveBuildingNoticesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ActiveNoticeList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildingPreviewResponse parses an HTTP response from a GetBuildingPreviewWithResponse call
+func ParseGetBuildingPreviewResponse(rsp *http.Response) (*GetBuildingPreviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildingPreviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BuildingPreview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBuildingTrafficResponse parses an HTTP response from a ListBuildingTrafficWithResponse call
+func ParseListBuildingTrafficResponse(rsp *http.Response) (*ListBuildingTrafficResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBuildingTrafficResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrafficList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutBuildingTrafficResponse parses an HTTP response from a PutBuildingTrafficWithResponse call
+func ParsePutBuildingTrafficResponse(rsp *http.Response) (*PutBuildingTrafficResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutBuildingTrafficResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Count   int    `json:"count"`
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportBuildingTrafficResponse parses an HTTP response from a ImportBuildingTrafficWithResponse call
+func ParseImportBuildingTrafficResponse(rsp *http.Response) (*ImportBuildingTrafficResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportBuildingTrafficResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrafficImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TrafficImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCalendarsResponse parses an HTTP response from a ListCalendarsWithResponse call
+func ParseListCalendarsResponse(rsp *http.Response) (*ListCalendarsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCalendarsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCalendarResponse parses an HTTP response from a CreateCalendarWithResponse call
+func ParseCreateCalendarResponse(rsp *http.Response) (*CreateCalendarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCalendarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Calendar
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCalendarResponse parses an HTTP response from a DeleteCalendarWithResponse call
+func ParseDeleteCalendarResponse(rsp *http.Response) (*DeleteCalendarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCalendarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarResponse parses an HTTP response from a GetCalendarWithResponse call
+func ParseGetCalendarResponse(rsp *http.Response) (*GetCalendarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Calendar
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCalendarResponse parses an HTTP response from a UpdateCalendarWithResponse call
+func ParseUpdateCalendarResponse(rsp *http.Response) (*UpdateCalendarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCalendarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Calendar
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCalendarEventsResponse parses an HTTP response from a ListCalendarEventsWithResponse call
+func ParseListCalendarEventsResponse(rsp *http.Response) (*ListCalendarEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCalendarEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarEventList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCalendarEventResponse parses an HTTP response from a CreateCalendarEventWithResponse call
+func ParseCreateCalendarEventResponse(rsp *http.Response) (*CreateCalendarEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCalendarEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CalendarEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCalendarEventResponse parses an HTTP response from a DeleteCalendarEventWithResponse call
+func ParseDeleteCalendarEventResponse(rsp *http.Response) (*DeleteCalendarEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCalendarEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportCalendarResponse parses an HTTP response from a ImportCalendarWithResponse call
+func ParseImportCalendarResponse(rsp *http.Response) (*ImportCalendarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportCalendarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDaypartsResponse parses an HTTP response from a ListDaypartsWithResponse call
+func ParseListDaypartsResponse(rsp *http.Response) (*ListDaypartsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDaypartsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DaypartPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDaypartResponse parses an HTTP response from a CreateDaypartWithResponse call
+func ParseCreateDaypartResponse(rsp *http.Response) (*CreateDaypartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDaypartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Daypart
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDaypartResponse parses an HTTP response from a DeleteDaypartWithResponse call
+func ParseDeleteDaypartResponse(rsp *http.Response) (*DeleteDaypartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDaypartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDaypartResponse parses an HTTP response from a GetDaypartWithResponse call
+func ParseGetDaypartResponse(rsp *http.Response) (*GetDaypartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDaypartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Daypart
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDaypartResponse parses an HTTP response from a UpdateDaypartWithResponse call
+func ParseUpdateDaypartResponse(rsp *http.Response) (*UpdateDaypartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDaypartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Daypart
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportAdsResponse parses an HTTP response from a ExportAdsWithResponse call
+func ParseExportAdsResponse(rsp *http.Response) (*ExportAdsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportAdsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportAdCertificateResponse parses an HTTP response from a ExportAdCertificateWithResponse call
+func ParseExportAdCertificateResponse(rsp *http.Response) (*ExportAdCertificateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportAdCertificateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportBuildingsResponse parses an HTTP response from a ExportBuildingsWithResponse call
+func ParseExportBuildingsResponse(rsp *http.Response) (*ExportBuildingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportBuildingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportInvoiceResponse parses an HTTP response from a ExportInvoiceWithResponse call
+func ParseExportInvoiceResponse(rsp *http.Response) (*ExportInvoiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportInvoiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportPlacementsResponse parses an HTTP response from a ExportPlacementsWithResponse call
+func ParseExportPlacementsResponse(rsp *http.Response) (*ExportPlacementsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportPlacementsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInvoicesResponse parses an HTTP response from a ListInvoicesWithResponse call
+func ParseListInvoicesResponse(rsp *http.Response) (*ListInvoicesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInvoicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InvoicePage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInvoiceResponse parses an HTTP response from a CreateInvoiceWithResponse call
+func ParseCreateInvoiceResponse(rsp *http.Response) (*CreateInvoiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInvoiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInvoiceResponse parses an HTTP response from a GetInvoiceWithResponse call
+func ParseGetInvoiceResponse(rsp *http.Response) (*GetInvoiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInvoiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVoidInvoiceResponse parses an HTTP response from a VoidInvoiceWithResponse call
+func ParseVoidInvoiceResponse(rsp *http.Response) (*VoidInvoiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VoidInvoiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLayoutsResponse parses an HTTP response from a ListLayoutsWithResponse call
+func ParseListLayoutsResponse(rsp *http.Response) (*ListLayoutsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLayoutsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LayoutPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateLayoutResponse parses an HTTP response from a CreateLayoutWithResponse call
+func ParseCreateLayoutResponse(rsp *http.Response) (*CreateLayoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateLayoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Layout
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteLayoutResponse parses an HTTP response from a DeleteLayoutWithResponse call
+func ParseDeleteLayoutResponse(rsp *http.Response) (*DeleteLayoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteLayoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLayoutResponse parses an HTTP response from a GetLayoutWithResponse call
+func ParseGetLayoutResponse(rsp *http.Response) (*GetLayoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLayoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Layout
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateLayoutResponse parses an HTTP response from a UpdateLayoutWithResponse call
+func ParseUpdateLayoutResponse(rsp *http.Response) (*UpdateLayoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateLayoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Layout
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetManifestPublicKeyResponse parses an HTTP response from a GetManifestPublicKeyWithResponse call
+func ParseGetManifestPublicKeyResponse(rsp *http.Response) (*GetManifestPublicKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetManifestPublicKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ManifestPublicKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNoticesResponse parses an HTTP response from a ListNoticesWithResponse call
+func ParseListNoticesResponse(rsp *http.Response) (*ListNoticesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNoticesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NoticePage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateNoticeResponse parses an HTTP response from a CreateNoticeWithResponse call
+func ParseCreateNoticeResponse(rsp *http.Response) (*CreateNoticeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateNoticeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Notice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteNoticeResponse parses an HTTP response from a DeleteNoticeWithResponse call
+func ParseDeleteNoticeResponse(rsp *http.Response) (*DeleteNoticeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteNoticeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNoticeResponse parses an HTTP response from a GetNoticeWithResponse call
+func ParseGetNoticeResponse(rsp *http.Response) (*GetNoticeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNoticeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Notice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateNoticeResponse parses an HTTP response from a UpdateNoticeWithResponse call
+func ParseUpdateNoticeResponse(rsp *http.Response) (*UpdateNoticeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateNoticeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Notice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveBuildingsFromNoticeResponse parses an HTTP response from a RemoveBuildingsFromNoticeWithResponse call
+func ParseRemoveBuildingsFromNoticeResponse(rsp *http.Response) (*RemoveBuildingsFromNoticeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveBuildingsFromNoticeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNoticeBuildingsResponse parses an HTTP response from a ListNoticeBuildingsWithResponse call
+func ParseListNoticeBuildingsResponse(rsp *http.Response) (*ListNoticeBuildingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNoticeBuildingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BuildingList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddBuildingsToNoticeResponse parses an HTTP response from a AddBuildingsToNoticeWithResponse call
+func ParseAddBuildingsToNoticeResponse(rsp *http.Response) (*AddBuildingsToNoticeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddBuildingsToNoticeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenAPIResponse parses an HTTP response from a GetOpenAPIWithResponse call
+func ParseGetOpenAPIResponse(rsp *http.Response) (*GetOpenAPIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenAPIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRateCardsResponse parses an HTTP response from a ListRateCardsWithResponse call
+func ParseListRateCardsResponse(rsp *http.Response) (*ListRateCardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRateCardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RateCardPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRateCardResponse parses an HTTP response from a CreateRateCardWithResponse call
+func ParseCreateRateCardResponse(rsp *http.Response) (*CreateRateCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRateCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RateCard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRateCardResponse parses an HTTP response from a DeleteRateCardWithResponse call
+func ParseDeleteRateCardResponse(rsp *http.Response) (*DeleteRateCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRateCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRateCardResponse parses an HTTP response from a GetRateCardWithResponse call
+func ParseGetRateCardResponse(rsp *http.Response) (*GetRateCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRateCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RateCard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRateCardResponse parses an HTTP response from a UpdateRateCardWithResponse call
+func ParseUpdateRateCardResponse(rsp *http.Response) (*UpdateRateCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRateCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RateCard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUploadPolicyResponse parses an HTTP response from a GetUploadPolicyWithResponse call
+func ParseGetUploadPolicyResponse(rsp *http.Response) (*GetUploadPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUploadPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PolicyToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHealthzResponse parses an HTTP response from a HealthzWithResponse call
+func ParseHealthzResponse(rsp *http.Response) (*HealthzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMetricsResponse parses an HTTP response from a MetricsWithResponse call
+func ParseMetricsResponse(rsp *http.Response) (*MetricsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MetricsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseReadyzResponse parses an HTTP response from a ReadyzWithResponse call
+func ParseReadyzResponse(rsp *http.Response) (*ReadyzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadyzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HealthStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,9 @@
+// Package client 是根据 openapi/openapi.yaml 生成的类型化 HTTP 客户端，供集成脚本调用
+//
+// 修改 openapi.yaml 后执行 go generate ./client 重新生成 client.gen.go。
+//
+//	c, err := client.NewClientWithResponses("http://localhost:8080", client.WithRequestEditorFn(client.BearerToken(token)))
+//	resp, err := c.ListAdsWithResponse(ctx, &client.ListAdsParams{})
+package client
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.3.0 -config oapi-codegen.yaml ../openapi/openapi.yaml
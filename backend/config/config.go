@@ -2,57 +2,34 @@ package config
 
 import (
 	"fmt"
-	"os"
+	"time"
 
-	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/logging"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 	"gorm.io/gorm/schema"
 )
 
 var DB *gorm.DB
 
 // InitDB 初始化数据库连接，并返回错误
-func InitDB() error {
-	dsn := os.Getenv("POSTGRES_DSN")
-	if dsn == "" {
-		return fmt.Errorf("环境变量 POSTGRES_DSN 未设置")
-	}
-
+// 表结构由 migrations 包管理
+// SQL 日志写入请求上下文中的日志器，slowThreshold 为慢查询阈值
+func InitDB(cfg DatabaseConfig, slowThreshold time.Duration) error {
 	var err error
-	DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
+	DB, err = gorm.Open(postgres.Open(cfg.DSN), &gorm.Config{
 		NamingStrategy: schema.NamingStrategy{
 			SingularTable: false,
 		},
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger: logging.NewGormLogger(slowThreshold),
+		// 将唯一约束冲突等错误转换为 gorm.ErrDuplicatedKey，供仓库层识别
+		TranslateError: true,
+		// 时间统一按 UTC 写入，与数据库会话、服务器的时区无关；大厦当地时间由 region 包换算
+		NowFunc: func() time.Time { return time.Now().UTC() },
 	})
 	if err != nil {
 		return fmt.Errorf("连接数据库失败: %w", err)
 	}
 
-	err = DB.AutoMigrate(
-		&models.Advertisement{},
-		&models.Building{},
-		&models.Administrator{},
-		&models.AdvertisementBuilding{},
-	)
-	if err != nil {
-		return fmt.Errorf("数据库迁移失败: %w", err)
-	}
-
-	// 手动添加唯一约束以防止重复关联
-	err = DB.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_advertisement_building ON advertisement_buildings (advertisement_id, building_id);`).Error
-	if err != nil {
-		return fmt.Errorf("创建唯一索引失败: %w", err)
-	}
-
-	// 如果有其他表需要创建唯一索引，请在此处添加
-	// 例如:
-	// err = DB.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_building_notice ON building_notices (building_id, notice_id);`).Error
-	// if err != nil {
-	// 	return fmt.Errorf("创建 building_notices 唯一索引失败: %w", err)
-	// }
-
 	return nil
 }
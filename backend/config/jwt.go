@@ -1,23 +1,54 @@
 package config
 
 import (
+	"errors"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
 )
 
-var jwtSecret = []byte("your_secret_key") // 请使用更安全的方式管理密钥
+var (
+	jwtSecret    []byte
+	tokenTTL     = 24 * time.Hour
+	challengeTTL = 5 * time.Minute
+)
+
+// PurposeMFA 两步验证挑战令牌的用途，只能用于提交验证码，不能访问接口
+const PurposeMFA = "mfa"
+
+// ErrTokenPurpose 令牌用途与预期不符
+var ErrTokenPurpose = errors.New("令牌用途不匹配")
 
 type Claims struct {
 	Username string `json:"username"`
+	Purpose  string `json:"purpose,omitempty"` // 为空表示访问令牌
 	jwt.StandardClaims
 }
 
+// InitJWT 设置签名密钥与令牌有效期，需在签发或校验令牌前调用
+func InitJWT(cfg JWTConfig) {
+	jwtSecret = []byte(cfg.Secret)
+	tokenTTL = cfg.TTL
+	if cfg.ChallengeTTL > 0 {
+		challengeTTL = cfg.ChallengeTTL
+	}
+}
+
 // GenerateToken 生成 JWT
 func GenerateToken(username string) (string, error) {
-	expirationTime := time.Now().Add(24 * time.Hour)
+	return generateToken(username, "", tokenTTL)
+}
+
+// GenerateChallengeToken 生成密码验证通过后、提交两步验证码前使用的短期令牌
+func GenerateChallengeToken(username string) (string, error) {
+	return generateToken(username, PurposeMFA, challengeTTL)
+}
+
+func generateToken(username, purpose string, ttl time.Duration) (string, error) {
+	expirationTime := time.Now().Add(ttl)
 	claims := &Claims{
 		Username: username,
+		Purpose:  purpose,
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: expirationTime.Unix(),
 			IssuedAt:  time.Now().Unix(),
@@ -28,8 +59,17 @@ func GenerateToken(username string) (string, error) {
 	return token.SignedString(jwtSecret)
 }
 
-// ValidateToken 验证 JWT
+// ValidateToken 验证访问令牌，挑战令牌视为无效
 func ValidateToken(tokenStr string) (*Claims, error) {
+	return validateToken(tokenStr, "")
+}
+
+// ValidateChallengeToken 验证两步验证挑战令牌
+func ValidateChallengeToken(tokenStr string) (*Claims, error) {
+	return validateToken(tokenStr, PurposeMFA)
+}
+
+func validateToken(tokenStr, purpose string) (*Claims, error) {
 	claims := &Claims{}
 	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
 		return jwtSecret, nil
@@ -37,5 +77,8 @@ func ValidateToken(tokenStr string) (*Claims, error) {
 	if err != nil || !token.Valid {
 		return nil, err
 	}
+	if claims.Purpose != purpose {
+		return nil, ErrTokenPurpose
+	}
 	return claims, nil
 }
@@ -0,0 +1,494 @@
+package config
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/joho/godotenv"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+// Config 应用的全部配置，按 默认值 < 配置文件 < 环境变量 < 命令行参数 的优先级加载
+type Config struct {
+	Server    ServerConfig    `yaml:"server"`
+	Database  DatabaseConfig  `yaml:"database"`
+	JWT       JWTConfig       `yaml:"jwt"`
+	OSS       OSSConfig       `yaml:"oss"`
+	Documents DocumentsConfig `yaml:"documents"`
+	Log       LogConfig       `yaml:"log"`
+	Auth      AuthConfig      `yaml:"auth"`
+	Webhooks  WebhookConfig   `yaml:"webhooks"`
+	Manifest  ManifestConfig  `yaml:"manifest"`
+	Region    RegionConfig    `yaml:"region"`
+	Playlist  PlaylistConfig  `yaml:"playlist"`
+	Lifecycle LifecycleConfig `yaml:"lifecycle"`
+}
+
+// ServerConfig HTTP 服务配置
+type ServerConfig struct {
+	Port            int           `yaml:"port"`             // 环境变量 PORT
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"` // 环境变量 SHUTDOWN_TIMEOUT，优雅关闭时等待请求完成的最长时间
+	DrainDelay      time.Duration `yaml:"drain_delay"`      // 环境变量 SHUTDOWN_DRAIN_DELAY，就绪检查失败后到停止接收请求的等待时间
+}
+
+// DatabaseConfig 数据库配置
+type DatabaseConfig struct {
+	DSN string `yaml:"dsn"` // 环境变量 POSTGRES_DSN
+}
+
+// JWTConfig 登录令牌配置
+type JWTConfig struct {
+	Secret       string        `yaml:"secret"`        // 环境变量 JWT_SECRET
+	TTL          time.Duration `yaml:"ttl"`           // 环境变量 JWT_TTL
+	ChallengeTTL time.Duration `yaml:"challenge_ttl"` // 环境变量 JWT_CHALLENGE_TTL，密码验证通过后提交两步验证码的时限
+}
+
+// OSSConfig 阿里云 OSS 上传策略配置
+type OSSConfig struct {
+	AccessKeyID     string        `yaml:"access_key_id"`     // 环境变量 ACCESS_KEY_ID
+	AccessKeySecret string        `yaml:"access_key_secret"` // 环境变量 ACCESS_KEY_SECRET
+	Host            string        `yaml:"host"`              // 环境变量 HOST
+	PolicyExpire    time.Duration `yaml:"policy_expire"`     // 环境变量 OSS_POLICY_EXPIRE
+}
+
+// DocumentsConfig PDF 文档配置
+type DocumentsConfig struct {
+	FontPath string `yaml:"font_path"` // 环境变量 PDF_FONT_PATH，支持中文的 TTF 字体
+}
+
+// LogConfig 日志配置
+type LogConfig struct {
+	Level         string        `yaml:"level"`          // 环境变量 LOG_LEVEL：debug、info、warn、error，debug 时输出 SQL
+	Format        string        `yaml:"format"`         // 环境变量 LOG_FORMAT：json、text
+	SlowThreshold time.Duration `yaml:"slow_threshold"` // 环境变量 LOG_SLOW_QUERY，超过该时长的 SQL 以 warn 级别记录
+}
+
+// AuthConfig 登录保护与限流配置，限流值为每分钟请求数，0 表示不限制
+type AuthConfig struct {
+	LoginPerIP       int            `yaml:"login_per_ip"`      // 环境变量 RATE_LIMIT_LOGIN_IP，每个 IP 每分钟的登录请求数
+	LoginPerUser     int            `yaml:"login_per_user"`    // 环境变量 RATE_LIMIT_LOGIN_USER，每个用户名每分钟的登录请求数
+	APIPerUser       int            `yaml:"api_per_user"`      // 环境变量 RATE_LIMIT_API_USER，每个登录用户每分钟的接口请求数
+	LockoutThreshold int            `yaml:"lockout_threshold"` // 环境变量 LOCKOUT_THRESHOLD，连续登录失败多少次后锁定账号，0 表示不锁定
+	LockoutDuration  time.Duration  `yaml:"lockout_duration"`  // 环境变量 LOCKOUT_DURATION，账号锁定时长
+	Password         PasswordPolicy `yaml:"password"`
+	TOTP             TOTPConfig     `yaml:"totp"`
+}
+
+// TOTPConfig 两步验证配置
+type TOTPConfig struct {
+	Issuer            string `yaml:"issuer"`              // 环境变量 TOTP_ISSUER，验证器应用中显示的发行方
+	RequireSuperAdmin bool   `yaml:"require_super_admin"` // 环境变量 TOTP_REQUIRE_SUPER_ADMIN，超级管理员必须启用两步验证
+}
+
+// PasswordPolicy 管理员密码强度要求
+type PasswordPolicy struct {
+	MinLength  int `yaml:"min_length"`  // 环境变量 PASSWORD_MIN_LENGTH，最小长度（字符数）
+	MinClasses int `yaml:"min_classes"` // 环境变量 PASSWORD_MIN_CLASSES，至少包含几类字符：小写字母、大写字母、数字、符号
+}
+
+// WebhookConfig 事件推送（Webhook）投递配置
+type WebhookConfig struct {
+	PollInterval time.Duration `yaml:"poll_interval"` // 环境变量 WEBHOOK_POLL_INTERVAL，扫描待投递事件的间隔
+	BatchSize    int           `yaml:"batch_size"`    // 环境变量 WEBHOOK_BATCH_SIZE，每次扫描最多投递的事件数
+	Timeout      time.Duration `yaml:"timeout"`       // 环境变量 WEBHOOK_TIMEOUT，单次投递的 HTTP 超时
+	MaxAttempts  int           `yaml:"max_attempts"`  // 环境变量 WEBHOOK_MAX_ATTEMPTS，超过后不再重试
+	BackoffBase  time.Duration `yaml:"backoff_base"`  // 环境变量 WEBHOOK_BACKOFF_BASE，第一次重试的等待时间，之后每次翻倍
+	BackoffMax   time.Duration `yaml:"backoff_max"`   // 环境变量 WEBHOOK_BACKOFF_MAX，重试等待时间上限
+}
+
+// ManifestConfig 离线播放清单配置
+type ManifestConfig struct {
+	SigningKey string `yaml:"signing_key"` // 环境变量 MANIFEST_SIGNING_KEY，base64 编码的 Ed25519 私钥（32 字节种子或 64 字节私钥），必须设置
+	History    int    `yaml:"history"`     // 环境变量 MANIFEST_HISTORY，每个大厦保留的历史版本数，早于此的版本无法计算增量
+}
+
+// RegionConfig 大厦未设置时区、语言时使用的默认值
+type RegionConfig struct {
+	DefaultTimezone string `yaml:"default_timezone"` // 环境变量 DEFAULT_TIMEZONE，IANA 时区，用于分时规则与按天汇总
+	DefaultLocale   string `yaml:"default_locale"`   // 环境变量 DEFAULT_LOCALE，BCP 47 语言标签
+}
+
+// PlaylistConfig 播放端轮播配置
+type PlaylistConfig struct {
+	LoopCapacity time.Duration `yaml:"loop_capacity"` // 环境变量 PLAYLIST_LOOP_CAPACITY，每个区域一轮播放的最长时长，0 表示不限制
+}
+
+// LifecycleConfig 广告按上线与到期时间自动切换状态的配置
+type LifecycleConfig struct {
+	Interval  time.Duration `yaml:"interval"`   // 环境变量 AD_LIFECYCLE_INTERVAL，扫描到达上线或到期时间的广告的间隔
+	BatchSize int           `yaml:"batch_size"` // 环境变量 AD_LIFECYCLE_BATCH_SIZE，每次扫描最多切换的广告数
+}
+
+// Default 返回默认配置
+func Default() Config {
+	return Config{
+		Server: ServerConfig{Port: 8080, ShutdownTimeout: 15 * time.Second},
+		JWT:    JWTConfig{TTL: 24 * time.Hour, ChallengeTTL: 5 * time.Minute},
+		OSS:    OSSConfig{PolicyExpire: 30 * time.Second},
+		Log:    LogConfig{Level: "info", Format: "json", SlowThreshold: 200 * time.Millisecond},
+		Auth: AuthConfig{
+			LoginPerIP:       20,
+			LoginPerUser:     5,
+			APIPerUser:       600,
+			LockoutThreshold: 5,
+			LockoutDuration:  15 * time.Minute,
+			Password:         PasswordPolicy{MinLength: 8, MinClasses: 3},
+			TOTP:             TOTPConfig{Issuer: "AdvertisementManagement"},
+		},
+		Webhooks: WebhookConfig{
+			PollInterval: 5 * time.Second,
+			BatchSize:    50,
+			Timeout:      10 * time.Second,
+			MaxAttempts:  8,
+			BackoffBase:  30 * time.Second,
+			BackoffMax:   time.Hour,
+		},
+		Manifest:  ManifestConfig{History: 20},
+		Region:    RegionConfig{DefaultTimezone: "Asia/Shanghai", DefaultLocale: "zh-CN"},
+		Playlist:  PlaylistConfig{LoopCapacity: 5 * time.Minute},
+		Lifecycle: LifecycleConfig{Interval: time.Minute, BatchSize: 100},
+	}
+}
+
+// Load 解析命令行参数并加载配置，返回解析后剩余的位置参数
+// .env 文件是可选的，存在时其中的变量会补充到环境变量中
+func Load(args []string) (*Config, []string, error) {
+	flags := flag.NewFlagSet("advertisement-management-system", flag.ContinueOnError)
+	configFile := flags.String("config", os.Getenv("CONFIG_FILE"), "YAML 配置文件路径（环境变量 CONFIG_FILE）")
+	envFile := flags.String("env-file", ".env", "可选的 .env 文件路径")
+	port := flags.Int("port", 0, "HTTP 监听端口，覆盖 PORT")
+	dsn := flags.String("dsn", "", "Postgres DSN，覆盖 POSTGRES_DSN")
+	if err := flags.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	// 加载 .env，文件不存在时忽略
+	if err := godotenv.Load(*envFile); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, fmt.Errorf("加载 %s 失败: %w", *envFile, err)
+	}
+
+	cfg := Default()
+	if *configFile != "" {
+		if err := cfg.loadFile(*configFile); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := cfg.loadEnv(); err != nil {
+		return nil, nil, err
+	}
+
+	if *port != 0 {
+		cfg.Server.Port = *port
+	}
+	if *dsn != "" {
+		cfg.Database.DSN = *dsn
+	}
+	return &cfg, flags.Args(), nil
+}
+
+// loadFile 从 YAML 文件加载配置
+func (c *Config) loadFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("读取配置文件 %s 失败: %w", path, err)
+	}
+	if err := yaml.Unmarshal(content, c); err != nil {
+		return fmt.Errorf("解析配置文件 %s 失败: %w", path, err)
+	}
+	return nil
+}
+
+// loadEnv 使用环境变量覆盖配置
+func (c *Config) loadEnv() error {
+	var errs []error
+	envInt("PORT", &c.Server.Port, &errs)
+	envDuration("SHUTDOWN_TIMEOUT", &c.Server.ShutdownTimeout, &errs)
+	envDuration("SHUTDOWN_DRAIN_DELAY", &c.Server.DrainDelay, &errs)
+	envString("POSTGRES_DSN", &c.Database.DSN)
+	envString("JWT_SECRET", &c.JWT.Secret)
+	envDuration("JWT_TTL", &c.JWT.TTL, &errs)
+	envDuration("JWT_CHALLENGE_TTL", &c.JWT.ChallengeTTL, &errs)
+	envString("ACCESS_KEY_ID", &c.OSS.AccessKeyID)
+	envString("ACCESS_KEY_SECRET", &c.OSS.AccessKeySecret)
+	envString("HOST", &c.OSS.Host)
+	envDuration("OSS_POLICY_EXPIRE", &c.OSS.PolicyExpire, &errs)
+	envString("PDF_FONT_PATH", &c.Documents.FontPath)
+	envString("LOG_LEVEL", &c.Log.Level)
+	envString("LOG_FORMAT", &c.Log.Format)
+	envDuration("LOG_SLOW_QUERY", &c.Log.SlowThreshold, &errs)
+	envInt("RATE_LIMIT_LOGIN_IP", &c.Auth.LoginPerIP, &errs)
+	envInt("RATE_LIMIT_LOGIN_USER", &c.Auth.LoginPerUser, &errs)
+	envInt("RATE_LIMIT_API_USER", &c.Auth.APIPerUser, &errs)
+	envInt("LOCKOUT_THRESHOLD", &c.Auth.LockoutThreshold, &errs)
+	envDuration("LOCKOUT_DURATION", &c.Auth.LockoutDuration, &errs)
+	envInt("PASSWORD_MIN_LENGTH", &c.Auth.Password.MinLength, &errs)
+	envInt("PASSWORD_MIN_CLASSES", &c.Auth.Password.MinClasses, &errs)
+	envString("TOTP_ISSUER", &c.Auth.TOTP.Issuer)
+	envBool("TOTP_REQUIRE_SUPER_ADMIN", &c.Auth.TOTP.RequireSuperAdmin, &errs)
+	envDuration("WEBHOOK_POLL_INTERVAL", &c.Webhooks.PollInterval, &errs)
+	envInt("WEBHOOK_BATCH_SIZE", &c.Webhooks.BatchSize, &errs)
+	envDuration("WEBHOOK_TIMEOUT", &c.Webhooks.Timeout, &errs)
+	envInt("WEBHOOK_MAX_ATTEMPTS", &c.Webhooks.MaxAttempts, &errs)
+	envDuration("WEBHOOK_BACKOFF_BASE", &c.Webhooks.BackoffBase, &errs)
+	envDuration("WEBHOOK_BACKOFF_MAX", &c.Webhooks.BackoffMax, &errs)
+	envString("MANIFEST_SIGNING_KEY", &c.Manifest.SigningKey)
+	envInt("MANIFEST_HISTORY", &c.Manifest.History, &errs)
+	envString("DEFAULT_TIMEZONE", &c.Region.DefaultTimezone)
+	envString("DEFAULT_LOCALE", &c.Region.DefaultLocale)
+	envDuration("PLAYLIST_LOOP_CAPACITY", &c.Playlist.LoopCapacity, &errs)
+	envDuration("AD_LIFECYCLE_INTERVAL", &c.Lifecycle.Interval, &errs)
+	envInt("AD_LIFECYCLE_BATCH_SIZE", &c.Lifecycle.BatchSize, &errs)
+	return errors.Join(errs...)
+}
+
+// Validate 校验启动服务所需的全部配置，返回所有问题
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		errs = append(errs, fmt.Errorf("端口 %d 无效，应在 1-65535 之间", c.Server.Port))
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("SHUTDOWN_TIMEOUT 必须大于 0"))
+	}
+	if c.Server.DrainDelay < 0 {
+		errs = append(errs, errors.New("SHUTDOWN_DRAIN_DELAY 不能为负数"))
+	}
+	if err := c.Database.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(c.JWT.Secret) < 16 {
+		errs = append(errs, errors.New("JWT_SECRET 未设置或长度不足 16 个字符"))
+	}
+	if c.JWT.TTL <= 0 {
+		errs = append(errs, errors.New("JWT_TTL 必须大于 0"))
+	}
+	if c.JWT.ChallengeTTL <= 0 {
+		errs = append(errs, errors.New("JWT_CHALLENGE_TTL 必须大于 0"))
+	}
+	if c.OSS.AccessKeyID == "" {
+		errs = append(errs, errors.New("ACCESS_KEY_ID 未设置"))
+	}
+	if c.OSS.AccessKeySecret == "" {
+		errs = append(errs, errors.New("ACCESS_KEY_SECRET 未设置"))
+	}
+	if c.OSS.Host == "" {
+		errs = append(errs, errors.New("HOST（OSS 上传地址）未设置"))
+	}
+	if c.OSS.PolicyExpire <= 0 {
+		errs = append(errs, errors.New("OSS_POLICY_EXPIRE 必须大于 0"))
+	}
+	if c.Documents.FontPath != "" {
+		if _, err := os.Stat(c.Documents.FontPath); err != nil {
+			errs = append(errs, fmt.Errorf("PDF_FONT_PATH 指向的字体文件不可用: %w", err))
+		}
+	}
+	if err := c.Log.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Auth.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Webhooks.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Manifest.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Region.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.Playlist.LoopCapacity < 0 {
+		errs = append(errs, errors.New("PLAYLIST_LOOP_CAPACITY 不能为负数"))
+	}
+	if err := c.Lifecycle.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Validate 校验登录保护配置
+func (a AuthConfig) Validate() error {
+	var errs []error
+	if a.LoginPerIP < 0 {
+		errs = append(errs, errors.New("RATE_LIMIT_LOGIN_IP 不能为负数"))
+	}
+	if a.LoginPerUser < 0 {
+		errs = append(errs, errors.New("RATE_LIMIT_LOGIN_USER 不能为负数"))
+	}
+	if a.APIPerUser < 0 {
+		errs = append(errs, errors.New("RATE_LIMIT_API_USER 不能为负数"))
+	}
+	if a.LockoutThreshold < 0 {
+		errs = append(errs, errors.New("LOCKOUT_THRESHOLD 不能为负数"))
+	}
+	if a.LockoutThreshold > 0 && a.LockoutDuration <= 0 {
+		errs = append(errs, errors.New("启用账号锁定时 LOCKOUT_DURATION 必须大于 0"))
+	}
+	// bcrypt 只使用密码的前 72 个字节
+	if a.Password.MinLength < 1 || a.Password.MinLength > 72 {
+		errs = append(errs, errors.New("PASSWORD_MIN_LENGTH 应在 1-72 之间"))
+	}
+	if a.Password.MinClasses < 0 || a.Password.MinClasses > 4 {
+		errs = append(errs, errors.New("PASSWORD_MIN_CLASSES 应在 0-4 之间"))
+	}
+	if strings.TrimSpace(a.TOTP.Issuer) == "" || strings.Contains(a.TOTP.Issuer, ":") {
+		errs = append(errs, errors.New("TOTP_ISSUER 不能为空，且不能包含冒号"))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate 校验事件推送配置
+func (w WebhookConfig) Validate() error {
+	var errs []error
+	if w.PollInterval <= 0 {
+		errs = append(errs, errors.New("WEBHOOK_POLL_INTERVAL 必须大于 0"))
+	}
+	if w.BatchSize < 1 {
+		errs = append(errs, errors.New("WEBHOOK_BATCH_SIZE 必须大于 0"))
+	}
+	if w.Timeout <= 0 {
+		errs = append(errs, errors.New("WEBHOOK_TIMEOUT 必须大于 0"))
+	}
+	if w.MaxAttempts < 1 {
+		errs = append(errs, errors.New("WEBHOOK_MAX_ATTEMPTS 必须大于 0"))
+	}
+	if w.BackoffBase <= 0 || w.BackoffMax < w.BackoffBase {
+		errs = append(errs, errors.New("WEBHOOK_BACKOFF_BASE 必须大于 0，且不能大于 WEBHOOK_BACKOFF_MAX"))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate 校验播放清单配置
+func (m ManifestConfig) Validate() error {
+	var errs []error
+	// 各实例与每次重启必须使用同一密钥，否则播放端保存的公钥会失效，因此不允许使用临时密钥
+	if m.SigningKey == "" {
+		errs = append(errs, errors.New("MANIFEST_SIGNING_KEY 未设置，可用 openssl rand -base64 32 生成"))
+	} else {
+		key, err := base64.StdEncoding.DecodeString(m.SigningKey)
+		if err != nil || (len(key) != ed25519.SeedSize && len(key) != ed25519.PrivateKeySize) {
+			errs = append(errs, errors.New("MANIFEST_SIGNING_KEY 应为 base64 编码的 32 字节种子或 64 字节 Ed25519 私钥"))
+		}
+	}
+	if m.History < 2 {
+		errs = append(errs, errors.New("MANIFEST_HISTORY 至少为 2"))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate 校验默认时区与语言
+func (r RegionConfig) Validate() error {
+	var errs []error
+	if _, err := r.Location(); err != nil {
+		errs = append(errs, fmt.Errorf("DEFAULT_TIMEZONE 不是有效的 IANA 时区: %w", err))
+	}
+	if _, err := language.Parse(r.DefaultLocale); err != nil {
+		errs = append(errs, fmt.Errorf("DEFAULT_LOCALE 不是有效的 BCP 47 语言标签: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate 校验广告状态自动切换配置
+func (l LifecycleConfig) Validate() error {
+	var errs []error
+	if l.Interval <= 0 {
+		errs = append(errs, errors.New("AD_LIFECYCLE_INTERVAL 必须大于 0"))
+	}
+	if l.BatchSize < 1 {
+		errs = append(errs, errors.New("AD_LIFECYCLE_BATCH_SIZE 必须大于 0"))
+	}
+	return errors.Join(errs...)
+}
+
+// Location 返回默认时区
+func (r RegionConfig) Location() (*time.Location, error) {
+	if r.DefaultTimezone == "" || r.DefaultTimezone == "Local" {
+		return nil, errors.New("时区必须是 IANA 时区名称")
+	}
+	return time.LoadLocation(r.DefaultTimezone)
+}
+
+// Validate 校验日志配置
+func (l LogConfig) Validate() error {
+	var errs []error
+	if _, err := logging.New(io.Discard, l.Level, l.Format); err != nil {
+		errs = append(errs, err)
+	}
+	if l.SlowThreshold < 0 {
+		errs = append(errs, errors.New("LOG_SLOW_QUERY 不能为负数"))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate 校验数据库配置，migrate 子命令只需要这部分配置
+func (d DatabaseConfig) Validate() error {
+	if d.DSN == "" {
+		return errors.New("POSTGRES_DSN 未设置")
+	}
+	if _, err := pgconn.ParseConfig(d.DSN); err != nil {
+		return fmt.Errorf("POSTGRES_DSN 格式错误: %w", err)
+	}
+	return nil
+}
+
+// Addr 返回 HTTP 监听地址
+func (s ServerConfig) Addr() string {
+	return ":" + strconv.Itoa(s.Port)
+}
+
+func envString(key string, dest *string) {
+	if value, ok := os.LookupEnv(key); ok && strings.TrimSpace(value) != "" {
+		*dest = strings.TrimSpace(value)
+	}
+}
+
+func envInt(key string, dest *int, errs *[]error) {
+	value, ok := os.LookupEnv(key)
+	if !ok || strings.TrimSpace(value) == "" {
+		return
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		*errs = append(*errs, fmt.Errorf("环境变量 %s=%q 不是有效的整数", key, value))
+		return
+	}
+	*dest = n
+}
+
+func envBool(key string, dest *bool, errs *[]error) {
+	value, ok := os.LookupEnv(key)
+	if !ok || strings.TrimSpace(value) == "" {
+		return
+	}
+	b, err := strconv.ParseBool(strings.TrimSpace(value))
+	if err != nil {
+		*errs = append(*errs, fmt.Errorf("环境变量 %s=%q 不是有效的布尔值（true、false）", key, value))
+		return
+	}
+	*dest = b
+}
+
+func envDuration(key string, dest *time.Duration, errs *[]error) {
+	value, ok := os.LookupEnv(key)
+	if !ok || strings.TrimSpace(value) == "" {
+		return
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		*errs = append(*errs, fmt.Errorf("环境变量 %s=%q 不是有效的时长（例如 30s、24h）", key, value))
+		return
+	}
+	*dest = d
+}
@@ -1,23 +1,35 @@
 package controllers
 
 import (
+	"context"
+	"errors"
 	"net/http"
-	"strconv"
+	"strings"
+	"time"
 
-	"github.com/10240418/advertisement-management-system/backend/config"
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/exclusivity"
 	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/10240418/advertisement-management-system/backend/webhooks"
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
 // UpdateAdInput 定义更新广告的输入结构体
 type UpdateAdInput struct {
-	Title         string `json:"title"`
-	Description   string `json:"description"`
-	ImageURL      string `json:"image_url"`
-	VideoURL      string `json:"video_url"`
-	Status        string `json:"status"`
-	VideoDuration int64  `json:"video_duration"` // 以秒为单位
+	Title         string  `json:"title"`
+	Description   string  `json:"description"`
+	ImageURL      string  `json:"image_url"`
+	ImageSize     int64   `json:"image_size" binding:"gte=0"`
+	ImageSHA256   string  `json:"image_sha256" binding:"omitempty,len=64,hexadecimal"`
+	VideoURL      string  `json:"video_url"`
+	VideoSize     int64   `json:"video_size" binding:"gte=0"`
+	VideoSHA256   string  `json:"video_sha256" binding:"omitempty,len=64,hexadecimal"`
+	Status        string  `json:"status"`
+	VideoDuration int64   `json:"video_duration"`                      // 以秒为单位
+	Category      *string `json:"category" binding:"omitempty,max=50"` // 为 nil 时不修改，空字符串表示清除类别
+	GoLiveAt      *string `json:"go_live_at"`                          // RFC 3339 时间，为 nil 时不修改，空字符串表示清除
+	ExpiresAt     *string `json:"expires_at"`                          // RFC 3339 时间，为 nil 时不修改，空字符串表示清除
 }
 
 // UpdatePlayDurationInput 定义更新播放时长的输入结构体
@@ -27,47 +39,25 @@ type UpdatePlayDurationInput struct {
 	PlayDuration    int64 `json:"play_duration" binding:"required"`
 }
 
-// GetAds 获取所有广告，并支持分页和排序
-func GetAds(c *gin.Context) {
-	// 从查询参数中获取分页信息
-	pageNumStr := c.DefaultQuery("pageNum", "1")
-	pageSizeStr := c.DefaultQuery("pageSize", "10")
-	desc := c.DefaultQuery("desc", "true")
-
-	pageNum, err := strconv.Atoi(pageNumStr)
-	if err != nil {
-		pageNum = 1
-	}
-
-	pageSize, err := strconv.Atoi(pageSizeStr)
-	if err != nil {
-		pageSize = 10
-	}
-
-	offset := (pageNum - 1) * pageSize
-
-	var ads []models.Advertisement
-	var count int64
-
-	// 构建基础查询
-	baseQuery := config.DB.Model(&models.Advertisement{})
+// AdController 处理广告及广告与大厦关联相关的请求
+type AdController struct {
+	store repository.Store
+}
 
-	// 添加排序
-	if desc == "true" {
-		baseQuery = baseQuery.Order("created_at DESC")
-	} else {
-		baseQuery = baseQuery.Order("created_at ASC")
-	}
+// NewAdController 创建 AdController
+func NewAdController(store repository.Store) *AdController {
+	return &AdController{store: store}
+}
 
-	// 获取总记录数不包含limit和offset
-	if err := baseQuery.Count(&count).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取总数失败"})
-		return
-	}
+// GetAds 获取所有广告，并支持分页和排序
+func (ctl *AdController) GetAds(c *gin.Context) {
+	// 从查询参数中获取分页信息
+	pageNum, pageSize, opts := pagination(c)
 
 	// 执行查询并进行分页
-	if err := baseQuery.Offset(offset).Limit(pageSize).Find(&ads).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取广告失败"})
+	ads, count, err := ctl.store.Ads().List(c.Request.Context(), opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
@@ -81,19 +71,16 @@ func GetAds(c *gin.Context) {
 }
 
 // GetAd 获取单个广告
-func GetAd(c *gin.Context) {
-	id := c.Param("id")
-	var ad models.Advertisement
+func (ctl *AdController) GetAd(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
 
 	// 查找广告并预加载关联的 AdvertisementBuildings 和 Building
-	if err := config.DB.
-		Preload("AdvertisementBuildings.Building").
-		First(&ad, id).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "广告未找到"})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "获取广告失败"})
-		}
+	ad, err := ctl.store.Ads().GetWithPlacements(c.Request.Context(), id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeAdNotFound))
 		return
 	}
 
@@ -101,110 +88,124 @@ func GetAd(c *gin.Context) {
 }
 
 // CreateAd 创建新广告
-func CreateAd(c *gin.Context) {
+func (ctl *AdController) CreateAd(c *gin.Context) {
 	var input models.Advertisement
 
 	// 绑定 JSON 数据到 Advertisement 结构体
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Respond(c, apierror.FromBinding(err))
 		return
 	}
-
-	// 开始事务
-	tx := config.DB.Begin()
-	if tx.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "启动事务失败"})
+	// 关联通过专门的接口维护，创建时忽略
+	input.AdvertisementBuildings = nil
+	input.ImageSHA256 = strings.ToLower(input.ImageSHA256)
+	input.VideoSHA256 = strings.ToLower(input.VideoSHA256)
+	input.Category = exclusivity.NormalizeCategory(input.Category)
+	// 切换时间由后台任务记录
+	input.ActivatedAt, input.ExpiredAt = nil, nil
+	if apiErr := applyLifecycle(&input, input.GoLiveAt != nil, input.ExpiresAt != nil, time.Now()); apiErr != nil {
+		apierror.Respond(c, apiErr)
 		return
 	}
 
-	// 创建广告
-	if err := tx.Create(&input).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "创建广告失败"})
-		return
-	}
+	ctx := c.Request.Context()
 
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "提交事务失败"})
+	// 创建广告，事件与广告在同一事务中写入
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Ads().Create(ctx, &input); err != nil {
+			return err
+		}
+		return webhooks.Emit(ctx, tx, webhooks.AdEvent(models.EventAdCreated, input))
+	})
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
 	// 预加载关联数据返回
-	if err := config.DB.Preload("AdvertisementBuildings.Building").First(&input, input.ID).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取广告失败"})
+	ad, err := ctl.store.Ads().GetWithPlacements(ctx, input.ID)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
-	c.JSON(http.StatusCreated, input)
+	c.JSON(http.StatusCreated, ad)
 }
 
 // UpdateAd 更新广告的基本信息
-func UpdateAd(c *gin.Context) {
-	id := c.Param("id")
-	var ad models.Advertisement
-
-	// 查找广告
-	if err := config.DB.First(&ad, id).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "广告未找到"})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "获取广告失败"})
-		}
+func (ctl *AdController) UpdateAd(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
 		return
 	}
+	ctx := c.Request.Context()
 
 	var input UpdateAdInput
 
 	// 绑定 JSON 数据到 input 结构体
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Respond(c, apierror.FromBinding(err))
 		return
 	}
 
-	// 更新广告字段
-	if input.Title != "" {
-		ad.Title = input.Title
-	}
-	if input.Description != "" {
-		ad.Description = input.Description
+	apiErr := apierror.BadRequest(apierror.CodeValidationFailed)
+	var goLiveAt, expiresAt *time.Time
+	if input.GoLiveAt != nil {
+		goLiveAt = optionalTime(apiErr, "go_live_at", *input.GoLiveAt)
 	}
-	if input.ImageURL != "" {
-		ad.ImageURL = input.ImageURL
+	if input.ExpiresAt != nil {
+		expiresAt = optionalTime(apiErr, "expires_at", *input.ExpiresAt)
 	}
-	if input.VideoURL != "" {
-		ad.VideoURL = input.VideoURL
-	}
-	if input.Status != "" {
-		ad.Status = input.Status
-	}
-	if input.VideoDuration != 0 {
-		ad.VideoDuration = input.VideoDuration
-	}
-
-	// 开始事务
-	tx := config.DB.Begin()
-	if tx.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "启动事务失败"})
+	if len(apiErr.Details) > 0 {
+		apierror.Respond(c, apiErr)
 		return
 	}
 
-	// 保存更新后的广告
-	if err := tx.Save(&ad).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "更新广告失败"})
-		return
-	}
+	// 在事务中锁定并重新读取广告后再修改，避免整行保存覆盖并发的上线、到期切换
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Ads().Lock(ctx, id); err != nil {
+			return err
+		}
+		ad, err := tx.Ads().Get(ctx, id)
+		if err != nil {
+			return notFound(err, apierror.CodeAdNotFound)
+		}
 
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "提交事务失败"})
+		categoryChanged := applyAdUpdate(ad, input)
+		if input.GoLiveAt != nil {
+			ad.GoLiveAt = goLiveAt
+		}
+		if input.ExpiresAt != nil {
+			ad.ExpiresAt = expiresAt
+		}
+		if apiErr := applyLifecycle(ad, input.GoLiveAt != nil, input.ExpiresAt != nil, time.Now()); apiErr != nil {
+			return apiErr
+		}
+
+		// 修改类别时检查已投放大厦的排他规则
+		if categoryChanged {
+			placements, err := tx.Placements().ListByAdvertisement(ctx, ad.ID)
+			if err != nil {
+				return err
+			}
+			if err := checkExclusivity(ctx, tx, buildingIDsOfPlacements(placements), []models.Advertisement{*ad}); err != nil {
+				return err
+			}
+		}
+		if err := tx.Ads().Save(ctx, ad); err != nil {
+			return err
+		}
+		return webhooks.Emit(ctx, tx, webhooks.AdEvent(models.EventAdUpdated, *ad))
+	})
+	if err != nil {
+		apierror.Respond(c, err)
 		return
 	}
 
 	// 预加载关联数据返回
-	if err := config.DB.Preload("AdvertisementBuildings.Building").First(&ad, id).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取广告失败"})
+	ad, err := ctl.store.Ads().GetWithPlacements(ctx, id)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
@@ -212,33 +213,35 @@ func UpdateAd(c *gin.Context) {
 }
 
 // DeleteAd 删除广告
-func DeleteAd(c *gin.Context) {
-	id := c.Param("id")
-
-	// 开始事务
-	tx := config.DB.Begin()
-	if tx.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "启动事务失败"})
+func (ctl *AdController) DeleteAd(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
 		return
 	}
 
-	// 删除关联的 AdvertisementBuilding 记录
-	if err := tx.Where("advertisement_id = ?", id).Delete(&models.AdvertisementBuilding{}).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "删除关联广告与建筑失败"})
-		return
-	}
-
-	// 删除广告记录
-	if err := tx.Unscoped().Delete(&models.Advertisement{}, "id = ?", id).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "删除广告失败"})
-		return
-	}
+	ctx := c.Request.Context()
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		ad, err := tx.Ads().Get(ctx, id)
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil // 保持原有行为：删除不存在的广告视为成功
+		}
+		if err != nil {
+			return err
+		}
+		placements, err := tx.Placements().ListByAdvertisement(ctx, id)
+		if err != nil {
+			return err
+		}
 
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "提交事务失败"})
+		// 删除广告记录及其关联的 AdvertisementBuilding 记录
+		if err := tx.Ads().Delete(ctx, id); err != nil {
+			return err
+		}
+		events := append(webhooks.PlacementEvents(models.EventPlacementDeleted, placements), webhooks.AdEvent(models.EventAdDeleted, *ad))
+		return webhooks.Emit(ctx, tx, events...)
+	})
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
@@ -246,65 +249,59 @@ func DeleteAd(c *gin.Context) {
 }
 
 // AddAdsToBuilding 通过 Building ID 添加多个 Advertisement 关联
-func AddAdsToBuilding(c *gin.Context) {
-	buildingID := c.Param("id")
+func (ctl *AdController) AddAdsToBuilding(c *gin.Context) {
+	buildingID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
 	var input struct {
 		AdvertisementIDs []uint `json:"advertisement_ids" binding:"required"`
 	}
 
 	// 绑定 JSON 数据到 input 结构体
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	// 开始事务
-	tx := config.DB.Begin()
-	if tx.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "启动事务失败"})
+		apierror.Respond(c, apierror.FromBinding(err))
 		return
 	}
 
-	// 查找建筑
-	var building models.Building
-	if err := tx.First(&building, buildingID).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusNotFound, gin.H{"error": "建筑未找到"})
-		return
-	}
+	err := ctl.store.Transaction(c.Request.Context(), func(tx repository.Store) error {
+		ctx := c.Request.Context()
 
-	// 查找广告
-	var ads []models.Advertisement
-	if err := tx.Where("id IN ?", input.AdvertisementIDs).Find(&ads).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询广告失败"})
-		return
-	}
+		// 查找建筑
+		building, err := tx.Buildings().Get(ctx, buildingID)
+		if err != nil {
+			return notFound(err, apierror.CodeBuildingNotFound)
+		}
 
-	// 验证所有广告 ID 是否存在
-	if len(ads) != len(input.AdvertisementIDs) {
-		tx.Rollback()
-		c.JSON(http.StatusBadRequest, gin.H{"error": "某些广告 ID 不存在"})
-		return
-	}
+		// 查找广告
+		ads, err := tx.Ads().FindByIDs(ctx, input.AdvertisementIDs)
+		if err != nil {
+			return err
+		}
 
-	// 创建新的关联记录
-	for _, ad := range ads {
-		association := models.AdvertisementBuilding{
-			AdvertisementID: ad.ID,
-			BuildingID:      building.ID,
-			PlayDuration:    ad.VideoDuration, // 默认为 VideoDuration
+		// 验证所有广告 ID 是否存在
+		if len(ads) != len(uniqueIDs(input.AdvertisementIDs)) {
+			return apierror.BadRequest(apierror.CodeUnknownAdIDs).WithMeta("missing_ids", missingIDs(input.AdvertisementIDs, adIDsOf(ads)))
 		}
-		if err := tx.Create(&association).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "关联广告与建筑失败"})
-			return
+
+		// 检查大厦的排他规则
+		if err := checkExclusivity(ctx, tx, []uint{building.ID}, ads); err != nil {
+			return err
 		}
-	}
 
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "提交事务失败"})
+		// 创建新的关联记录
+		events := make([]webhooks.Event, 0, len(ads))
+		for _, ad := range ads {
+			placement, err := createPlacement(ctx, tx, ad, building.ID)
+			if err != nil {
+				return err
+			}
+			events = append(events, webhooks.PlacementEvent(models.EventPlacementCreated, *placement))
+		}
+		return webhooks.Emit(ctx, tx, events...)
+	})
+	if err != nil {
+		apierror.Respond(c, err)
 		return
 	}
 
@@ -312,43 +309,44 @@ func AddAdsToBuilding(c *gin.Context) {
 }
 
 // RemoveAdsFromBuilding 通过 Building ID 删除多个 Advertisement 关联
-func RemoveAdsFromBuilding(c *gin.Context) {
-	buildingID := c.Param("id")
+func (ctl *AdController) RemoveAdsFromBuilding(c *gin.Context) {
+	buildingID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
 	var input struct {
 		AdvertisementIDs []uint `json:"advertisement_ids" binding:"required"`
 	}
 
 	// 绑定 JSON 数据到 input 结构体
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Respond(c, apierror.FromBinding(err))
 		return
 	}
 
-	// 开始事务
-	tx := config.DB.Begin()
-	if tx.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "启动事务失败"})
-		return
-	}
+	err := ctl.store.Transaction(c.Request.Context(), func(tx repository.Store) error {
+		ctx := c.Request.Context()
 
-	// 查找建筑
-	var building models.Building
-	if err := tx.First(&building, buildingID).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusNotFound, gin.H{"error": "建筑未找到"})
-		return
-	}
+		// 查找建筑
+		building, err := tx.Buildings().Get(ctx, buildingID)
+		if err != nil {
+			return notFound(err, apierror.CodeBuildingNotFound)
+		}
 
-	// 删除指定的关联记录
-	if err := tx.Where("building_id = ? AND advertisement_id IN ?", building.ID, input.AdvertisementIDs).Delete(&models.AdvertisementBuilding{}).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "删除关联失败"})
-		return
-	}
+		placements, err := tx.Placements().ListByBuilding(ctx, building.ID)
+		if err != nil {
+			return err
+		}
+		removed := filterPlacements(placements, input.AdvertisementIDs, func(p models.AdvertisementBuilding) uint { return p.AdvertisementID })
 
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "提交事务失败"})
+		// 删除指定的关联记录
+		if err := tx.Placements().DeleteFromBuilding(ctx, building.ID, input.AdvertisementIDs); err != nil {
+			return err
+		}
+		return webhooks.Emit(ctx, tx, webhooks.PlacementEvents(models.EventPlacementDeleted, removed)...)
+	})
+	if err != nil {
+		apierror.Respond(c, err)
 		return
 	}
 
@@ -356,65 +354,59 @@ func RemoveAdsFromBuilding(c *gin.Context) {
 }
 
 // AddBuildingsToAd 通过 Advertisement ID 添加多个 Building 关联
-func AddBuildingsToAd(c *gin.Context) {
-	adID := c.Param("id")
+func (ctl *AdController) AddBuildingsToAd(c *gin.Context) {
+	adID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
 	var input struct {
 		BuildingIDs []uint `json:"building_ids" binding:"required"`
 	}
 
 	// 绑定 JSON 数据到 input 结构体
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Respond(c, apierror.FromBinding(err))
 		return
 	}
 
-	// 开始事务
-	tx := config.DB.Begin()
-	if tx.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "启动事务失败"})
-		return
-	}
+	err := ctl.store.Transaction(c.Request.Context(), func(tx repository.Store) error {
+		ctx := c.Request.Context()
 
-	// 查找广告
-	var ad models.Advertisement
-	if err := tx.First(&ad, adID).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusNotFound, gin.H{"error": "广告未找到"})
-		return
-	}
-
-	// 查找建筑
-	var buildings []models.Building
-	if err := tx.Where("id IN ?", input.BuildingIDs).Find(&buildings).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询建筑失败"})
-		return
-	}
+		// 查找广告
+		ad, err := tx.Ads().Get(ctx, adID)
+		if err != nil {
+			return notFound(err, apierror.CodeAdNotFound)
+		}
 
-	// 验证所有建筑 ID 是否存在
-	if len(buildings) != len(input.BuildingIDs) {
-		tx.Rollback()
-		c.JSON(http.StatusBadRequest, gin.H{"error": "某些建筑 ID 不存在"})
-		return
-	}
+		// 查找建筑
+		buildings, err := tx.Buildings().FindByIDs(ctx, input.BuildingIDs)
+		if err != nil {
+			return err
+		}
 
-	// 创建新的关联记录
-	for _, building := range buildings {
-		association := models.AdvertisementBuilding{
-			AdvertisementID: ad.ID,
-			BuildingID:      building.ID,
-			PlayDuration:    ad.VideoDuration, // 默认为 VideoDuration
+		// 验证所有建筑 ID 是否存在
+		if len(buildings) != len(uniqueIDs(input.BuildingIDs)) {
+			return apierror.BadRequest(apierror.CodeUnknownBuildingIDs).WithMeta("missing_ids", missingIDs(input.BuildingIDs, buildingIDsOf(buildings)))
 		}
-		if err := tx.Create(&association).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "关联广告与建筑失败"})
-			return
+
+		// 检查各大厦的排他规则
+		if err := checkExclusivity(ctx, tx, buildingIDsOf(buildings), []models.Advertisement{*ad}); err != nil {
+			return err
 		}
-	}
 
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "提交事务失败"})
+		// 创建新的关联记录
+		events := make([]webhooks.Event, 0, len(buildings))
+		for _, building := range buildings {
+			placement, err := createPlacement(ctx, tx, *ad, building.ID)
+			if err != nil {
+				return err
+			}
+			events = append(events, webhooks.PlacementEvent(models.EventPlacementCreated, *placement))
+		}
+		return webhooks.Emit(ctx, tx, events...)
+	})
+	if err != nil {
+		apierror.Respond(c, err)
 		return
 	}
 
@@ -422,43 +414,44 @@ func AddBuildingsToAd(c *gin.Context) {
 }
 
 // RemoveBuildingsFromAd 通过 Advertisement ID 删除多个 Building 关联
-func RemoveBuildingsFromAd(c *gin.Context) {
-	adID := c.Param("id")
+func (ctl *AdController) RemoveBuildingsFromAd(c *gin.Context) {
+	adID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
 	var input struct {
 		BuildingIDs []uint `json:"building_ids" binding:"required"`
 	}
 
 	// 绑定 JSON 数据到 input 结构体
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Respond(c, apierror.FromBinding(err))
 		return
 	}
 
-	// 开始事务
-	tx := config.DB.Begin()
-	if tx.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "启动事务失败"})
-		return
-	}
+	err := ctl.store.Transaction(c.Request.Context(), func(tx repository.Store) error {
+		ctx := c.Request.Context()
 
-	// 查找广告
-	var ad models.Advertisement
-	if err := tx.First(&ad, adID).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusNotFound, gin.H{"error": "广告未找到"})
-		return
-	}
+		// 查找广告
+		ad, err := tx.Ads().Get(ctx, adID)
+		if err != nil {
+			return notFound(err, apierror.CodeAdNotFound)
+		}
 
-	// 删除指定的关联记录
-	if err := tx.Where("advertisement_id = ? AND building_id IN ?", ad.ID, input.BuildingIDs).Delete(&models.AdvertisementBuilding{}).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "删除关联失败"})
-		return
-	}
+		placements, err := tx.Placements().ListByAdvertisement(ctx, ad.ID)
+		if err != nil {
+			return err
+		}
+		removed := filterPlacements(placements, input.BuildingIDs, func(p models.AdvertisementBuilding) uint { return p.BuildingID })
 
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "提交事务失败"})
+		// 删除指定的关联记录
+		if err := tx.Placements().DeleteFromAdvertisement(ctx, ad.ID, input.BuildingIDs); err != nil {
+			return err
+		}
+		return webhooks.Emit(ctx, tx, webhooks.PlacementEvents(models.EventPlacementDeleted, removed)...)
+	})
+	if err != nil {
+		apierror.Respond(c, err)
 		return
 	}
 
@@ -466,13 +459,17 @@ func RemoveBuildingsFromAd(c *gin.Context) {
 }
 
 // GetAdvertisementsByBuilding 获取指定 Building ID 关联的所有 Advertisement 对象
-func GetAdvertisementsByBuilding(c *gin.Context) {
-	buildingID := c.Param("id")
-	var associations []models.AdvertisementBuilding
+func (ctl *AdController) GetAdvertisementsByBuilding(c *gin.Context) {
+	buildingID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
 
 	// 查询关联记录
-	if err := config.DB.Where("building_id = ?", buildingID).Find(&associations).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询关联失败"})
+	associations, err := ctl.store.Placements().ListByBuilding(ctx, buildingID)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
@@ -483,9 +480,9 @@ func GetAdvertisementsByBuilding(c *gin.Context) {
 	}
 
 	// 查询 Advertisement 对象
-	var advertisements []models.Advertisement
-	if err := config.DB.Where("id IN ?", adIDs).Find(&advertisements).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询广告失败"})
+	advertisements, err := ctl.store.Ads().FindByIDs(ctx, adIDs)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
@@ -495,13 +492,17 @@ func GetAdvertisementsByBuilding(c *gin.Context) {
 }
 
 // GetBuildingsByAdvertisement 获取指定 Advertisement ID 关联的所有 Building 对象
-func GetBuildingsByAdvertisement(c *gin.Context) {
-	adID := c.Param("id")
-	var associations []models.AdvertisementBuilding
+func (ctl *AdController) GetBuildingsByAdvertisement(c *gin.Context) {
+	adID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
 
 	// 查询关联记录
-	if err := config.DB.Where("advertisement_id = ?", adID).Find(&associations).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询关联失败"})
+	associations, err := ctl.store.Placements().ListByAdvertisement(ctx, adID)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
@@ -512,9 +513,9 @@ func GetBuildingsByAdvertisement(c *gin.Context) {
 	}
 
 	// 查询 Building 对象
-	var buildings []models.Building
-	if err := config.DB.Where("id IN ?", buildingIDs).Find(&buildings).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询建筑失败"})
+	buildings, err := ctl.store.Buildings().FindByIDs(ctx, buildingIDs)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
@@ -524,28 +525,200 @@ func GetBuildingsByAdvertisement(c *gin.Context) {
 }
 
 // UpdatePlayDuration 更新广告与建筑之间的播放时长
-func UpdatePlayDuration(c *gin.Context) {
+func (ctl *AdController) UpdatePlayDuration(c *gin.Context) {
 	var input UpdatePlayDurationInput
 
 	// 绑定 JSON 数据到 input 结构体
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Respond(c, apierror.FromBinding(err))
 		return
 	}
+	ctx := c.Request.Context()
 
 	// 查找关联记录
-	var association models.AdvertisementBuilding
-	if err := config.DB.Where("advertisement_id = ? AND building_id = ?", input.AdvertisementID, input.BuildingID).First(&association).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "关联记录未找到"})
+	association, err := ctl.store.Placements().Get(ctx, input.AdvertisementID, input.BuildingID)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodePlacementNotFound))
 		return
 	}
 
 	// 更新 PlayDuration
 	association.PlayDuration = input.PlayDuration
-	if err := config.DB.Save(&association).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "更新播放时长失败"})
+	err = ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Placements().Save(ctx, association); err != nil {
+			return err
+		}
+		return webhooks.Emit(ctx, tx, webhooks.PlacementEvent(models.EventPlacementUpdated, *association))
+	})
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
 	c.JSON(http.StatusOK, association)
 }
+
+// createPlacement 创建广告与大厦的关联，播放时长默认为广告的 VideoDuration
+func createPlacement(ctx context.Context, tx repository.Store, ad models.Advertisement, buildingID uint) (*models.AdvertisementBuilding, error) {
+	association := models.AdvertisementBuilding{
+		AdvertisementID: ad.ID,
+		BuildingID:      buildingID,
+		PlayDuration:    ad.VideoDuration, // 默认为 VideoDuration
+	}
+	if err := tx.Placements().Create(ctx, &association); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return nil, apierror.Conflict(apierror.CodePlacementExists).
+				WithMeta("advertisement_id", ad.ID).
+				WithMeta("building_id", buildingID)
+		}
+		return nil, err
+	}
+	return &association, nil
+}
+
+// filterPlacements 返回 key 在 ids 中的投放记录，用于删除前生成事件
+func filterPlacements(placements []models.AdvertisementBuilding, ids []uint, key func(models.AdvertisementBuilding) uint) []models.AdvertisementBuilding {
+	wanted := make(map[uint]struct{}, len(ids))
+	for _, id := range ids {
+		wanted[id] = struct{}{}
+	}
+	var result []models.AdvertisementBuilding
+	for _, placement := range placements {
+		if _, ok := wanted[key(placement)]; ok {
+			result = append(result, placement)
+		}
+	}
+	return result
+}
+
+// applyLifecycle 校验修改后的上线与到期时间并重置对应的切换记录。
+// 到期时间必须是将来的时间且晚于上线时间；上线时间晚于 now 时广告在上线前保持 inactive，
+// 不晚于 now 时视为已经上线，状态以请求为准
+func applyLifecycle(ad *models.Advertisement, goLiveChanged, expiresChanged bool, now time.Time) *apierror.Error {
+	apiErr := apierror.BadRequest(apierror.CodeValidationFailed)
+	if expiresChanged && ad.ExpiresAt != nil && !ad.ExpiresAt.After(now) {
+		apiErr.WithDetails(apierror.Field("expires_at", "future", nil))
+	}
+	if ad.GoLiveAt != nil && ad.ExpiresAt != nil && !ad.ExpiresAt.After(*ad.GoLiveAt) {
+		apiErr.WithDetails(apierror.Field("expires_at", "after", map[string]any{"param": "go_live_at"}))
+	}
+	if len(apiErr.Details) > 0 {
+		return apiErr
+	}
+
+	if expiresChanged {
+		ad.ExpiresAt = utcTime(ad.ExpiresAt)
+		ad.ExpiredAt = nil
+	}
+	if goLiveChanged {
+		ad.GoLiveAt = utcTime(ad.GoLiveAt)
+		ad.ActivatedAt = nil
+		switch {
+		case ad.GoLiveAt == nil:
+		case ad.GoLiveAt.After(now):
+			ad.Status = "inactive"
+		default:
+			activated := now.UTC()
+			ad.ActivatedAt = &activated
+		}
+	}
+	return nil
+}
+
+// optionalTime 解析可清除的 RFC 3339 时间，空字符串返回 nil，格式错误时记录到 apiErr
+func optionalTime(apiErr *apierror.Error, field, value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		apiErr.WithDetails(apierror.Field(field, "type", map[string]any{"param": "RFC 3339 time"}))
+		return nil
+	}
+	return &parsed
+}
+
+// applyAdUpdate 把 input 中提供的字段写入 ad（上线与到期时间除外），返回类别是否改变
+func applyAdUpdate(ad *models.Advertisement, input UpdateAdInput) bool {
+	if input.Title != "" {
+		ad.Title = input.Title
+	}
+	if input.Description != "" {
+		ad.Description = input.Description
+	}
+	// 更换素材地址时清除旧素材的大小与哈希，除非同时提供了新的
+	if input.ImageURL != "" && input.ImageURL != ad.ImageURL {
+		ad.ImageURL = input.ImageURL
+		ad.ImageSize, ad.ImageSHA256 = 0, ""
+	}
+	if input.ImageSize != 0 {
+		ad.ImageSize = input.ImageSize
+	}
+	if input.ImageSHA256 != "" {
+		ad.ImageSHA256 = strings.ToLower(input.ImageSHA256)
+	}
+	if input.VideoURL != "" && input.VideoURL != ad.VideoURL {
+		ad.VideoURL = input.VideoURL
+		ad.VideoSize, ad.VideoSHA256 = 0, ""
+	}
+	if input.VideoSize != 0 {
+		ad.VideoSize = input.VideoSize
+	}
+	if input.VideoSHA256 != "" {
+		ad.VideoSHA256 = strings.ToLower(input.VideoSHA256)
+	}
+	if input.Status != "" {
+		ad.Status = input.Status
+	}
+	if input.VideoDuration != 0 {
+		ad.VideoDuration = input.VideoDuration
+	}
+	categoryChanged := false
+	if input.Category != nil {
+		category := exclusivity.NormalizeCategory(*input.Category)
+		categoryChanged = category != ad.Category
+		ad.Category = category
+	}
+	return categoryChanged
+}
+
+// utcTime 返回转换为 UTC 的时间，nil 保持不变
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+// uniqueIDs 去除重复的 ID
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]struct{}, len(ids))
+	result := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
+
+// adIDsOf 返回广告的 ID 列表
+func adIDsOf(ads []models.Advertisement) []uint {
+	ids := make([]uint, len(ads))
+	for i, ad := range ads {
+		ids[i] = ad.ID
+	}
+	return ids
+}
+
+// buildingIDsOf 返回大厦的 ID 列表
+func buildingIDsOf(buildings []models.Building) []uint {
+	ids := make([]uint, len(buildings))
+	for i, building := range buildings {
+		ids[i] = building.ID
+	}
+	return ids
+}
@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/10240418/advertisement-management-system/backend/repository/memory"
+	"github.com/gin-gonic/gin"
+)
+
+func TestDeleteAdCascades(t *testing.T) {
+	api := newTestAPI(t)
+	deleted := api.createAd("新品发布", 15)
+	kept := api.createAd("周年庆", 30)
+	building := api.createBuilding("国贸大厦", deleted.ID, kept.ID)
+
+	api.expect(api.do(http.MethodDelete, fmt.Sprintf("/api/ads/%d", deleted.ID), nil), http.StatusOK, nil)
+	api.expectError(api.do(http.MethodGet, fmt.Sprintf("/api/ads/%d", deleted.ID), nil), http.StatusNotFound, "ad_not_found")
+
+	var got models.Building
+	api.expect(api.do(http.MethodGet, fmt.Sprintf("/api/buildings/%d", building.ID), nil), http.StatusOK, &got)
+	if keys, want := placementKeys(got.AdvertisementBuildings), [][2]uint{{kept.ID, building.ID}}; !reflect.DeepEqual(keys, want) {
+		t.Fatalf("大厦的投放记录 %v，期望 %v", keys, want)
+	}
+
+	// 删除不存在的广告视为成功
+	api.expect(api.do(http.MethodDelete, fmt.Sprintf("/api/ads/%d", deleted.ID), nil), http.StatusOK, nil)
+}
+
+func TestAddBuildingsToAdDuplicateRollsBack(t *testing.T) {
+	api := newTestAPI(t)
+	ad := api.createAd("新品发布", 15)
+	placed := api.createBuilding("国贸大厦", ad.ID)
+	other := api.createBuilding("环球中心")
+
+	meta := api.expectError(api.do(http.MethodPost, fmt.Sprintf("/api/ads/%d/buildings", ad.ID), map[string]any{
+		"building_ids": []uint{other.ID, placed.ID},
+	}), http.StatusConflict, "placement_exists")
+	if meta["advertisement_id"] != float64(ad.ID) || meta["building_id"] != float64(placed.ID) {
+		t.Fatalf("meta = %v，期望广告 %d 与大厦 %d", meta, ad.ID, placed.ID)
+	}
+
+	// 事务回滚，other 的投放记录没有被创建
+	var got models.Advertisement
+	api.expect(api.do(http.MethodGet, fmt.Sprintf("/api/ads/%d", ad.ID), nil), http.StatusOK, &got)
+	if keys, want := placementKeys(got.AdvertisementBuildings), [][2]uint{{ad.ID, placed.ID}}; !reflect.DeepEqual(keys, want) {
+		t.Fatalf("广告的投放记录 %v，期望 %v", keys, want)
+	}
+
+	api.expectError(api.do(http.MethodPost, fmt.Sprintf("/api/buildings/%d/ads", placed.ID), map[string]any{
+		"advertisement_ids": []uint{ad.ID},
+	}), http.StatusConflict, "placement_exists")
+}
+
+func TestAddBuildingsToAdValidation(t *testing.T) {
+	api := newTestAPI(t)
+	ad := api.createAd("新品发布", 15)
+	building := api.createBuilding("国贸大厦")
+
+	tests := []struct {
+		name   string
+		path   string
+		body   map[string]any
+		status int
+		code   string
+	}{
+		{"未知大厦", fmt.Sprintf("/api/ads/%d/buildings", ad.ID), map[string]any{"building_ids": []uint{building.ID, 42}}, http.StatusBadRequest, "unknown_building_ids"},
+		{"未知广告", fmt.Sprintf("/api/buildings/%d/ads", building.ID), map[string]any{"advertisement_ids": []uint{42}}, http.StatusBadRequest, "unknown_ad_ids"},
+		{"广告不存在", "/api/ads/42/buildings", map[string]any{"building_ids": []uint{building.ID}}, http.StatusNotFound, "ad_not_found"},
+		{"大厦不存在", "/api/buildings/42/ads", map[string]any{"advertisement_ids": []uint{ad.ID}}, http.StatusNotFound, "building_not_found"},
+		{"无效 ID", "/api/ads/abc/buildings", map[string]any{"building_ids": []uint{building.ID}}, http.StatusBadRequest, "invalid_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api.t = t
+			api.expectError(api.do(http.MethodPost, tt.path, tt.body), tt.status, tt.code)
+		})
+	}
+}
+
+func TestPlacementOrdering(t *testing.T) {
+	api := newTestAPI(t)
+	ads := []models.Advertisement{api.createAd("甲", 10), api.createAd("乙", 20), api.createAd("丙", 30)}
+	buildings := []models.Building{api.createBuilding("一号楼"), api.createBuilding("二号楼"), api.createBuilding("三号楼")}
+
+	// 请求中的 ID 顺序不影响返回顺序，列表始终按 ID 升序
+	api.expect(api.do(http.MethodPost, fmt.Sprintf("/api/ads/%d/buildings", ads[0].ID), map[string]any{
+		"building_ids": []uint{buildings[2].ID, buildings[0].ID, buildings[1].ID},
+	}), http.StatusOK, nil)
+	api.expect(api.do(http.MethodPost, fmt.Sprintf("/api/buildings/%d/ads", buildings[0].ID), map[string]any{
+		"advertisement_ids": []uint{ads[2].ID, ads[1].ID},
+	}), http.StatusOK, nil)
+
+	var ad models.Advertisement
+	api.expect(api.do(http.MethodGet, fmt.Sprintf("/api/ads/%d", ads[0].ID), nil), http.StatusOK, &ad)
+	if got, want := placementKeys(ad.AdvertisementBuildings), [][2]uint{{ads[0].ID, buildings[0].ID}, {ads[0].ID, buildings[1].ID}, {ads[0].ID, buildings[2].ID}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("广告的投放记录 %v，期望 %v", got, want)
+	}
+
+	var building models.Building
+	api.expect(api.do(http.MethodGet, fmt.Sprintf("/api/buildings/%d", buildings[0].ID), nil), http.StatusOK, &building)
+	if got, want := placementKeys(building.AdvertisementBuildings), [][2]uint{{ads[0].ID, buildings[0].ID}, {ads[1].ID, buildings[0].ID}, {ads[2].ID, buildings[0].ID}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("大厦的投放记录 %v，期望 %v", got, want)
+	}
+
+	var byAd struct {
+		Buildings []models.Building `json:"buildings"`
+	}
+	api.expect(api.do(http.MethodGet, fmt.Sprintf("/api/ads/%d/buildings", ads[0].ID), nil), http.StatusOK, &byAd)
+	if got, want := buildingIDsOf(byAd.Buildings), buildingIDsOf(buildings); !reflect.DeepEqual(got, want) {
+		t.Errorf("广告关联的大厦 %v，期望 %v", got, want)
+	}
+
+	var byBuilding struct {
+		Advertisements []models.Advertisement `json:"advertisements"`
+	}
+	api.expect(api.do(http.MethodGet, fmt.Sprintf("/api/buildings/%d/ads", buildings[0].ID), nil), http.StatusOK, &byBuilding)
+	if got, want := adIDsOf(byBuilding.Advertisements), adIDsOf(ads); !reflect.DeepEqual(got, want) {
+		t.Errorf("大厦关联的广告 %v，期望 %v", got, want)
+	}
+}
+
+func TestRemoveBuildingsFromAd(t *testing.T) {
+	api := newTestAPI(t)
+	ad := api.createAd("新品发布", 15)
+	first := api.createBuilding("国贸大厦", ad.ID)
+	second := api.createBuilding("环球中心", ad.ID)
+
+	// 未关联的大厦 ID 被忽略
+	api.expect(api.do(http.MethodDelete, fmt.Sprintf("/api/ads/%d/buildings", ad.ID), map[string]any{
+		"building_ids": []uint{first.ID, 42},
+	}), http.StatusOK, nil)
+
+	var got models.Advertisement
+	api.expect(api.do(http.MethodGet, fmt.Sprintf("/api/ads/%d", ad.ID), nil), http.StatusOK, &got)
+	if keys, want := placementKeys(got.AdvertisementBuildings), [][2]uint{{ad.ID, second.ID}}; !reflect.DeepEqual(keys, want) {
+		t.Fatalf("广告的投放记录 %v，期望 %v", keys, want)
+	}
+	if got.AdvertisementBuildings[0].PlayDuration != ad.VideoDuration {
+		t.Fatalf("播放时长 %d，期望默认为视频时长 %d", got.AdvertisementBuildings[0].PlayDuration, ad.VideoDuration)
+	}
+}
+
+// transitionBeforeTx 在下一个事务开始前执行一次广告状态切换，模拟请求处理期间到期任务先提交
+type transitionBeforeTx struct {
+	*memory.Store
+	adID  uint
+	event string
+	at    time.Time
+	done  bool
+}
+
+func (s *transitionBeforeTx) Transaction(ctx context.Context, fn func(tx repository.Store) error) error {
+	if !s.done {
+		s.done = true
+		if _, err := s.Store.Ads().Transition(ctx, s.adID, s.event, s.at); err != nil {
+			return err
+		}
+	}
+	return s.Store.Transaction(ctx, fn)
+}
+
+// TestUpdateAdKeepsConcurrentTransition 修改广告时保留请求期间发生的到期切换，不用旧数据整行覆盖
+func TestUpdateAdKeepsConcurrentTransition(t *testing.T) {
+	api := newTestAPI(t)
+	ad := api.createAd("限时优惠", 15)
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	api.expect(api.do(http.MethodPut, fmt.Sprintf("/api/ads/%d", ad.ID), map[string]any{"expires_at": expiresAt.Format(time.RFC3339)}), http.StatusOK, nil)
+
+	store := &transitionBeforeTx{Store: api.store, adID: ad.ID, event: models.EventAdExpired, at: expiresAt.Add(time.Minute)}
+	r := gin.New()
+	r.PUT("/api/ads/:id", NewAdController(store).UpdateAd)
+	api.router = r
+
+	var got models.Advertisement
+	api.expect(api.do(http.MethodPut, fmt.Sprintf("/api/ads/%d", ad.ID), map[string]any{"title": "限时优惠（已延期）"}), http.StatusOK, &got)
+	if !store.done {
+		t.Fatal("状态切换未执行")
+	}
+	if got.Title != "限时优惠（已延期）" {
+		t.Fatalf("标题 %q 未更新", got.Title)
+	}
+	if got.Status != "inactive" || got.ExpiredAt == nil {
+		t.Fatalf("到期切换被覆盖：status %q，expired_at %v", got.Status, got.ExpiredAt)
+	}
+}
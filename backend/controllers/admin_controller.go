@@ -1,26 +1,63 @@
 package controllers
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"net/http"
-	"strconv"
 	"strings"
+	"time"
 
+	"github.com/10240418/advertisement-management-system/backend/apierror"
 	"github.com/10240418/advertisement-management-system/backend/config"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/middleware"
 	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/ratelimit"
+	"github.com/10240418/advertisement-management-system/backend/repository"
 	"github.com/gin-gonic/gin"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// AdminController 处理管理员注册、登录与管理相关的请求
+type AdminController struct {
+	store   repository.Store
+	limiter ratelimit.Store
+	auth    config.AuthConfig
+	now     func() time.Time
+}
+
+// NewAdminController 创建 AdminController，limiter 用于按用户名限制登录频率
+func NewAdminController(store repository.Store, limiter ratelimit.Store, auth config.AuthConfig) *AdminController {
+	return &AdminController{store: store, limiter: limiter, auth: auth, now: time.Now}
+}
+
+// adminsLockKey 注册与删除管理员时使用的 advisory lock 键，
+// 保证"第一个管理员成为超级管理员"与"至少保留一个超级管理员"在并发请求下成立
+const adminsLockKey = 7284217
+
 // RegisterAdmin 注册新的管理员
-func RegisterAdmin(c *gin.Context) {
+// 尚无管理员时任何人都可以注册并成为超级管理员，之后只有超级管理员可以注册新的管理员
+func (ctl *AdminController) RegisterAdmin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 先检查一次权限，避免未授权的请求校验密码与计算哈希；加锁后在事务中再次确认
+	count, err := ctl.store.Admins().Count(ctx)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	if apiErr := authorizeRegistration(c, count); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
 	var input struct {
 		Username string `json:"username"`
 		Password string `json:"password"`
 	}
 
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Respond(c, apierror.FromBinding(err))
 		return
 	}
 
@@ -30,42 +67,88 @@ func RegisterAdmin(c *gin.Context) {
 
 	// 检查用户名和密码为空
 	if input.Username == "" || input.Password == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "用户名和密码不能为空"})
+		apierror.Respond(c, requireNonBlank("username", input.Username, "password", input.Password))
+		return
+	}
+
+	// 检查密码强度
+	if apiErr := checkPassword(ctl.auth.Password, "password", input.Username, input.Password); apiErr != nil {
+		apierror.Respond(c, apiErr)
 		return
 	}
 
 	// 检查用户名是否已存在
-	var existingAdmin models.Administrator
-	if err := config.DB.Where("username = ?", input.Username).First(&existingAdmin).Error; err == nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "用户名已存在"})
+	if _, err := ctl.store.Admins().GetByUsername(ctx, input.Username); err == nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeUsernameTaken))
 		return
 	}
 
 	// 密码加密
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
 	if err != nil {
-		fmt.Printf("Password encryption failed: %v\n", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "密码加密失败"})
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
+	now := ctl.now()
 	admin := models.Administrator{
-		Username: input.Username,
-		Password: string(hashedPassword), // 确保这里存储的是生成的哈希值
+		Username:          input.Username,
+		Password:          string(hashedPassword), // 确保这里存储的是生成的哈希值
+		Role:              models.RoleAdmin,
+		PasswordChangedAt: &now,
 	}
 
-	// 保存管理员到数据库
-	if err := config.DB.Create(&admin).Error; err != nil {
-		fmt.Printf("Failed to create administrator: %v\n", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "注册管理员失败"})
+	// 加锁后重新统计，并发的首次注册中只有一个成为超级管理员，其余按已有管理员处理
+	err = ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Lock(ctx, adminsLockKey); err != nil {
+			return err
+		}
+		count, err := tx.Admins().Count(ctx)
+		if err != nil {
+			return err
+		}
+		if apiErr := authorizeRegistration(c, count); apiErr != nil {
+			return apiErr
+		}
+		if count == 0 {
+			// 第一个注册的管理员成为超级管理员
+			admin.Role = models.RoleSuperAdmin
+		}
+
+		// 保存管理员到数据库
+		if err := tx.Admins().Create(ctx, &admin); err != nil {
+			if errors.Is(err, repository.ErrDuplicate) {
+				return apierror.BadRequest(apierror.CodeUsernameTaken)
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		apierror.Respond(c, err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, gin.H{"message": "管理员注册成功"})
 }
 
+// authorizeRegistration 已有 count 个管理员时要求当前请求来自超级管理员
+func authorizeRegistration(c *gin.Context, count int64) *apierror.Error {
+	if count == 0 {
+		return nil
+	}
+	current := middleware.CurrentAdmin(c)
+	if current == nil && middleware.CurrentAPIKey(c) == nil {
+		return apierror.Unauthorized(apierror.CodeAuthRequired)
+	}
+	if current == nil || current.Role != models.RoleSuperAdmin {
+		return apierror.Forbidden(apierror.CodePermissionDenied)
+	}
+	return nil
+}
+
 // LoginAdmin 管理员登录
-func LoginAdmin(c *gin.Context) {
+func (ctl *AdminController) LoginAdmin(c *gin.Context) {
 	var input struct {
 		Username string `json:"username" binding:"required"`
 		Password string `json:"password" binding:"required"`
@@ -73,7 +156,7 @@ func LoginAdmin(c *gin.Context) {
 
 	// 绑定 JSON 数据到 input 结构体
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Respond(c, apierror.FromBinding(err))
 		return
 	}
 
@@ -83,77 +166,101 @@ func LoginAdmin(c *gin.Context) {
 
 	// 检查用户名和密码是否为空
 	if input.Username == "" || input.Password == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "用户名和密码不能为空"})
+		apierror.Respond(c, requireNonBlank("username", input.Username, "password", input.Password))
+		return
+	}
+
+	// 按用户名限流，防止分布在多个 IP 上的密码猜测
+	if !ratelimit.Enforce(c, ctl.limiter, "login-user:"+input.Username, ratelimit.PerMinute(ctl.auth.LoginPerUser)) {
 		return
 	}
 
+	ctx := c.Request.Context()
+
 	// 查询数据库中的管理员
-	var admin models.Administrator
-	if err := config.DB.Where("username = ?", input.Username).First(&admin).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "无效的凭证"})
+	admin, err := ctl.store.Admins().GetByUsername(ctx, input.Username)
+	if err != nil {
+		// 用户名不存在时同样计算一次 bcrypt，避免通过响应时间判断用户名是否存在
+		_ = bcrypt.CompareHashAndPassword([]byte(dummyPasswordHash), []byte(input.Password))
+		apierror.Respond(c, credentialsError(err))
+		return
+	}
+
+	// 锁定期间不再校验密码
+	now := ctl.now()
+	if ctl.auth.LockoutThreshold > 0 && admin.Locked(now) {
+		apierror.Respond(c, accountLocked(admin.LockedUntil.Sub(now)))
 		return
 	}
 
 	// 验证密码
 	if err := bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(input.Password)); err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "无效的凭证"})
+		apierror.Respond(c, ctl.loginFailure(ctx, admin, now, apierror.CodeInvalidCredentials))
 		return
 	}
 
-	// 生成JWT
-	token, err := config.GenerateToken(admin.Username)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "生成token失败"})
+	// 已启用两步验证时先返回挑战令牌，提交验证码后才签发访问令牌
+	if admin.TOTPEnabled {
+		challenge, err := config.GenerateChallengeToken(admin.Username)
+		if err != nil {
+			apierror.Respond(c, apierror.Internal(err))
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "请输入两步验证码", "mfa_required": true, "challenge_token": challenge})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "登录成功", "token": token})
+	ctl.completeLogin(c, admin, now)
 }
 
-// GetAdminUsers 获取所有管理员
-func GetAdminUsers(c *gin.Context) {
-	// 从查询参数中获取分页信息
-	pageNumStr := c.DefaultQuery("pageNum", "1")
-	pageSizeStr := c.DefaultQuery("pageSize", "10")
-	desc := c.DefaultQuery("desc", "true")
-
-	pageNum, err := strconv.Atoi(pageNumStr)
-	if err != nil || pageNum < 1 {
-		pageNum = 1
+// completeLogin 清除失败记录、记录登录时间并签发访问令牌
+func (ctl *AdminController) completeLogin(c *gin.Context, admin *models.Administrator, now time.Time) {
+	if err := ctl.store.Admins().RecordLoginSuccess(c.Request.Context(), admin.ID, now); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
 	}
 
-	pageSize, err := strconv.Atoi(pageSizeStr)
-	if err != nil || pageSize < 1 {
-		pageSize = 10
+	// 生成JWT
+	token, err := config.GenerateToken(admin.Username)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
 	}
 
-	// 计算分页的偏移量
-	offset := (pageNum - 1) * pageSize
-
-	var admins []models.Administrator
-	var count int64
-
-	// 构建基础查询，不包含 Limit 和 Offset
-	baseQuery := config.DB.Model(&models.Administrator{})
-
-	// 添加排序
-	if desc == "true" {
-		baseQuery = baseQuery.Order("username DESC")
-	} else {
-		baseQuery = baseQuery.Order("username ASC")
+	response := gin.H{"message": "登录成功", "token": token}
+	if ctl.auth.TOTP.RequireSuperAdmin && admin.Role == models.RoleSuperAdmin && !admin.TOTPEnabled {
+		// 该令牌只能用于绑定两步验证
+		response["totp_enrollment_required"] = true
 	}
+	c.JSON(http.StatusOK, response)
+}
 
-	// 获取总记录数（不包含 Limit 和 Offset）
-	if err := baseQuery.Count(&count).Error; err != nil {
-		fmt.Printf("Count Error: %v\n", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取总数失败"})
-		return
+// loginFailure 记录一次登录失败，达到阈值时锁定账号并返回 423，否则返回 401 code
+func (ctl *AdminController) loginFailure(ctx context.Context, admin *models.Administrator, now time.Time, code apierror.Code) error {
+	if ctl.auth.LockoutThreshold <= 0 {
+		return apierror.Unauthorized(code)
+	}
+	admin, err := ctl.store.Admins().RecordLoginFailure(ctx, admin.ID, ctl.auth.LockoutThreshold, now.Add(ctl.auth.LockoutDuration))
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if admin.Locked(now) {
+		logging.FromContext(ctx).WarnContext(ctx, "登录失败次数过多，锁定账号",
+			"username", admin.Username, "locked_until", admin.LockedUntil)
+		return accountLocked(admin.LockedUntil.Sub(now))
 	}
+	return apierror.Unauthorized(code)
+}
+
+// GetAdminUsers 获取所有管理员
+func (ctl *AdminController) GetAdminUsers(c *gin.Context) {
+	// 从查询参数中获取分页信息
+	pageNum, pageSize, opts := pagination(c)
 
 	// 执行查询并进行分页
-	if err := baseQuery.Offset(offset).Limit(pageSize).Find(&admins).Error; err != nil {
-		fmt.Printf("Find Error: %v\n", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取管理员失败"})
+	admins, count, err := ctl.store.Admins().List(c.Request.Context(), opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
@@ -166,65 +273,216 @@ func GetAdminUsers(c *gin.Context) {
 	})
 }
 
-// 修改密码
-func UpdateAdminPassword(c *gin.Context) {
+// UpdateAdminPassword 修改当前登录管理员的密码
+// 修改后此前签发的令牌全部失效，响应中返回新令牌
+func (ctl *AdminController) UpdateAdminPassword(c *gin.Context) {
 	var input struct {
-		Username    string `json:"username"`
-		OldPassword string `json:"old_password"`
-		NewPassword string `json:"new_password"`
+		Username    string `json:"username"` // 已废弃，只能修改当前登录管理员的密码
+		OldPassword string `json:"old_password" binding:"required"`
+		NewPassword string `json:"new_password" binding:"required"`
 	}
 
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Respond(c, apierror.FromBinding(err))
 		return
 	}
 
-	// 查询数据库中的管理员
-	var admin models.Administrator
-	if err := config.DB.Where("username = ?", input.Username).First(&admin).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "无效的凭证"})
+	admin := middleware.CurrentAdmin(c)
+	if input.Username != "" && input.Username != admin.Username {
+		apierror.Respond(c, apierror.Forbidden(apierror.CodePermissionDenied))
 		return
 	}
 
 	// 验证旧密码
-	if err := bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(input.OldPassword)); err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "旧密码不正确"})
+	input.NewPassword = strings.TrimSpace(input.NewPassword)
+	if err := bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(strings.TrimSpace(input.OldPassword))); err != nil {
+		apierror.Respond(c, apierror.Unauthorized(apierror.CodeWrongPassword))
 		return
 	}
 
-	// 加密新密码
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.NewPassword), bcrypt.DefaultCost)
+	// 检查新密码强度，且不能与当前密码相同
+	apiErr := checkPassword(ctl.auth.Password, "new_password", admin.Username, input.NewPassword)
+	if bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(input.NewPassword)) == nil {
+		if apiErr == nil {
+			apiErr = apierror.BadRequest(apierror.CodeValidationFailed)
+		}
+		apiErr.WithDetails(apierror.Field("new_password", "password_reused", nil))
+	}
+	if apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	if err := setPassword(c.Request.Context(), ctl.store.Admins(), admin.ID, input.NewPassword); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	// 旧令牌已失效，签发新令牌
+	token, err := config.GenerateToken(admin.Username)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "密码更新成功", "token": token})
+}
+
+// ResetAdminPassword 超级管理员重置其他管理员的密码，操作写入审计日志
+func (ctl *AdminController) ResetAdminPassword(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	var input struct {
+		NewPassword string `json:"new_password" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	input.NewPassword = strings.TrimSpace(input.NewPassword)
+
+	ctx := c.Request.Context()
+	target, err := ctl.store.Admins().GetByID(ctx, id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "新密码加密失败"})
+		apierror.Respond(c, notFound(err, apierror.CodeAdminNotFound))
 		return
 	}
 
-	// 更新密码
-	admin.Password = string(hashedPassword)
-	if err := config.DB.Save(&admin).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "更新密码失败"})
+	if apiErr := checkPassword(ctl.auth.Password, "new_password", target.Username, input.NewPassword); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	actor := middleware.CurrentAdmin(c)
+	err = ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := setPassword(ctx, tx.Admins(), target.ID, input.NewPassword); err != nil {
+			return err
+		}
+		return tx.AuditLogs().Create(ctx, &models.AdminAuditLog{
+			ActorID:        actor.ID,
+			ActorUsername:  actor.Username,
+			Action:         models.AuditPasswordReset,
+			TargetID:       target.ID,
+			TargetUsername: target.Username,
+			ClientIP:       c.ClientIP(),
+			RequestID:      logging.RequestID(ctx),
+		})
+	})
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "密码更新成功"})
+	logging.FromContext(ctx).InfoContext(ctx, "重置管理员密码", "actor", actor.Username, "target", target.Username)
+	c.JSON(http.StatusOK, gin.H{"message": "密码已重置"})
 }
 
-// DeleteAdmin 删除管理员（硬删除）
-func DeleteAdmin(c *gin.Context) {
+// GetAuditLogs 分页获取管理员审计日志，按时间倒序
+func (ctl *AdminController) GetAuditLogs(c *gin.Context) {
+	pageNum, pageSize, opts := pagination(c)
+
+	entries, count, err := ctl.store.AuditLogs().List(c.Request.Context(), opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":     entries,
+		"total":    count,
+		"pageNum":  pageNum,
+		"pageSize": pageSize,
+	})
+}
+
+// setPassword 加密并保存新密码，同时记录改密时间使旧令牌失效
+func setPassword(ctx context.Context, admins repository.AdminRepository, id uint, password string) error {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	return admins.UpdatePassword(ctx, id, string(hashedPassword), time.Now())
+}
+
+// DeleteAdmin 超级管理员删除其他管理员（硬删除），操作写入审计日志
+// 不能删除自己，也不能删除最后一个超级管理员
+func (ctl *AdminController) DeleteAdmin(c *gin.Context) {
 	var input struct {
 		ID uint `json:"id" binding:"required"`
 	}
 
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "ID 是必需的"})
+		apierror.Respond(c, apierror.FromBinding(err))
 		return
 	}
 
-	// 硬删除管理员记录
-	if err := config.DB.Unscoped().Delete(&models.Administrator{}, input.ID).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "删除管理员失败"})
+	actor := middleware.CurrentAdmin(c)
+	if input.ID == actor.ID {
+		apierror.Respond(c, apierror.Conflict(apierror.CodeAdminSelfDelete))
+		return
+	}
+
+	ctx := c.Request.Context()
+	var target *models.Administrator
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		// 与注册共用同一把锁，并发删除时超级管理员的数量不会降到 0
+		if err := tx.Lock(ctx, adminsLockKey); err != nil {
+			return err
+		}
+		var err error
+		target, err = tx.Admins().GetByID(ctx, input.ID)
+		if err != nil {
+			return notFound(err, apierror.CodeAdminNotFound)
+		}
+		if target.Role == models.RoleSuperAdmin {
+			count, err := tx.Admins().CountByRole(ctx, models.RoleSuperAdmin)
+			if err != nil {
+				return err
+			}
+			if count <= 1 {
+				return apierror.Conflict(apierror.CodeLastSuperAdmin)
+			}
+		}
+
+		// 硬删除管理员记录
+		if err := tx.Admins().Delete(ctx, target.ID); err != nil {
+			return err
+		}
+		return tx.AuditLogs().Create(ctx, &models.AdminAuditLog{
+			ActorID:        actor.ID,
+			ActorUsername:  actor.Username,
+			Action:         models.AuditAdminDeleted,
+			TargetID:       target.ID,
+			TargetUsername: target.Username,
+			ClientIP:       c.ClientIP(),
+			RequestID:      logging.RequestID(ctx),
+		})
+	})
+	if err != nil {
+		apierror.Respond(c, err)
 		return
 	}
 
+	logging.FromContext(ctx).InfoContext(ctx, "删除管理员", "actor", actor.Username, "target", target.Username)
 	c.JSON(http.StatusOK, gin.H{"message": "管理员删除成功"})
 }
+
+// dummyPasswordHash 用户名不存在时用于比对的 bcrypt 哈希，代价与 bcrypt.DefaultCost 一致
+const dummyPasswordHash = "$2a$10$7.DoWhdtstDy6BOJbmPs4.TCG3breuf.98jPO7gJKCxHw2aYZIlP6"
+
+// credentialsError 用户不存在时返回 invalid_credentials，不区分用户名或密码错误
+func credentialsError(err error) *apierror.Error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return apierror.Unauthorized(apierror.CodeInvalidCredentials)
+	}
+	return apierror.Internal(err)
+}
+
+// accountLocked 返回 423 account_locked，Retry-After 为剩余锁定时间
+func accountLocked(remaining time.Duration) *apierror.Error {
+	return apierror.New(http.StatusLocked, apierror.CodeAccountLocked).
+		WithRetryAfter(ratelimit.RetryAfterSeconds(remaining))
+}
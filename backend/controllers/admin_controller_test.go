@@ -0,0 +1,176 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/config"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/ratelimit"
+	"github.com/10240418/advertisement-management-system/backend/repository/memory"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testPassword 测试管理员 root 的密码
+const testPassword = "Str0ng!Passw0rd"
+
+// testClock 测试用的可调时钟
+type testClock struct{ t time.Time }
+
+func (c *testClock) now() time.Time          { return c.t }
+func (c *testClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+// newLoginTestAPI 注册登录接口（与 routers 中一样按 IP 限流），并创建密码为 testPassword 的管理员 root
+// 返回的时钟控制锁定判断使用的当前时间
+func newLoginTestAPI(t *testing.T, auth config.AuthConfig) (*testAPI, *testClock) {
+	t.Helper()
+	config.InitJWT(config.JWTConfig{Secret: "0123456789abcdef0123456789abcdef", TTL: time.Hour})
+
+	store := memory.NewStore()
+	limiter := ratelimit.NewMemoryStore()
+	clk := &testClock{t: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)}
+	adminController := NewAdminController(store, limiter, auth)
+	adminController.now = clk.now
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Admins().Create(context.Background(), &models.Administrator{Username: "root", Password: string(hash), Role: models.RoleSuperAdmin}); err != nil {
+		t.Fatal(err)
+	}
+
+	r := gin.New()
+	r.POST("/api/admin/login", ratelimit.Middleware(limiter, "login", ratelimit.PerMinute(auth.LoginPerIP), ratelimit.ByIP), adminController.LoginAdmin)
+	return &testAPI{t: t, router: r, store: store}, clk
+}
+
+// loginAttempt 推进时钟后从 ip 以 username/password 登录一次
+type loginAttempt struct {
+	advance    time.Duration
+	ip         string
+	username   string
+	password   string
+	status     int
+	code       string // 为空时期望登录成功
+	retryAfter string
+}
+
+func runLoginAttempts(t *testing.T, auth config.AuthConfig, attempts []loginAttempt) {
+	api, clk := newLoginTestAPI(t, auth)
+	for i, at := range attempts {
+		clk.advance(at.advance)
+		ip := at.ip
+		if ip == "" {
+			ip = "192.0.2.1"
+		}
+		w := api.doFrom(ip, http.MethodPost, "/api/admin/login", map[string]string{"username": at.username, "password": at.password})
+		if at.code == "" {
+			api.expect(w, at.status, nil)
+		} else {
+			api.expectError(w, at.status, at.code)
+		}
+		if got := w.Header().Get("Retry-After"); got != at.retryAfter {
+			t.Fatalf("第 %d 次登录 Retry-After %q，期望 %q", i+1, got, at.retryAfter)
+		}
+	}
+}
+
+// TestLoginRateLimit 登录按 IP 与按用户名分别限流，超出时返回 429 与 Retry-After
+func TestLoginRateLimit(t *testing.T) {
+	const wrong = "Wr0ng!Passw0rd"
+	tests := []struct {
+		name     string
+		auth     config.AuthConfig
+		attempts []loginAttempt
+	}{
+		{
+			name: "按 IP 限流",
+			auth: config.AuthConfig{LoginPerIP: 2},
+			attempts: []loginAttempt{
+				{ip: "192.0.2.1", username: "root", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{ip: "192.0.2.1", username: "nobody", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{ip: "192.0.2.1", username: "root", password: testPassword, status: http.StatusTooManyRequests, code: "rate_limited", retryAfter: "30"},
+				{ip: "192.0.2.2", username: "root", password: testPassword, status: http.StatusOK},
+			},
+		},
+		{
+			name: "按用户名限流",
+			auth: config.AuthConfig{LoginPerUser: 2},
+			attempts: []loginAttempt{
+				{ip: "192.0.2.1", username: "root", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{ip: "192.0.2.2", username: "root", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{ip: "192.0.2.3", username: "root", password: testPassword, status: http.StatusTooManyRequests, code: "rate_limited", retryAfter: "30"},
+				{ip: "192.0.2.3", username: "nobody", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runLoginAttempts(t, tt.auth, tt.attempts)
+		})
+	}
+}
+
+// TestLoginLockout 连续失败达到阈值后锁定账号，锁定期间正确密码同样被拒绝，登录成功后清零失败次数
+func TestLoginLockout(t *testing.T) {
+	const wrong = "Wr0ng!Passw0rd"
+	auth := config.AuthConfig{LockoutThreshold: 3, LockoutDuration: 15 * time.Minute}
+
+	tests := []struct {
+		name     string
+		attempts []loginAttempt
+	}{
+		{
+			name: "达到阈值后锁定",
+			attempts: []loginAttempt{
+				{username: "root", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{username: "root", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{username: "root", password: wrong, status: http.StatusLocked, code: "account_locked", retryAfter: "900"},
+				{advance: 5 * time.Minute, username: "root", password: testPassword, status: http.StatusLocked, code: "account_locked", retryAfter: "600"},
+				{advance: 10 * time.Minute, username: "root", password: testPassword, status: http.StatusOK},
+			},
+		},
+		{
+			name: "登录成功后失败次数清零",
+			attempts: []loginAttempt{
+				{username: "root", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{username: "root", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{username: "root", password: testPassword, status: http.StatusOK},
+				{username: "root", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{username: "root", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{username: "root", password: wrong, status: http.StatusLocked, code: "account_locked", retryAfter: "900"},
+			},
+		},
+		{
+			name: "不存在的用户名不会锁定",
+			attempts: []loginAttempt{
+				{username: "nobody", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{username: "nobody", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{username: "nobody", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+				{username: "nobody", password: wrong, status: http.StatusUnauthorized, code: "invalid_credentials"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runLoginAttempts(t, auth, tt.attempts)
+		})
+	}
+}
+
+// TestDummyPasswordHash 用户名不存在时比对的哈希必须有效且代价与真实密码一致，否则响应时间仍会暴露用户名
+func TestDummyPasswordHash(t *testing.T) {
+	cost, err := bcrypt.Cost([]byte(dummyPasswordHash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost != bcrypt.DefaultCost {
+		t.Fatalf("代价 %d，期望 %d", cost, bcrypt.DefaultCost)
+	}
+}
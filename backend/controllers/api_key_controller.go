@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/apikey"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/middleware"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyController 管理供系统集成使用的 API 密钥
+type APIKeyController struct {
+	store repository.Store
+}
+
+// NewAPIKeyController 创建 APIKeyController
+func NewAPIKeyController(store repository.Store) *APIKeyController {
+	return &APIKeyController{store: store}
+}
+
+// ListAPIKeys 分页获取 API 密钥，不包含密钥本身
+func (ctl *APIKeyController) ListAPIKeys(c *gin.Context) {
+	pageNum, pageSize, opts := pagination(c)
+
+	keys, count, err := ctl.store.APIKeys().List(c.Request.Context(), opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":     keys,
+		"total":    count,
+		"pageNum":  pageNum,
+		"pageSize": pageSize,
+	})
+}
+
+// CreateAPIKey 创建 API 密钥，完整密钥只在响应中返回这一次
+func (ctl *APIKeyController) CreateAPIKey(c *gin.Context) {
+	var input struct {
+		Name      string     `json:"name" binding:"required,max=100"`
+		Scopes    []string   `json:"scopes" binding:"required,min=1"`
+		ExpiresAt *time.Time `json:"expires_at"` // 为空表示永不过期
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	input.Name = strings.TrimSpace(input.Name)
+
+	apiErr := apierror.BadRequest(apierror.CodeValidationFailed)
+	if input.Name == "" {
+		apiErr.WithDetails(apierror.Field("name", "required", nil))
+	}
+	scopes := make([]string, 0, len(input.Scopes))
+	for i, scope := range input.Scopes {
+		if !slices.Contains(models.APIKeyScopes, scope) {
+			apiErr.WithDetails(apierror.Field(fmt.Sprintf("scopes[%d]", i), "oneof",
+				map[string]any{"param": strings.Join(models.APIKeyScopes, " ")}))
+			continue
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	now := time.Now()
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(now) {
+		apiErr.WithDetails(apierror.Field("expires_at", "future", nil))
+	}
+	if len(apiErr.Details) > 0 {
+		apierror.Respond(c, apiErr)
+		return
+	}
+	slices.Sort(scopes)
+
+	ctx := c.Request.Context()
+	admin := middleware.CurrentAdmin(c)
+	var raw string
+	var key models.APIKey
+	// 前缀冲突的概率极低，冲突时重新生成
+	for attempt := 0; ; attempt++ {
+		var prefix, hash string
+		var err error
+		raw, prefix, hash, err = apikey.Generate()
+		if err != nil {
+			apierror.Respond(c, apierror.Internal(err))
+			return
+		}
+		key = models.APIKey{
+			Name:        input.Name,
+			Prefix:      prefix,
+			KeyHash:     hash,
+			Scopes:      scopes,
+			ExpiresAt:   input.ExpiresAt,
+			CreatedByID: admin.ID,
+		}
+		err = ctl.store.APIKeys().Create(ctx, &key)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, repository.ErrDuplicate) || attempt == 2 {
+			apierror.Respond(c, apierror.Internal(err))
+			return
+		}
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "创建 API 密钥",
+		"prefix", key.Prefix, "scopes", key.Scopes, "created_by", admin.Username)
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "API 密钥已创建，请立即保存，之后无法再次查看",
+		"key":     raw,
+		"api_key": key,
+	})
+}
+
+// RevokeAPIKey 吊销 API 密钥，吊销后立即失效，记录保留用于追溯
+func (ctl *APIKeyController) RevokeAPIKey(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := ctl.store.APIKeys().Revoke(ctx, id, time.Now()); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeAPIKeyNotFound))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "吊销 API 密钥", "id", id, "revoked_by", middleware.CurrentAdmin(c).Username)
+	c.JSON(http.StatusOK, gin.H{"message": "API 密钥已吊销"})
+}
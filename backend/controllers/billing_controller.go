@@ -0,0 +1,531 @@
+package controllers
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/billing"
+	"github.com/10240418/advertisement-management-system/backend/dayparting"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// RateCardInput 创建或更新费率卡的输入，更新时整体替换
+type RateCardInput struct {
+	Name        string                `json:"name" binding:"required,max=100"`
+	Description string                `json:"description" binding:"max=500"`
+	Currency    string                `json:"currency" binding:"required,len=3,alpha"` // ISO 4217 货币代码
+	Rates       []models.RateCardRate `json:"rates" binding:"required,min=1,max=500"`
+}
+
+// InvoiceInput 开具发票的输入，计费期为各大厦的当地日期，包含两端
+type InvoiceInput struct {
+	AdvertisementID uint   `json:"advertisement_id" binding:"required"`
+	RateCardID      uint   `json:"rate_card_id" binding:"required"`
+	PeriodStart     string `json:"period_start" binding:"required"`
+	PeriodEnd       string `json:"period_end" binding:"required"`
+}
+
+// Charges 广告在计费期内按费率卡计算的费用，每栋投放的大厦一行
+type Charges struct {
+	AdvertisementID uint           `json:"advertisement_id"`
+	Title           string         `json:"title"`
+	RateCardID      uint           `json:"rate_card_id"`
+	Currency        string         `json:"currency"`
+	From            string         `json:"from"`
+	To              string         `json:"to"`
+	Seconds         int64          `json:"seconds"`
+	Total           int64          `json:"total"`
+	Lines           []billing.Line `json:"lines"`
+}
+
+// MissingRate 费率卡中没有适用费率的大厦
+type MissingRate struct {
+	BuildingID uint   `json:"building_id"`
+	Tier       string `json:"tier"`
+}
+
+// BillingController 管理费率卡、计算投放费用并开具发票
+type BillingController struct {
+	store  repository.Store
+	engine *dayparting.Engine
+}
+
+// NewBillingController 创建 BillingController，engine 用于按分时规则与大厦时区计算播放小时
+func NewBillingController(store repository.Store, engine *dayparting.Engine) *BillingController {
+	return &BillingController{store: store, engine: engine}
+}
+
+// ListRateCards 分页获取费率卡，按名称排序
+func (ctl *BillingController) ListRateCards(c *gin.Context) {
+	pageNum, pageSize, opts := pagination(c)
+
+	cards, count, err := ctl.store.RateCards().List(c.Request.Context(), opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":     cards,
+		"total":    count,
+		"pageNum":  pageNum,
+		"pageSize": pageSize,
+	})
+}
+
+// GetRateCard 获取单个费率卡
+func (ctl *BillingController) GetRateCard(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	card, err := ctl.store.RateCards().Get(c.Request.Context(), id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeRateCardNotFound))
+		return
+	}
+
+	c.JSON(http.StatusOK, card)
+}
+
+// CreateRateCard 创建费率卡
+func (ctl *BillingController) CreateRateCard(c *gin.Context) {
+	var input RateCardInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	var card models.RateCard
+	if apiErr := input.apply(&card); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := ctl.store.RateCards().Create(ctx, &card); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			apierror.Respond(c, apierror.Conflict(apierror.CodeRateCardNameTaken))
+			return
+		}
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "创建费率卡", "id", card.ID, "name", card.Name, "rates", len(card.Rates))
+	c.JSON(http.StatusCreated, card)
+}
+
+// UpdateRateCard 替换费率卡，已开具的发票不受影响
+func (ctl *BillingController) UpdateRateCard(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input RateCardInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	card, err := ctl.store.RateCards().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeRateCardNotFound))
+		return
+	}
+	if apiErr := input.apply(card); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+	if err := ctl.store.RateCards().Save(ctx, card); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			apierror.Respond(c, apierror.Conflict(apierror.CodeRateCardNameTaken))
+			return
+		}
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, card)
+}
+
+// DeleteRateCard 删除费率卡，已开具的发票保留费率卡名称
+func (ctl *BillingController) DeleteRateCard(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := ctl.store.RateCards().Delete(ctx, id); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeRateCardNotFound))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "删除费率卡", "id", id)
+	c.JSON(http.StatusOK, gin.H{"message": "费率卡已删除"})
+}
+
+// GetAdCharges 按费率卡计算广告在 from、to 两个日期之间（包含两端）的费用，不开具发票。
+// ?rate_card_id= 必填，日期按各大厦的当地日期计算
+func (ctl *BillingController) GetAdCharges(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	apiErr := checkPeriod("from", c.Query("from"), "to", c.Query("to"))
+	cardID, err := strconv.ParseUint(c.Query("rate_card_id"), 10, 64)
+	switch {
+	case c.Query("rate_card_id") == "":
+		apiErr.WithDetails(apierror.Field("rate_card_id", "required", nil))
+	case err != nil || cardID == 0:
+		apiErr.WithDetails(apierror.Field("rate_card_id", "invalid", map[string]any{"value": c.Query("rate_card_id")}))
+	}
+	if len(apiErr.Details) > 0 {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	ad, err := ctl.store.Ads().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeAdNotFound))
+		return
+	}
+	card, err := ctl.store.RateCards().Get(ctx, uint(cardID))
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeRateCardNotFound))
+		return
+	}
+	charges, err := ctl.charges(ctx, ctl.store, ad, card, c.Query("from"), c.Query("to"))
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, charges)
+}
+
+// ListInvoices 分页获取发票，按开具时间倒序，不包含明细；?advertisement_id= 只返回该广告的发票
+func (ctl *BillingController) ListInvoices(c *gin.Context) {
+	pageNum, pageSize, opts := pagination(c)
+	var adID uint64
+	if value := c.Query("advertisement_id"); value != "" {
+		var err error
+		if adID, err = strconv.ParseUint(value, 10, 64); err != nil {
+			apierror.Respond(c, apierror.BadRequest(apierror.CodeValidationFailed).
+				WithDetails(apierror.Field("advertisement_id", "invalid", map[string]any{"value": value})))
+			return
+		}
+	}
+
+	invoices, count, err := ctl.store.Invoices().List(c.Request.Context(), uint(adID), opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":     invoices,
+		"total":    count,
+		"pageNum":  pageNum,
+		"pageSize": pageSize,
+	})
+}
+
+// GetInvoice 获取发票及其明细
+func (ctl *BillingController) GetInvoice(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	invoice, err := ctl.store.Invoices().Get(c.Request.Context(), id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeInvoiceNotFound))
+		return
+	}
+
+	c.JSON(http.StatusOK, invoice)
+}
+
+// CreateInvoice 按费率卡计算广告在计费期内的费用并开具发票，每栋投放的大厦一条明细。
+// 广告在重叠的计费期已有未作废的发票时返回 409
+func (ctl *BillingController) CreateInvoice(c *gin.Context) {
+	var input InvoiceInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	if apiErr := checkPeriod("period_start", input.PeriodStart, "period_end", input.PeriodEnd); len(apiErr.Details) > 0 {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	var invoice models.Invoice
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Ads().Lock(ctx, input.AdvertisementID); err != nil {
+			return err
+		}
+		ad, err := tx.Ads().Get(ctx, input.AdvertisementID)
+		if err != nil {
+			return notFound(err, apierror.CodeAdNotFound)
+		}
+		card, err := tx.RateCards().Get(ctx, input.RateCardID)
+		if err != nil {
+			return notFound(err, apierror.CodeRateCardNotFound)
+		}
+		existing, err := tx.Invoices().Overlapping(ctx, ad.ID, input.PeriodStart, input.PeriodEnd)
+		if err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			ids := make([]uint, len(existing))
+			for i, other := range existing {
+				ids[i] = other.ID
+			}
+			return apierror.Conflict(apierror.CodeInvoiceOverlap).WithMeta("invoice_ids", ids)
+		}
+
+		charges, err := ctl.charges(ctx, tx, ad, card, input.PeriodStart, input.PeriodEnd)
+		if err != nil {
+			return err
+		}
+		invoice = models.Invoice{
+			AdvertisementID:    ad.ID,
+			AdvertisementTitle: ad.Title,
+			RateCardID:         &card.ID,
+			RateCardName:       card.Name,
+			Currency:           card.Currency,
+			PeriodStart:        input.PeriodStart,
+			PeriodEnd:          input.PeriodEnd,
+			Status:             models.InvoiceIssued,
+			Seconds:            charges.Seconds,
+			Total:              charges.Total,
+			Lines:              make([]models.InvoiceLine, len(charges.Lines)),
+		}
+		for i, line := range charges.Lines {
+			invoice.Lines[i] = models.InvoiceLine{
+				BuildingID:   line.BuildingID,
+				BuildingName: line.BuildingName,
+				Tier:         line.Tier,
+				Timezone:     line.Timezone,
+				PlayDuration: line.PlayDuration,
+				Hours:        line.Hours,
+				Seconds:      line.Seconds,
+				Amount:       line.Amount,
+			}
+		}
+		return tx.Invoices().Create(ctx, &invoice)
+	})
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "开具发票", "id", invoice.ID, "advertisement_id", invoice.AdvertisementID,
+		"period_start", invoice.PeriodStart, "period_end", invoice.PeriodEnd, "total", invoice.Total, "currency", invoice.Currency)
+	c.JSON(http.StatusCreated, invoice)
+}
+
+// VoidInvoice 作废发票，作废后可以为同一计费期重新开票
+func (ctl *BillingController) VoidInvoice(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	var invoice *models.Invoice
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		var err error
+		if invoice, err = tx.Invoices().Get(ctx, id); err != nil {
+			return notFound(err, apierror.CodeInvoiceNotFound)
+		}
+		if err := tx.Ads().Lock(ctx, invoice.AdvertisementID); err != nil {
+			return err
+		}
+		if invoice.Status == models.InvoiceVoid {
+			return apierror.Conflict(apierror.CodeInvoiceVoid)
+		}
+		now := time.Now().UTC()
+		invoice.Status = models.InvoiceVoid
+		invoice.VoidedAt = &now
+		return tx.Invoices().Save(ctx, invoice)
+	})
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "作废发票", "id", id)
+	c.JSON(http.StatusOK, invoice)
+}
+
+// charges 计算广告在 from、to 两个当地日期之间（包含两端）在各投放大厦的费用，按大厦 ID 排序。
+// 费率卡没有适用于某些大厦等级的费率时返回 422 rate_missing，meta.buildings 列出这些大厦
+func (ctl *BillingController) charges(ctx context.Context, store repository.Store, ad *models.Advertisement, card *models.RateCard, from, to string) (*Charges, error) {
+	placements, err := store.Placements().ListByAdvertisement(ctx, ad.ID)
+	if err != nil {
+		return nil, err
+	}
+	buildings, err := store.Buildings().FindByIDs(ctx, buildingIDsOfPlacements(placements))
+	if err != nil {
+		return nil, err
+	}
+	buildingsByID := make(map[uint]models.Building, len(buildings))
+	for _, building := range buildings {
+		buildingsByID[building.ID] = building
+	}
+	plan, err := ctl.engine.Prepare(ctx, placements)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(placements, func(a, b models.AdvertisementBuilding) int { return cmp.Compare(a.BuildingID, b.BuildingID) })
+	charges := &Charges{
+		AdvertisementID: ad.ID,
+		Title:           ad.Title,
+		RateCardID:      card.ID,
+		Currency:        card.Currency,
+		From:            from,
+		To:              to,
+		Lines:           make([]billing.Line, 0, len(placements)),
+	}
+	missing := []MissingRate{}
+	for _, placement := range placements {
+		building, ok := buildingsByID[placement.BuildingID]
+		if !ok {
+			continue
+		}
+		rates, ok := billing.RatesFor(card, building.Tier)
+		if !ok {
+			missing = append(missing, MissingRate{BuildingID: building.ID, Tier: building.Tier})
+			continue
+		}
+		settings := ctl.engine.Region(&building)
+		start, end := dateBounds(from, to, settings.Location)
+		line := billing.Charge(plan, rates, *ad, placement, settings.Location, start, end)
+		line.BuildingID, line.BuildingName = building.ID, building.Name
+		line.Tier, line.Timezone = building.Tier, settings.Timezone
+		charges.Lines = append(charges.Lines, line)
+		charges.Seconds += line.Seconds
+		charges.Total += line.Amount
+	}
+	if len(missing) > 0 {
+		return nil, apierror.Unprocessable(apierror.CodeRateMissing).WithMeta("buildings", missing)
+	}
+	return charges, nil
+}
+
+// apply 校验输入并写入费率卡：时段为整点，同一等级的时段互不重叠且覆盖全天，价格不能为负数
+func (input RateCardInput) apply(card *models.RateCard) *apierror.Error {
+	name := strings.TrimSpace(input.Name)
+	apiErr := requireNonBlank("name", name)
+
+	rates := make([]models.RateCardRate, len(input.Rates))
+	for i, rate := range input.Rates {
+		field := fmt.Sprintf("rates[%d]", i)
+		rate.Tier = billing.NormalizeTier(rate.Tier)
+		valid := true
+		if len(rate.Tier) > 50 {
+			apiErr.WithDetails(apierror.Field(field+".tier", "max", map[string]any{"param": 50}))
+		}
+		start, startOK := dayparting.ParseClock(rate.Start)
+		switch {
+		case !startOK || start >= 24*60:
+			apiErr.WithDetails(apierror.Field(field+".start", "time_of_day", nil))
+			valid = false
+		case start%60 != 0:
+			apiErr.WithDetails(apierror.Field(field+".start", "whole_hour", nil))
+			valid = false
+		}
+		end, endOK := dayparting.ParseClock(rate.End)
+		switch {
+		case !endOK:
+			apiErr.WithDetails(apierror.Field(field+".end", "time_of_day", nil))
+			valid = false
+		case end%60 != 0:
+			apiErr.WithDetails(apierror.Field(field+".end", "whole_hour", nil))
+			valid = false
+		case valid && end <= start:
+			apiErr.WithDetails(apierror.Field(field+".end", "after", map[string]any{"param": field + ".start"}))
+			valid = false
+		}
+		if rate.PricePerSecond < 0 {
+			apiErr.WithDetails(apierror.Field(field+".price_per_second", "gte", map[string]any{"param": 0}))
+		}
+		rates[i] = rate
+	}
+	if len(apiErr.Details) > 0 {
+		return apiErr
+	}
+
+	// 同一等级的时段按开始时间排序后应首尾相接，从 00:00 到 24:00
+	overlaps, uncovered := billing.CheckBands(rates)
+	for _, overlap := range overlaps {
+		apiErr.WithDetails(apierror.Field(fmt.Sprintf("rates[%d]", overlap.Index), "overlaps",
+			map[string]any{"other": fmt.Sprintf("rates[%d]", overlap.Other)}))
+	}
+	for _, tier := range uncovered {
+		display := tier
+		if display == "" {
+			display = "(default)"
+		}
+		apiErr.WithDetails(apierror.Field("rates", "day_coverage", map[string]any{"tier": display}))
+	}
+	if len(apiErr.Details) > 0 {
+		return apiErr
+	}
+
+	slices.SortStableFunc(rates, func(a, b models.RateCardRate) int {
+		if a.Tier != b.Tier {
+			return cmp.Compare(a.Tier, b.Tier)
+		}
+		return cmp.Compare(a.Start, b.Start)
+	})
+	card.Name = name
+	card.Description = input.Description
+	card.Currency = strings.ToUpper(input.Currency)
+	card.Rates = rates
+	return nil
+}
+
+// checkPeriod 校验计费期：两个字段都是 YYYY-MM-DD 日期，结束日期不早于开始日期且最多 maxReportDays 天。
+// 返回的错误没有 Details 时表示校验通过，调用方可以继续追加其他字段的错误
+func checkPeriod(fromField, from, toField, to string) *apierror.Error {
+	apiErr := apierror.BadRequest(apierror.CodeValidationFailed)
+	parse := func(field, value string) (time.Time, bool) {
+		if value == "" {
+			apiErr.WithDetails(apierror.Field(field, "required", nil))
+			return time.Time{}, false
+		}
+		t, err := time.Parse(models.DateLayout, value)
+		if err != nil {
+			apiErr.WithDetails(apierror.Field(field, "date", nil))
+			return time.Time{}, false
+		}
+		return t, true
+	}
+	start, startOK := parse(fromField, from)
+	end, endOK := parse(toField, to)
+	if startOK && endOK {
+		if rangeErr := checkDateRange(fromField, toField, start, end); rangeErr != nil {
+			apiErr.WithDetails(rangeErr.Details...)
+		}
+	}
+	return apiErr
+}
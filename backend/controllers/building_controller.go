@@ -1,13 +1,15 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
-	"strconv"
 
-	"github.com/10240418/advertisement-management-system/backend/config"
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/billing"
 	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/10240418/advertisement-management-system/backend/webhooks"
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
 // CreateBuildingInput 定义创建大厦的输入结构体
@@ -16,23 +18,49 @@ type CreateBuildingInput struct {
 	Address          string `json:"address"`
 	BuildingID       string `json:"blg_id"`
 	AdvertisementIDs []uint `json:"advertisement_ids"`
+	Timezone         string `json:"timezone"`              // IANA 时区，为空时使用默认时区
+	Locale           string `json:"locale"`                // BCP 47 语言标签，为空时使用默认语言
+	Tier             string `json:"tier" binding:"max=50"` // 计费等级，为空时使用费率卡的默认费率
 	// NoticeIDs 如果需要关联通知，也可以添加
 }
 
 // UpdateBuildingInput 定义更新大厦的输入结构体
 type UpdateBuildingInput struct {
-	Name       string `json:"name"`
-	Address    string `json:"address"`
-	BuildingID string `json:"blg_id"`
+	Name       string  `json:"name"`
+	Address    string  `json:"address"`
+	BuildingID string  `json:"blg_id"`
+	Timezone   string  `json:"timezone"`
+	Locale     string  `json:"locale"`
+	Tier       *string `json:"tier" binding:"omitempty,max=50"` // 为空字符串时清除等级
+}
+
+// BuildingController 处理大厦相关的请求
+type BuildingController struct {
+	store repository.Store
+}
+
+// NewBuildingController 创建 BuildingController
+func NewBuildingController(store repository.Store) *BuildingController {
+	return &BuildingController{store: store}
 }
 
 // CreateBuilding 创建新大厦，并关联广告
-func CreateBuilding(c *gin.Context) {
+func (ctl *BuildingController) CreateBuilding(c *gin.Context) {
 	var input CreateBuildingInput
 
 	// 绑定 JSON 数据到 input 结构体
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	if apiErr := validTimezone(input.Timezone); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+	locale, apiErr := validLocale(input.Locale)
+	if apiErr != nil {
+		apierror.Respond(c, apiErr)
 		return
 	}
 
@@ -41,75 +69,72 @@ func CreateBuilding(c *gin.Context) {
 		Name:       input.Name,
 		Address:    input.Address,
 		BuildingID: input.BuildingID,
+		Timezone:   input.Timezone,
+		Locale:     locale,
+		Tier:       billing.NormalizeTier(input.Tier),
 	}
 
-	// 开始事务
-	tx := config.DB.Begin()
-	if tx.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "启动事务失败"})
-		return
-	}
-
-	// 保存大厦到数据库
-	if err := tx.Create(&building).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "创建大厦失败"})
-		return
-	}
-
-	// 处理广告关联
-	if len(input.AdvertisementIDs) > 0 {
-		var ads []models.Advertisement
-		if err := tx.Where("id IN ?", input.AdvertisementIDs).Find(&ads).Error; err != nil {
-			tx.Rollback()
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "查询广告失败"})
-			return
+	ctx := c.Request.Context()
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		// 保存大厦到数据库
+		if err := tx.Buildings().Create(ctx, &building); err != nil {
+			if errors.Is(err, repository.ErrDuplicate) {
+				return apierror.Conflict(apierror.CodeBuildingNameTaken)
+			}
+			return err
 		}
 
-		if len(ads) != len(input.AdvertisementIDs) {
-			tx.Rollback()
-			c.JSON(http.StatusBadRequest, gin.H{"error": "某些广告 ID 不存在"})
-			return
-		}
+		// 处理广告关联
+		if len(input.AdvertisementIDs) > 0 {
+			ads, err := tx.Ads().FindByIDs(ctx, input.AdvertisementIDs)
+			if err != nil {
+				return err
+			}
 
-		// 创建 AdvertisementBuilding 关联记录
-		for _, ad := range ads {
-			association := models.AdvertisementBuilding{
-				AdvertisementID: ad.ID,
-				BuildingID:      building.ID,
-				PlayDuration:    ad.VideoDuration, // 默认为 VideoDuration
+			if len(ads) != len(uniqueIDs(input.AdvertisementIDs)) {
+				return apierror.BadRequest(apierror.CodeUnknownAdIDs).WithMeta("missing_ids", missingIDs(input.AdvertisementIDs, adIDsOf(ads)))
 			}
-			if err := tx.Create(&association).Error; err != nil {
-				tx.Rollback()
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "关联大厦与广告失败"})
-				return
+
+			// 创建 AdvertisementBuilding 关联记录
+			events := make([]webhooks.Event, 0, len(ads))
+			for _, ad := range ads {
+				placement, err := createPlacement(ctx, tx, ad, building.ID)
+				if err != nil {
+					return err
+				}
+				events = append(events, webhooks.PlacementEvent(models.EventPlacementCreated, *placement))
 			}
+			return webhooks.Emit(ctx, tx, events...)
 		}
-	}
-
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "提交事务失败"})
+		return nil
+	})
+	if err != nil {
+		apierror.Respond(c, err)
 		return
 	}
 
 	// 预加载关联数据返回
-	if err := config.DB.Preload("AdvertisementBuildings").First(&building, building.ID).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取大厦失败"})
+	created, err := ctl.store.Buildings().GetWithPlacements(ctx, building.ID)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
-	c.JSON(http.StatusOK, building)
+	c.JSON(http.StatusOK, created)
 }
 
 // UpdateBuilding 更新大厦的基本信息
-func UpdateBuilding(c *gin.Context) {
-	id := c.Param("id")
-	var building models.Building
+func (ctl *BuildingController) UpdateBuilding(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
 
 	// 查找大厦
-	if err := config.DB.First(&building, id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "大厦未找到"})
+	building, err := ctl.store.Buildings().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
 		return
 	}
 
@@ -117,7 +142,7 @@ func UpdateBuilding(c *gin.Context) {
 
 	// 绑定 JSON 数据到 input 结构体
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Respond(c, apierror.FromBinding(err))
 		return
 	}
 
@@ -131,50 +156,67 @@ func UpdateBuilding(c *gin.Context) {
 	if input.BuildingID != "" {
 		building.BuildingID = input.BuildingID
 	}
+	if input.Timezone != "" {
+		if apiErr := validTimezone(input.Timezone); apiErr != nil {
+			apierror.Respond(c, apiErr)
+			return
+		}
+		building.Timezone = input.Timezone
+	}
+	if input.Locale != "" {
+		locale, apiErr := validLocale(input.Locale)
+		if apiErr != nil {
+			apierror.Respond(c, apiErr)
+			return
+		}
+		building.Locale = locale
+	}
+	if input.Tier != nil {
+		building.Tier = billing.NormalizeTier(*input.Tier)
+	}
 
 	// 保存更新后的大厦
-	if err := config.DB.Save(&building).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "更新大厦失败"})
+	if err := ctl.store.Buildings().Save(ctx, building); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			apierror.Respond(c, apierror.Conflict(apierror.CodeBuildingNameTaken))
+			return
+		}
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
 	// 预加载关联数据返回
-	if err := config.DB.Preload("AdvertisementBuildings").First(&building, building.ID).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取大厦失败"})
+	updated, err := ctl.store.Buildings().GetWithPlacements(ctx, building.ID)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
-	c.JSON(http.StatusOK, building)
+	c.JSON(http.StatusOK, updated)
 }
 
 // DeleteBuilding 删除大厦
-func DeleteBuilding(c *gin.Context) {
-	id := c.Param("id")
-
-	// 开始事务
-	tx := config.DB.Begin()
-	if tx.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "启动事务失败"})
-		return
-	}
-
-	// 删除关联的 AdvertisementBuilding 记录
-	if err := tx.Where("building_id = ?", id).Delete(&models.AdvertisementBuilding{}).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "删除关联大厦与广告失败"})
+func (ctl *BuildingController) DeleteBuilding(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
 		return
 	}
 
-	// 删除大厦记录
-	if err := tx.Unscoped().Delete(&models.Building{}, "id = ?", id).Error; err != nil {
-		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "删除大厦失败"})
-		return
-	}
+	ctx := c.Request.Context()
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		placements, err := tx.Placements().ListByBuilding(ctx, id)
+		if err != nil {
+			return err
+		}
 
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "提交事务失败"})
+		// 删除大厦记录及其关联的 AdvertisementBuilding 记录
+		if err := tx.Buildings().Delete(ctx, id); err != nil {
+			return err
+		}
+		return webhooks.Emit(ctx, tx, webhooks.PlacementEvents(models.EventPlacementDeleted, placements)...)
+	})
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
@@ -182,46 +224,14 @@ func DeleteBuilding(c *gin.Context) {
 }
 
 // GetBuildings 获取所有大厦，并支持分页和排序
-func GetBuildings(c *gin.Context) {
+func (ctl *BuildingController) GetBuildings(c *gin.Context) {
 	// 从查询参数中获取分页信息
-	pageNumStr := c.DefaultQuery("pageNum", "1")
-	pageSizeStr := c.DefaultQuery("pageSize", "10")
-	desc := c.DefaultQuery("desc", "true")
-
-	pageNum, err := strconv.Atoi(pageNumStr)
-	if err != nil {
-		pageNum = 1
-	}
-
-	pageSize, err := strconv.Atoi(pageSizeStr)
-	if err != nil {
-		pageSize = 10
-	}
-
-	offset := (pageNum - 1) * pageSize
-
-	var buildings []models.Building
-	var count int64
-
-	// 构建查询
-	baseQuery := config.DB.Model(&models.Building{})
-
-	// 添加排序
-	if desc == "true" {
-		baseQuery = baseQuery.Order("created_at DESC")
-	} else {
-		baseQuery = baseQuery.Order("created_at ASC")
-	}
-
-	// 获取总记录数不包含limit和offset
-	if err := baseQuery.Count(&count).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取总数失败"})
-		return
-	}
+	pageNum, pageSize, opts := pagination(c)
 
 	// 执行查询并进行分页
-	if err := baseQuery.Offset(offset).Limit(pageSize).Find(&buildings).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取大厦失败"})
+	buildings, count, err := ctl.store.Buildings().List(c.Request.Context(), opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
 		return
 	}
 
@@ -235,20 +245,15 @@ func GetBuildings(c *gin.Context) {
 }
 
 // GetBuilding 获取单个大厦
-func GetBuilding(c *gin.Context) {
-	id := c.Param("id")
-	var building models.Building
-
-	if err := config.DB.
-		Preload("AdvertisementBuildings").
-		Preload("AdvertisementBuildings.Advertisement").
-		Preload("AdvertisementBuildings.Building").
-		First(&building, id).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "大厦未找到"})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "获取大厦失败"})
-		}
+func (ctl *BuildingController) GetBuilding(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	building, err := ctl.store.Buildings().GetWithPlacements(c.Request.Context(), id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
 		return
 	}
 
@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+)
+
+func TestCreateBuildingDuplicateName(t *testing.T) {
+	api := newTestAPI(t)
+	api.createBuilding("国贸大厦")
+
+	api.expectError(api.do(http.MethodPost, "/api/buildings", map[string]any{"name": "国贸大厦"}), http.StatusConflict, "building_name_taken")
+
+	// 改名为已有名称同样冲突
+	other := api.createBuilding("环球中心")
+	api.expectError(api.do(http.MethodPut, fmt.Sprintf("/api/buildings/%d", other.ID), map[string]any{"name": "国贸大厦"}), http.StatusConflict, "building_name_taken")
+}
+
+func TestCreateBuildingUnknownAdsRollsBack(t *testing.T) {
+	api := newTestAPI(t)
+	ad := api.createAd("新品发布", 15)
+
+	meta := api.expectError(api.do(http.MethodPost, "/api/buildings", map[string]any{
+		"name":              "国贸大厦",
+		"advertisement_ids": []uint{ad.ID, 99, 99},
+	}), http.StatusBadRequest, "unknown_ad_ids")
+	if got := fmt.Sprint(meta["missing_ids"]); got != "[99]" {
+		t.Fatalf("missing_ids = %s，期望 [99]", got)
+	}
+
+	// 事务回滚，大厦没有被创建，名称仍然可用
+	var list struct {
+		Total int64 `json:"total"`
+	}
+	api.expect(api.do(http.MethodGet, "/api/buildings", nil), http.StatusOK, &list)
+	if list.Total != 0 {
+		t.Fatalf("total = %d，期望 0", list.Total)
+	}
+	api.createBuilding("国贸大厦", ad.ID)
+}
+
+func TestDeleteBuildingCascades(t *testing.T) {
+	api := newTestAPI(t)
+	first := api.createAd("新品发布", 15)
+	second := api.createAd("周年庆", 30)
+	building := api.createBuilding("国贸大厦", first.ID, second.ID)
+	kept := api.createBuilding("环球中心", first.ID)
+
+	api.expect(api.do(http.MethodDelete, fmt.Sprintf("/api/buildings/%d", building.ID), nil), http.StatusOK, nil)
+	api.expectError(api.do(http.MethodGet, fmt.Sprintf("/api/buildings/%d", building.ID), nil), http.StatusNotFound, "building_not_found")
+
+	// 只删除该大厦的投放记录
+	var ad models.Advertisement
+	api.expect(api.do(http.MethodGet, fmt.Sprintf("/api/ads/%d", first.ID), nil), http.StatusOK, &ad)
+	if got, want := placementKeys(ad.AdvertisementBuildings), [][2]uint{{first.ID, kept.ID}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("广告 %d 的投放记录 %v，期望 %v", first.ID, got, want)
+	}
+	placements, err := api.store.Placements().ListByAdvertisement(context.Background(), second.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(placements) != 0 {
+		t.Fatalf("广告 %d 仍有投放记录 %v", second.ID, placementKeys(placements))
+	}
+
+	// 删除后可以重新使用名称
+	api.createBuilding("国贸大厦")
+}
+
+func TestGetBuildingsOrdering(t *testing.T) {
+	api := newTestAPI(t)
+	var ids []uint
+	for i := 0; i < 3; i++ {
+		ids = append(ids, api.createBuilding(fmt.Sprintf("大厦 %d", i)).ID)
+	}
+
+	tests := []struct {
+		query string
+		want  []uint
+	}{
+		{"", []uint{ids[2], ids[1], ids[0]}}, // 默认按创建时间倒序
+		{"?desc=false", ids},
+		{"?pageSize=2&pageNum=2", []uint{ids[0]}},
+		{"?desc=false&pageSize=2&pageNum=1", ids[:2]},
+		{"?pageSize=2&pageNum=3", []uint{}},
+	}
+	for _, tt := range tests {
+		var list struct {
+			Data  []models.Building `json:"data"`
+			Total int64             `json:"total"`
+		}
+		api.expect(api.do(http.MethodGet, "/api/buildings"+tt.query, nil), http.StatusOK, &list)
+		got := []uint{}
+		for _, building := range list.Data {
+			got = append(got, building.ID)
+		}
+		if !reflect.DeepEqual(got, tt.want) || list.Total != 3 {
+			t.Errorf("GET /api/buildings%s = %v (total %d)，期望 %v (total 3)", tt.query, got, list.Total, tt.want)
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/dayparting"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// CalendarInput 创建或更新日历的输入
+type CalendarInput struct {
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description" binding:"max=500"`
+}
+
+// CalendarEventInput 手工添加日历事件的输入，end_date 不包含在内，为空表示只有 start_date 一天
+type CalendarEventInput struct {
+	Summary   string `json:"summary" binding:"required,max=200"`
+	StartDate string `json:"start_date" binding:"required"`
+	EndDate   string `json:"end_date"`
+	RRule     string `json:"rrule" binding:"max=500"`
+}
+
+// CalendarController 管理命名日历及其事件，事件可以从 iCalendar 文件导入
+type CalendarController struct {
+	store repository.Store
+}
+
+// NewCalendarController 创建 CalendarController
+func NewCalendarController(store repository.Store) *CalendarController {
+	return &CalendarController{store: store}
+}
+
+// ListCalendars 分页获取日历，按名称排序
+func (ctl *CalendarController) ListCalendars(c *gin.Context) {
+	pageNum, pageSize, opts := pagination(c)
+
+	calendars, count, err := ctl.store.Calendars().List(c.Request.Context(), opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":     calendars,
+		"total":    count,
+		"pageNum":  pageNum,
+		"pageSize": pageSize,
+	})
+}
+
+// GetCalendar 获取单个日历
+func (ctl *CalendarController) GetCalendar(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	calendar, err := ctl.store.Calendars().Get(c.Request.Context(), id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeCalendarNotFound))
+		return
+	}
+
+	c.JSON(http.StatusOK, calendar)
+}
+
+// CreateCalendar 创建日历
+func (ctl *CalendarController) CreateCalendar(c *gin.Context) {
+	var input CalendarInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	if apiErr := requireNonBlank("name", input.Name); len(apiErr.Details) > 0 {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	calendar := models.Calendar{Name: strings.TrimSpace(input.Name), Description: input.Description}
+	if err := ctl.store.Calendars().Create(ctx, &calendar); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			apierror.Respond(c, apierror.Conflict(apierror.CodeCalendarNameTaken))
+			return
+		}
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "创建日历", "id", calendar.ID, "name", calendar.Name)
+	c.JSON(http.StatusCreated, calendar)
+}
+
+// UpdateCalendar 修改日历名称与说明
+func (ctl *CalendarController) UpdateCalendar(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input CalendarInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	if apiErr := requireNonBlank("name", input.Name); len(apiErr.Details) > 0 {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	calendar, err := ctl.store.Calendars().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeCalendarNotFound))
+		return
+	}
+	calendar.Name = strings.TrimSpace(input.Name)
+	calendar.Description = input.Description
+	if err := ctl.store.Calendars().Save(ctx, calendar); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			apierror.Respond(c, apierror.Conflict(apierror.CodeCalendarNameTaken))
+			return
+		}
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, calendar)
+}
+
+// DeleteCalendar 删除日历及其事件，仍被分时规则引用时返回 409 和这些规则的 ID
+func (ctl *CalendarController) DeleteCalendar(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		daypartIDs, err := tx.Dayparts().UsingCalendar(ctx, id)
+		if err != nil {
+			return err
+		}
+		if len(daypartIDs) > 0 {
+			return apierror.Conflict(apierror.CodeCalendarInUse).WithMeta("daypart_ids", daypartIDs)
+		}
+		if err := tx.Calendars().Delete(ctx, id); err != nil {
+			return notFound(err, apierror.CodeCalendarNotFound)
+		}
+		return nil
+	})
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "删除日历", "id", id)
+	c.JSON(http.StatusOK, gin.H{"message": "日历已删除"})
+}
+
+// ListCalendarEvents 获取日历的全部事件，按开始日期排序
+func (ctl *CalendarController) ListCalendarEvents(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, err := ctl.store.Calendars().Get(ctx, id); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeCalendarNotFound))
+		return
+	}
+	events, err := ctl.store.Calendars().ListEvents(ctx, id)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": events})
+}
+
+// CreateCalendarEvent 向日历添加一个全天事件
+func (ctl *CalendarController) CreateCalendarEvent(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input CalendarEventInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	event, apiErr := input.event()
+	if apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, err := ctl.store.Calendars().Get(ctx, id); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeCalendarNotFound))
+		return
+	}
+	event.CalendarID = id
+	if err := ctl.store.Calendars().CreateEvent(ctx, event); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, event)
+}
+
+// DeleteCalendarEvent 删除日历中的一个事件
+func (ctl *CalendarController) DeleteCalendarEvent(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	eventID, ok := paramID(c, "event_id")
+	if !ok {
+		return
+	}
+
+	if err := ctl.store.Calendars().DeleteEvent(c.Request.Context(), id, eventID); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeEventNotFound))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "日历事件已删除"})
+}
+
+// ImportCalendar 从上传的 iCalendar 文件（字段名 file）导入事件，替换日历中已有的全部事件
+// 已取消或无法解析的事件会被跳过，并在响应中列出原因
+func (ctl *CalendarController) ImportCalendar(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportFileSize)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeFileRequired).With("field", "file"))
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeFileUnreadable).WithCause(err))
+		return
+	}
+	defer file.Close()
+
+	events, skipped, err := dayparting.ParseICalendar(file)
+	if err != nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeFileInvalid).WithCause(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, err := ctl.store.Calendars().Get(ctx, id); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeCalendarNotFound))
+		return
+	}
+	err = ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		return tx.Calendars().ReplaceEvents(ctx, id, events)
+	})
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	if skipped == nil {
+		skipped = []dayparting.Skipped{}
+	}
+	logging.FromContext(ctx).InfoContext(ctx, "导入日历", "id", id, "imported", len(events), "skipped", len(skipped))
+	c.JSON(http.StatusOK, gin.H{
+		"calendar_id": id,
+		"imported":    len(events),
+		"skipped":     skipped,
+	})
+}
+
+// event 校验输入并转换为日历事件
+func (input CalendarEventInput) event() (*models.CalendarEvent, *apierror.Error) {
+	apiErr := requireNonBlank("summary", input.Summary)
+	start, err := dayparting.ParseDate(input.StartDate)
+	if err != nil {
+		apiErr.WithDetails(apierror.Field("start_date", "date", nil))
+	}
+	end := start.AddDate(0, 0, 1)
+	if input.EndDate != "" {
+		if end, err = dayparting.ParseDate(input.EndDate); err != nil {
+			apiErr.WithDetails(apierror.Field("end_date", "date", nil))
+		} else if !end.After(start) {
+			apiErr.WithDetails(apierror.Field("end_date", "after", map[string]any{"param": "start_date"}))
+		}
+	}
+	if input.RRule != "" && len(apiErr.Details) == 0 {
+		if _, err := dayparting.ParseRule(input.RRule, start); err != nil {
+			apiErr.WithDetails(apierror.Field("rrule", "rrule", map[string]any{"reason": err.Error()}))
+		}
+	}
+	if len(apiErr.Details) > 0 {
+		return nil, apiErr
+	}
+	return &models.CalendarEvent{
+		Summary:   strings.TrimSpace(input.Summary),
+		StartDate: start.Format(models.DateLayout),
+		EndDate:   end.Format(models.DateLayout),
+		RRule:     strings.TrimSpace(input.RRule),
+	}, nil
+}
@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/region"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// pagination 解析 pageNum、pageSize 与 desc 查询参数
+func pagination(c *gin.Context) (pageNum, pageSize int, opts repository.ListOptions) {
+	pageNum, err := strconv.Atoi(c.DefaultQuery("pageNum", "1"))
+	if err != nil || pageNum < 1 {
+		pageNum = 1
+	}
+
+	pageSize, err = strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+	if err != nil || pageSize < 1 {
+		pageSize = 10
+	}
+
+	opts = repository.ListOptions{
+		Offset: (pageNum - 1) * pageSize,
+		Limit:  pageSize,
+		Desc:   c.DefaultQuery("desc", "true") == "true",
+	}
+	return pageNum, pageSize, opts
+}
+
+// paramID 解析路径参数中的 ID，无效时直接返回 400
+func paramID(c *gin.Context, name string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 64)
+	if err != nil || id == 0 {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeInvalidID).WithDetails(apierror.Field(name, "invalid", map[string]any{"value": c.Param(name)})))
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// deviceID 返回请求设备的标识，优先使用 X-Device-ID 请求头，缺省时使用客户端 IP
+func deviceID(c *gin.Context) string {
+	if id := strings.TrimSpace(c.GetHeader("X-Device-ID")); id != "" {
+		return id
+	}
+	return c.ClientIP()
+}
+
+// notFound 将仓库的 ErrNotFound 转换为指定错误码的 404，其他错误视为内部错误
+func notFound(err error, code apierror.Code) *apierror.Error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return apierror.NotFound(code)
+	}
+	return apierror.Internal(err)
+}
+
+// missingIDs 返回 requested 中不在 found 里的 ID
+func missingIDs(requested, found []uint) []uint {
+	exists := make(map[uint]struct{}, len(found))
+	for _, id := range found {
+		exists[id] = struct{}{}
+	}
+	missing := []uint{}
+	for _, id := range uniqueIDs(requested) {
+		if _, ok := exists[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+// requireNonBlank 检查按 名称、值 成对传入的字段，返回所有空白字段的 required 校验错误
+func requireNonBlank(pairs ...string) *apierror.Error {
+	apiErr := apierror.BadRequest(apierror.CodeValidationFailed)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if strings.TrimSpace(pairs[i+1]) == "" {
+			apiErr.WithDetails(apierror.Field(pairs[i], "required", nil))
+		}
+	}
+	return apiErr
+}
+
+// validTimezone 校验 IANA 时区名称，空字符串表示使用默认时区
+func validTimezone(name string) *apierror.Error {
+	if name == "" {
+		return nil
+	}
+	if _, err := region.LoadLocation(name); err != nil {
+		return apierror.BadRequest(apierror.CodeValidationFailed).
+			WithDetails(apierror.Field("timezone", "timezone", map[string]any{"value": name}))
+	}
+	return nil
+}
+
+// validLocale 校验 BCP 47 语言标签并返回规范写法，空字符串表示使用默认语言
+func validLocale(value string) (string, *apierror.Error) {
+	if value == "" {
+		return "", nil
+	}
+	locale, err := region.ParseLocale(value)
+	if err != nil {
+		return "", apierror.BadRequest(apierror.CodeValidationFailed).
+			WithDetails(apierror.Field("locale", "locale", map[string]any{"value": value}))
+	}
+	return locale, nil
+}
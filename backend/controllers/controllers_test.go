@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository/memory"
+	"github.com/gin-gonic/gin"
+)
+
+// TestMain 丢弃日志，失败时只输出断言信息
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	os.Exit(m.Run())
+}
+
+// testAPI 基于内存存储的广告、大厦与投放接口，路径与 routers 中一致但不经过认证
+type testAPI struct {
+	t      *testing.T
+	router *gin.Engine
+	store  *memory.Store
+}
+
+func newTestAPI(t *testing.T) *testAPI {
+	store := memory.NewStore()
+	adController := NewAdController(store)
+	buildingController := NewBuildingController(store)
+
+	r := gin.New()
+	ads := r.Group("/api/ads")
+	ads.GET("", adController.GetAds)
+	ads.GET("/:id", adController.GetAd)
+	ads.POST("", adController.CreateAd)
+	ads.PUT("/:id", adController.UpdateAd)
+	ads.DELETE("/:id", adController.DeleteAd)
+	ads.POST("/:id/buildings", adController.AddBuildingsToAd)
+	ads.DELETE("/:id/buildings", adController.RemoveBuildingsFromAd)
+	ads.GET("/:id/buildings", adController.GetBuildingsByAdvertisement)
+
+	buildings := r.Group("/api/buildings")
+	buildings.GET("", buildingController.GetBuildings)
+	buildings.GET("/:id", buildingController.GetBuilding)
+	buildings.POST("", buildingController.CreateBuilding)
+	buildings.PUT("/:id", buildingController.UpdateBuilding)
+	buildings.DELETE("/:id", buildingController.DeleteBuilding)
+	buildings.POST("/:id/ads", adController.AddAdsToBuilding)
+	buildings.DELETE("/:id/ads", adController.RemoveAdsFromBuilding)
+	buildings.GET("/:id/ads", adController.GetAdvertisementsByBuilding)
+	return &testAPI{t: t, router: r, store: store}
+}
+
+// do 发送 JSON 请求，body 为 nil 时不带请求体
+func (a *testAPI) do(method, path string, body any) *httptest.ResponseRecorder {
+	a.t.Helper()
+	return a.doFrom("192.0.2.1", method, path, body)
+}
+
+// doFrom 以 ip 为客户端地址发送 JSON 请求
+func (a *testAPI) doFrom(ip, method, path string, body any) *httptest.ResponseRecorder {
+	a.t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			a.t.Fatal(err)
+		}
+	}
+	req := httptest.NewRequest(method, path, &buf)
+	req.Header.Set("Content-Type", "application/json")
+	req.RemoteAddr = ip + ":1234"
+	w := httptest.NewRecorder()
+	a.router.ServeHTTP(w, req)
+	return w
+}
+
+// expect 检查状态码并解码响应体，out 为 nil 时只检查状态码
+func (a *testAPI) expect(w *httptest.ResponseRecorder, status int, out any) {
+	a.t.Helper()
+	if w.Code != status {
+		a.t.Fatalf("状态码 %d，期望 %d：%s", w.Code, status, w.Body.String())
+	}
+	if out != nil {
+		if err := json.Unmarshal(w.Body.Bytes(), out); err != nil {
+			a.t.Fatalf("解码响应失败：%v：%s", err, w.Body.String())
+		}
+	}
+}
+
+// expectError 检查错误响应的状态码与错误码，返回 meta
+func (a *testAPI) expectError(w *httptest.ResponseRecorder, status int, code string) map[string]any {
+	a.t.Helper()
+	var resp struct {
+		Error struct {
+			Code string         `json:"code"`
+			Meta map[string]any `json:"meta"`
+		} `json:"error"`
+	}
+	a.expect(w, status, &resp)
+	if resp.Error.Code != code {
+		a.t.Fatalf("错误码 %q，期望 %q：%s", resp.Error.Code, code, w.Body.String())
+	}
+	return resp.Error.Meta
+}
+
+func (a *testAPI) createAd(title string, duration int64) models.Advertisement {
+	a.t.Helper()
+	var ad models.Advertisement
+	a.expect(a.do(http.MethodPost, "/api/ads", map[string]any{"title": title, "status": "active", "video_duration": duration}), http.StatusCreated, &ad)
+	return ad
+}
+
+func (a *testAPI) createBuilding(name string, adIDs ...uint) models.Building {
+	a.t.Helper()
+	var building models.Building
+	a.expect(a.do(http.MethodPost, "/api/buildings", map[string]any{"name": name, "advertisement_ids": adIDs}), http.StatusOK, &building)
+	return building
+}
+
+// placementKeys 返回投放记录的 (广告, 大厦) ID 对，保持原有顺序
+func placementKeys(placements []models.AdvertisementBuilding) [][2]uint {
+	keys := make([][2]uint, len(placements))
+	for i, p := range placements {
+		keys[i] = [2]uint{p.AdvertisementID, p.BuildingID}
+	}
+	return keys
+}
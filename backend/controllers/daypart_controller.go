@@ -0,0 +1,349 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/dayparting"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/preview"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/10240418/advertisement-management-system/backend/webhooks"
+	"github.com/gin-gonic/gin"
+)
+
+// DaypartInput 创建或更新分时规则的输入，更新时整体替换
+type DaypartInput struct {
+	Name               string                 `json:"name" binding:"required,max=100"`
+	Description        string                 `json:"description" binding:"max=500"`
+	Windows            []models.DaypartWindow `json:"windows" binding:"required,min=1,max=50"`
+	IncludeCalendarIDs []uint                 `json:"include_calendar_ids"`
+	ExcludeCalendarIDs []uint                 `json:"exclude_calendar_ids"`
+}
+
+// AssignDaypartInput 设置投放分时规则的输入，daypart_id 为 null 时恢复全天播放
+type AssignDaypartInput struct {
+	DaypartID *uint `json:"daypart_id"`
+}
+
+// PlacementEligibility 投放在某一时刻是否可以播放
+type PlacementEligibility struct {
+	AdvertisementID uint   `json:"advertisement_id"`
+	Title           string `json:"title"`
+	DaypartID       *uint  `json:"daypart_id"`
+	dayparting.Decision
+}
+
+// DaypartController 管理分时规则，并按规则判断大厦中的投放当前是否可以播放
+type DaypartController struct {
+	store  repository.Store
+	engine *dayparting.Engine
+}
+
+// NewDaypartController 创建 DaypartController
+func NewDaypartController(store repository.Store, engine *dayparting.Engine) *DaypartController {
+	return &DaypartController{store: store, engine: engine}
+}
+
+// ListDayparts 分页获取分时规则，按名称排序
+func (ctl *DaypartController) ListDayparts(c *gin.Context) {
+	pageNum, pageSize, opts := pagination(c)
+
+	dayparts, count, err := ctl.store.Dayparts().List(c.Request.Context(), opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":     dayparts,
+		"total":    count,
+		"pageNum":  pageNum,
+		"pageSize": pageSize,
+	})
+}
+
+// GetDaypart 获取单个分时规则
+func (ctl *DaypartController) GetDaypart(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	daypart, err := ctl.store.Dayparts().Get(c.Request.Context(), id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeDaypartNotFound))
+		return
+	}
+
+	c.JSON(http.StatusOK, daypart)
+}
+
+// CreateDaypart 创建分时规则
+func (ctl *DaypartController) CreateDaypart(c *gin.Context) {
+	var input DaypartInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	var daypart models.Daypart
+	if apiErr := ctl.apply(c, input, &daypart); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := ctl.store.Dayparts().Create(ctx, &daypart); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			apierror.Respond(c, apierror.Conflict(apierror.CodeDaypartNameTaken))
+			return
+		}
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "创建分时规则", "id", daypart.ID, "name", daypart.Name, "windows", len(daypart.Windows))
+	c.JSON(http.StatusCreated, daypart)
+}
+
+// UpdateDaypart 替换分时规则的时段与引用的日历，使用该规则的投放立即按新规则判断
+func (ctl *DaypartController) UpdateDaypart(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input DaypartInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	daypart, err := ctl.store.Dayparts().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeDaypartNotFound))
+		return
+	}
+	if apiErr := ctl.apply(c, input, daypart); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+	if err := ctl.store.Dayparts().Save(ctx, daypart); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			apierror.Respond(c, apierror.Conflict(apierror.CodeDaypartNameTaken))
+			return
+		}
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, daypart)
+}
+
+// DeleteDaypart 删除分时规则，仍有投放使用时返回 409 和这些投放
+func (ctl *DaypartController) DeleteDaypart(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		placements, err := tx.Dayparts().Placements(ctx, id)
+		if err != nil {
+			return err
+		}
+		if len(placements) > 0 {
+			used := make([]gin.H, 0, len(placements))
+			for _, placement := range placements {
+				used = append(used, gin.H{"advertisement_id": placement.AdvertisementID, "building_id": placement.BuildingID})
+			}
+			return apierror.Conflict(apierror.CodeDaypartInUse).WithMeta("placements", used)
+		}
+		if err := tx.Dayparts().Delete(ctx, id); err != nil {
+			return notFound(err, apierror.CodeDaypartNotFound)
+		}
+		return nil
+	})
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "删除分时规则", "id", id)
+	c.JSON(http.StatusOK, gin.H{"message": "分时规则已删除"})
+}
+
+// AssignPlacementDaypart 设置或清除大厦中一条广告使用的分时规则
+func (ctl *DaypartController) AssignPlacementDaypart(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	adID, ok := paramID(c, "ad_id")
+	if !ok {
+		return
+	}
+	var input AssignDaypartInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	placement, err := ctl.store.Placements().Get(ctx, adID, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodePlacementNotFound))
+		return
+	}
+	if input.DaypartID != nil {
+		if _, err := ctl.store.Dayparts().Get(ctx, *input.DaypartID); err != nil {
+			apierror.Respond(c, notFound(err, apierror.CodeDaypartNotFound))
+			return
+		}
+	}
+
+	placement.DaypartID = input.DaypartID
+	err = ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Placements().Save(ctx, placement); err != nil {
+			return err
+		}
+		return webhooks.Emit(ctx, tx, webhooks.PlacementEvent(models.EventPlacementUpdated, *placement))
+	})
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, placement)
+}
+
+// GetBuildingEligibility 按分时规则判断大厦中每条广告在 at 时刻（RFC 3339，默认当前时间）是否可以播放
+func (ctl *DaypartController) GetBuildingEligibility(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	at := time.Now()
+	if value := c.Query("at"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			apierror.Respond(c, apierror.BadRequest(apierror.CodeValidationFailed).
+				WithDetails(apierror.Field("at", "type", map[string]any{"param": "RFC 3339 time"})))
+			return
+		}
+		at = parsed
+	}
+
+	ctx := c.Request.Context()
+	building, err := ctl.store.Buildings().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return
+	}
+	placements, err := ctl.store.Placements().ListByBuilding(ctx, id)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	ads, err := ctl.store.Ads().FindByIDs(ctx, adIDsOfPlacements(placements))
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	adsByID := make(map[uint]models.Advertisement, len(ads))
+	for _, ad := range ads {
+		adsByID[ad.ID] = ad
+	}
+	plan, err := ctl.engine.Prepare(ctx, placements)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	settings := ctl.engine.Region(building)
+	local := at.In(settings.Location)
+	results := make([]PlacementEligibility, 0, len(placements))
+	for _, placement := range placements {
+		ad := adsByID[placement.AdvertisementID]
+		result := PlacementEligibility{AdvertisementID: ad.ID, Title: ad.Title, DaypartID: placement.DaypartID}
+		result.Decision = preview.Check(plan, preview.Entry{Placement: placement, Ad: ad}, local)
+		results = append(results, result)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"building_id": building.ID,
+		"timezone":    settings.Timezone,
+		"locale":      settings.Locale,
+		"at":          local.Format(time.RFC3339),
+		"data":        results,
+	})
+}
+
+// apply 校验输入并写入分时规则：时段的时刻、起始日期与 RRULE 有效，引用的日历存在
+func (ctl *DaypartController) apply(c *gin.Context, input DaypartInput, daypart *models.Daypart) *apierror.Error {
+	apiErr := requireNonBlank("name", input.Name)
+	windows := make([]models.DaypartWindow, 0, len(input.Windows))
+	for i, w := range input.Windows {
+		field := fmt.Sprintf("windows[%d]", i)
+		w.RRule = strings.TrimSpace(w.RRule)
+		start, startOK := dayparting.ParseClock(w.Start)
+		if !startOK || start >= 24*60 {
+			apiErr.WithDetails(apierror.Field(field+".start", "time_of_day", nil))
+		}
+		end, endOK := dayparting.ParseClock(w.End)
+		if !endOK {
+			apiErr.WithDetails(apierror.Field(field+".end", "time_of_day", nil))
+		} else if startOK && end <= start {
+			apiErr.WithDetails(apierror.Field(field+".end", "after", map[string]any{"param": field + ".start"}))
+		}
+		var anchor time.Time
+		if w.StartDate != "" {
+			var err error
+			if anchor, err = dayparting.ParseDate(w.StartDate); err != nil {
+				apiErr.WithDetails(apierror.Field(field+".start_date", "date", nil))
+				continue
+			}
+		}
+		if w.RRule != "" {
+			if _, err := dayparting.ParseRule(w.RRule, anchor); err != nil {
+				apiErr.WithDetails(apierror.Field(field+".rrule", "rrule", map[string]any{"reason": err.Error()}))
+			}
+		}
+		windows = append(windows, w)
+	}
+
+	ctx := c.Request.Context()
+	references := []struct {
+		field string
+		ids   []uint
+	}{
+		{"include_calendar_ids", input.IncludeCalendarIDs},
+		{"exclude_calendar_ids", input.ExcludeCalendarIDs},
+	}
+	for _, ref := range references {
+		for _, calendarID := range uniqueIDs(ref.ids) {
+			if _, err := ctl.store.Calendars().Get(ctx, calendarID); err != nil {
+				if !errors.Is(err, repository.ErrNotFound) {
+					return apierror.Internal(err)
+				}
+				apiErr.WithDetails(apierror.Field(ref.field, "not_found", map[string]any{"value": calendarID}))
+			}
+		}
+	}
+	if len(apiErr.Details) > 0 {
+		return apiErr
+	}
+
+	daypart.Name = strings.TrimSpace(input.Name)
+	daypart.Description = input.Description
+	daypart.Windows = windows
+	daypart.IncludeCalendarIDs = uniqueIDs(input.IncludeCalendarIDs)
+	daypart.ExcludeCalendarIDs = uniqueIDs(input.ExcludeCalendarIDs)
+	return nil
+}
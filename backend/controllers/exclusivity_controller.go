@@ -0,0 +1,271 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/exclusivity"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// ExclusivityRuleInput 创建或更新排他规则的输入，更新时整体替换
+type ExclusivityRuleInput struct {
+	Category    string `json:"category" binding:"required,max=50"`
+	Kind        string `json:"kind" binding:"required"`
+	MaxAds      int    `json:"max_ads"`       // limit 规则允许的广告数，至少为 1
+	SponsorAdID *uint  `json:"sponsor_ad_id"` // sponsor 规则的赞助广告，其类别必须与规则相同
+}
+
+// ExclusivityController 处理大厦广告类别排他规则的请求
+type ExclusivityController struct {
+	store repository.Store
+}
+
+// NewExclusivityController 创建 ExclusivityController
+func NewExclusivityController(store repository.Store) *ExclusivityController {
+	return &ExclusivityController{store: store}
+}
+
+// ListExclusivityRules 获取大厦的全部排他规则，按类别排序
+func (ctl *ExclusivityController) ListExclusivityRules(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, err := ctl.store.Buildings().Get(ctx, id); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return
+	}
+	rules, err := ctl.store.Exclusivity().ListByBuildings(ctx, []uint{id})
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": rules})
+}
+
+// CreateExclusivityRule 为大厦创建排他规则，大厦现有的投放违反该规则时返回 409
+func (ctl *ExclusivityController) CreateExclusivityRule(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input ExclusivityRuleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	rule := models.ExclusivityRule{BuildingID: id}
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		if _, err := tx.Buildings().Get(ctx, id); err != nil {
+			return notFound(err, apierror.CodeBuildingNotFound)
+		}
+		if err := input.apply(ctx, tx, &rule); err != nil {
+			return err
+		}
+		if err := tx.Exclusivity().Create(ctx, &rule); err != nil {
+			if errors.Is(err, repository.ErrDuplicate) {
+				return apierror.Conflict(apierror.CodeRuleExists).WithMeta("category", rule.Category)
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "创建排他规则", "id", rule.ID, "building_id", id, "category", rule.Category, "kind", rule.Kind)
+	c.JSON(http.StatusCreated, rule)
+}
+
+// UpdateExclusivityRule 替换大厦的排他规则，大厦现有的投放违反新规则时返回 409
+func (ctl *ExclusivityController) UpdateExclusivityRule(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	ruleID, ok := paramID(c, "rule_id")
+	if !ok {
+		return
+	}
+	var input ExclusivityRuleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	var rule *models.ExclusivityRule
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		var err error
+		if rule, err = tx.Exclusivity().Get(ctx, id, ruleID); err != nil {
+			return notFound(err, apierror.CodeRuleNotFound)
+		}
+		if err := input.apply(ctx, tx, rule); err != nil {
+			return err
+		}
+		if err := tx.Exclusivity().Save(ctx, rule); err != nil {
+			if errors.Is(err, repository.ErrDuplicate) {
+				return apierror.Conflict(apierror.CodeRuleExists).WithMeta("category", rule.Category)
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+// DeleteExclusivityRule 删除大厦的排他规则
+func (ctl *ExclusivityController) DeleteExclusivityRule(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	ruleID, ok := paramID(c, "rule_id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := ctl.store.Exclusivity().Delete(ctx, id, ruleID); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeRuleNotFound))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "删除排他规则", "id", ruleID, "building_id", id)
+	c.JSON(http.StatusOK, gin.H{"message": "排他规则已删除"})
+}
+
+// apply 校验输入并写入规则：类型有效，limit 规则的广告数至少为 1，
+// sponsor 规则的赞助广告存在且属于该类别；随后用大厦现有的投放检查规则
+func (input ExclusivityRuleInput) apply(ctx context.Context, tx repository.Store, rule *models.ExclusivityRule) error {
+	category := exclusivity.NormalizeCategory(input.Category)
+	apiErr := requireNonBlank("category", category)
+	switch input.Kind {
+	case models.ExclusivityLimit:
+		if input.MaxAds < 1 {
+			apiErr.WithDetails(apierror.Field("max_ads", "gte", map[string]any{"param": 1}))
+		}
+		rule.MaxAds, rule.SponsorAdID = input.MaxAds, nil
+	case models.ExclusivitySponsor:
+		if input.SponsorAdID == nil {
+			apiErr.WithDetails(apierror.Field("sponsor_ad_id", "required", nil))
+			break
+		}
+		sponsor, err := tx.Ads().Get(ctx, *input.SponsorAdID)
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			apiErr.WithDetails(apierror.Field("sponsor_ad_id", "not_found", map[string]any{"value": *input.SponsorAdID}))
+		case err != nil:
+			return err
+		case category != "" && sponsor.Category != category:
+			apiErr.WithDetails(apierror.Field("sponsor_ad_id", "category_mismatch", map[string]any{"param": category}))
+		}
+		rule.MaxAds, rule.SponsorAdID = 1, input.SponsorAdID
+	default:
+		apiErr.WithDetails(apierror.Field("kind", "oneof",
+			map[string]any{"param": strings.Join(models.ExclusivityKinds, " ")}))
+	}
+	if len(apiErr.Details) > 0 {
+		return apiErr
+	}
+	rule.Category = category
+	rule.Kind = input.Kind
+
+	if err := tx.Buildings().Lock(ctx, []uint{rule.BuildingID}); err != nil {
+		return err
+	}
+	ads, err := buildingAds(ctx, tx, rule.BuildingID, nil)
+	if err != nil {
+		return err
+	}
+	if conflicts := exclusivity.Check(rule.BuildingID, []models.ExclusivityRule{*rule}, ads, nil); len(conflicts) > 0 {
+		return apierror.Conflict(apierror.CodeExclusiveConflict).WithMeta("conflicts", conflicts)
+	}
+	return nil
+}
+
+// checkExclusivity 在事务中检查 buildingIDs 中的大厦投放 pending 广告（新增投放或修改了类别）之后
+// 是否违反排他规则，违反时返回 409 exclusivity_conflict，meta.conflicts 列出违反的规则与涉及的投放
+func checkExclusivity(ctx context.Context, tx repository.Store, buildingIDs []uint, pending []models.Advertisement) error {
+	if !slices.ContainsFunc(pending, func(ad models.Advertisement) bool { return ad.Category != "" }) {
+		return nil
+	}
+	buildingIDs = uniqueIDs(buildingIDs)
+	slices.Sort(buildingIDs)
+	if err := tx.Buildings().Lock(ctx, buildingIDs); err != nil {
+		return err
+	}
+	rules, err := tx.Exclusivity().ListByBuildings(ctx, buildingIDs)
+	if err != nil || len(rules) == 0 {
+		return err
+	}
+
+	rulesByBuilding := make(map[uint][]models.ExclusivityRule)
+	for _, rule := range rules {
+		rulesByBuilding[rule.BuildingID] = append(rulesByBuilding[rule.BuildingID], rule)
+	}
+	pendingIDs := adIDsOf(pending)
+	conflicts := []exclusivity.Conflict{}
+	for _, buildingID := range buildingIDs {
+		if len(rulesByBuilding[buildingID]) == 0 {
+			continue
+		}
+		ads, err := buildingAds(ctx, tx, buildingID, pending)
+		if err != nil {
+			return err
+		}
+		conflicts = append(conflicts, exclusivity.Check(buildingID, rulesByBuilding[buildingID], ads, pendingIDs)...)
+	}
+	if len(conflicts) > 0 {
+		return apierror.Conflict(apierror.CodeExclusiveConflict).WithMeta("conflicts", conflicts)
+	}
+	return nil
+}
+
+// buildingAds 返回大厦已投放的广告加上 pending 中的广告，pending 中的广告覆盖同 ID 的已保存版本
+func buildingAds(ctx context.Context, tx repository.Store, buildingID uint, pending []models.Advertisement) ([]models.Advertisement, error) {
+	placements, err := tx.Placements().ListByBuilding(ctx, buildingID)
+	if err != nil {
+		return nil, err
+	}
+	saved, err := tx.Ads().FindByIDs(ctx, adIDsOfPlacements(placements))
+	if err != nil {
+		return nil, err
+	}
+	ads := slices.Clone(pending)
+	for _, ad := range saved {
+		if !slices.Contains(adIDsOf(pending), ad.ID) {
+			ads = append(ads, ad)
+		}
+	}
+	return ads, nil
+}
+
+// buildingIDsOfPlacements 返回投放记录中的大厦 ID
+func buildingIDsOfPlacements(placements []models.AdvertisementBuilding) []uint {
+	ids := make([]uint, 0, len(placements))
+	for _, placement := range placements {
+		ids = append(ids, placement.BuildingID)
+	}
+	return ids
+}
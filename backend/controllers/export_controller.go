@@ -0,0 +1,214 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/documents"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/region"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/10240418/advertisement-management-system/backend/spreadsheet"
+	"github.com/gin-gonic/gin"
+)
+
+// ExportController 处理广告、大厦、投放矩阵及发票的导出请求
+type ExportController struct {
+	store   repository.Store
+	regions *region.Resolver
+}
+
+// NewExportController 创建 ExportController
+func NewExportController(store repository.Store, regions *region.Resolver) *ExportController {
+	return &ExportController{store: store, regions: regions}
+}
+
+// ExportAds 导出所有广告（format=csv|xlsx）
+func (ctl *ExportController) ExportAds(c *gin.Context) {
+	streamExport(c, "advertisements", []interface{}{"id", "title", "description", "image_url", "video_url", "video_duration", "status", "created_at", "updated_at"},
+		func(ctx context.Context, write func(values ...interface{}) error) error {
+			return ctl.store.Ads().Each(ctx, func(ad models.Advertisement) error {
+				return write(ad.ID, ad.Title, ad.Description, ad.ImageURL, ad.VideoURL, ad.VideoDuration, ad.Status,
+					ad.CreatedAt.Format(time.RFC3339), ad.UpdatedAt.Format(time.RFC3339))
+			})
+		})
+}
+
+// ExportBuildings 导出所有大厦（format=csv|xlsx），时间按大厦所在时区输出
+func (ctl *ExportController) ExportBuildings(c *gin.Context) {
+	streamExport(c, "buildings", []interface{}{"id", "name", "address", "blg_id", "timezone", "locale", "tier", "created_at", "updated_at"},
+		func(ctx context.Context, write func(values ...interface{}) error) error {
+			return ctl.store.Buildings().Each(ctx, func(building models.Building) error {
+				loc := ctl.regions.Location(&building)
+				return write(building.ID, building.Name, building.Address, building.BuildingID, building.Timezone, building.Locale, building.Tier,
+					building.CreatedAt.In(loc).Format(time.RFC3339), building.UpdatedAt.In(loc).Format(time.RFC3339))
+			})
+		})
+}
+
+// ExportPlacements 导出广告与大厦的投放矩阵及播放时长（format=csv|xlsx）
+func (ctl *ExportController) ExportPlacements(c *gin.Context) {
+	streamExport(c, "placements", []interface{}{"advertisement_id", "advertisement_title", "advertisement_status", "video_duration", "building_id", "building_name", "blg_id", "address", "play_duration"},
+		func(ctx context.Context, write func(values ...interface{}) error) error {
+			return ctl.store.Placements().EachDetail(ctx, func(row repository.PlacementDetail) error {
+				return write(row.AdvertisementID, row.AdvertisementTitle, row.AdvertisementStatus, row.VideoDuration,
+					row.BuildingID, row.BuildingName, row.BlgID, row.Address, row.PlayDuration)
+			})
+		})
+}
+
+// ExportAdCertificate 生成单个广告的投放证明 PDF
+func (ctl *ExportController) ExportAdCertificate(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ad, err := ctl.store.Ads().GetWithPlacements(c.Request.Context(), id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeAdNotFound))
+		return
+	}
+
+	cert := documents.PlacementCertificate{
+		AdvertisementID: ad.ID,
+		Title:           ad.Title,
+		Status:          ad.Status,
+		VideoDuration:   ad.VideoDuration,
+		CreatedAt:       ad.CreatedAt,
+		GeneratedAt:     time.Now(),
+	}
+	for _, ab := range ad.AdvertisementBuildings {
+		cert.Placements = append(cert.Placements, documents.CertificatePlacement{
+			BuildingID:   ab.BuildingID,
+			BuildingName: ab.Building.Name,
+			BlgID:        ab.Building.BuildingID,
+			Address:      ab.Building.Address,
+			PlayDuration: ab.PlayDuration,
+		})
+	}
+
+	// PDF 体积较小，先在内存中生成，避免失败时已写出部分响应
+	var buf bytes.Buffer
+	if err := documents.WritePlacementCertificate(&buf, cert); err != nil {
+		apierror.Respond(c, apierror.Internal(fmt.Errorf("生成投放证明失败: %w", err)))
+		return
+	}
+
+	filename := fmt.Sprintf("placement-certificate-%d.pdf", ad.ID)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
+
+// ExportInvoice 导出单张发票（format=csv|xlsx|pdf），表格格式每栋大厦一行
+func (ctl *ExportController) ExportInvoice(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	format := c.DefaultQuery("format", "csv")
+	if _, err := spreadsheet.ParseFormat(format); err != nil && format != "pdf" {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeUnsupportedFormat).
+			WithDetails(apierror.Field("format", "oneof", map[string]any{"param": "csv xlsx pdf"})))
+		return
+	}
+
+	invoice, err := ctl.store.Invoices().Get(c.Request.Context(), id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeInvoiceNotFound))
+		return
+	}
+
+	if format != "pdf" {
+		streamExport(c, fmt.Sprintf("invoice-%d", invoice.ID),
+			[]interface{}{"invoice_id", "advertisement_id", "period_start", "period_end", "currency", "building_id", "building_name", "tier", "timezone", "play_duration", "hours", "seconds", "amount"},
+			func(ctx context.Context, write func(values ...interface{}) error) error {
+				for _, line := range invoice.Lines {
+					if err := write(invoice.ID, invoice.AdvertisementID, invoice.PeriodStart, invoice.PeriodEnd, invoice.Currency,
+						line.BuildingID, line.BuildingName, line.Tier, line.Timezone, line.PlayDuration, line.Hours, line.Seconds, line.Amount); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		return
+	}
+
+	doc := documents.Invoice{
+		ID:                 invoice.ID,
+		AdvertisementID:    invoice.AdvertisementID,
+		AdvertisementTitle: invoice.AdvertisementTitle,
+		RateCardName:       invoice.RateCardName,
+		Currency:           invoice.Currency,
+		PeriodStart:        invoice.PeriodStart,
+		PeriodEnd:          invoice.PeriodEnd,
+		Status:             invoice.Status,
+		Total:              invoice.Total,
+		IssuedAt:           invoice.CreatedAt,
+		VoidedAt:           invoice.VoidedAt,
+	}
+	for _, line := range invoice.Lines {
+		doc.Lines = append(doc.Lines, documents.InvoiceLine{
+			BuildingID:   line.BuildingID,
+			BuildingName: line.BuildingName,
+			Tier:         line.Tier,
+			PlayDuration: line.PlayDuration,
+			Hours:        line.Hours,
+			Seconds:      line.Seconds,
+			Amount:       line.Amount,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := documents.WriteInvoice(&buf, doc); err != nil {
+		apierror.Respond(c, apierror.Internal(fmt.Errorf("生成发票失败: %w", err)))
+		return
+	}
+
+	filename := fmt.Sprintf("invoice-%d.pdf", invoice.ID)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
+
+// streamExport 设置下载响应头，并将 query 写出的行逐行输出为 CSV 或 XLSX
+func streamExport(c *gin.Context, name string, header []interface{}, query func(ctx context.Context, write func(values ...interface{}) error) error) {
+	format, err := spreadsheet.ParseFormat(c.DefaultQuery("format", "csv"))
+	if err != nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeUnsupportedFormat).
+			WithDetails(apierror.Field("format", "oneof", map[string]any{"param": "csv xlsx"})))
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().Format("20060102-150405"), format)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Content-Type", spreadsheet.ContentType(format))
+	c.Status(http.StatusOK)
+
+	writer, err := spreadsheet.NewRowWriter(format, c.Writer, name)
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("创建导出写入器失败", slog.Any("error", err))
+		return
+	}
+	// 出错提前返回时也要释放 XLSX 的临时数据，成功时下方的 Close 已完成写入，这里不再重复
+	defer writer.Close()
+	if err := writer.WriteRow(header...); err != nil {
+		logging.FromContext(c.Request.Context()).Error("写入导出表头失败", slog.String("export", name), slog.Any("error", err))
+		return
+	}
+
+	// 响应头已发送，此后出错只能记录日志并中断输出
+	if err := query(c.Request.Context(), writer.WriteRow); err != nil {
+		logging.FromContext(c.Request.Context()).Error("导出失败", slog.String("export", name), slog.Any("error", err))
+		c.Abort()
+		return
+	}
+	if err := writer.Close(); err != nil {
+		logging.FromContext(c.Request.Context()).Error("完成导出失败", slog.String("export", name), slog.Any("error", err))
+	}
+}
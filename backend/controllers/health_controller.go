@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// 就绪检查访问数据库的超时时间
+const readinessTimeout = 2 * time.Second
+
+// HealthController 处理存活与就绪检查
+type HealthController struct {
+	store    repository.Store
+	draining <-chan struct{}
+}
+
+// NewHealthController 创建 HealthController，draining 关闭后就绪检查返回 503，
+// 使负载均衡在优雅关闭期间不再转发新请求
+func NewHealthController(store repository.Store, draining <-chan struct{}) *HealthController {
+	return &HealthController{store: store, draining: draining}
+}
+
+// Healthz 存活检查，进程能处理请求即返回 200
+func (ctl *HealthController) Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readyz 就绪检查，服务正在关闭或数据库连接池不可用时返回 503
+func (ctl *HealthController) Readyz(c *gin.Context) {
+	select {
+	case <-ctl.draining:
+		c.JSON(http.StatusServiceUnavailable, gin.H{"status": "draining"})
+		return
+	default:
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), readinessTimeout)
+	defer cancel()
+
+	// 接口无需认证，错误详情可能包含数据库地址与用户名，只记录在日志中
+	if err := ctl.store.Ping(ctx); err != nil {
+		logging.FromContext(c.Request.Context()).Error("就绪检查失败：数据库不可用", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status": "unavailable",
+			"checks": gin.H{"database": "unavailable"},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"status": "ok",
+		"checks": gin.H{"database": "ok"},
+	})
+}
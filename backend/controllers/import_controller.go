@@ -0,0 +1,307 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/billing"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/10240418/advertisement-management-system/backend/spreadsheet"
+	"github.com/10240418/advertisement-management-system/backend/webhooks"
+	"github.com/gin-gonic/gin"
+)
+
+// 导入文件的最大体积（10MB）
+const maxImportFileSize = 10 << 20
+
+// BuildingImportRow 表示导入文件中的一行大厦数据及其校验结果
+type BuildingImportRow struct {
+	Row              int                   `json:"row"` // 文件中的行号，从 1 开始，包含表头
+	Name             string                `json:"name"`
+	Address          string                `json:"address"`
+	BuildingID       string                `json:"blg_id"`
+	Timezone         string                `json:"timezone,omitempty"`
+	Locale           string                `json:"locale,omitempty"`
+	Tier             string                `json:"tier,omitempty"`
+	AdvertisementIDs []uint                `json:"advertisement_ids"`
+	Errors           []apierror.FieldError `json:"errors,omitempty"`
+}
+
+// BuildingImportReport 导入的校验报告
+type BuildingImportReport struct {
+	DryRun    bool                `json:"dry_run"`
+	Committed bool                `json:"committed"`
+	Total     int                 `json:"total"`
+	Valid     int                 `json:"valid"`
+	Invalid   int                 `json:"invalid"`
+	Rows      []BuildingImportRow `json:"rows"`
+}
+
+// ImportBuildings 通过 CSV 或 XLSX 文件批量导入大厦
+// 默认仅做校验（dry_run=true），dry_run=false 且全部行校验通过时才在同一事务中写入
+func (ctl *BuildingController) ImportBuildings(c *gin.Context) {
+	dryRun := c.DefaultQuery("dry_run", "true") != "false"
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportFileSize)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeFileRequired).With("field", "file"))
+		return
+	}
+
+	format, err := spreadsheet.FormatFromFilename(fileHeader.Filename)
+	if err != nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeUnsupportedFormat))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeFileUnreadable).WithCause(err))
+		return
+	}
+	defer file.Close()
+
+	records, err := spreadsheet.ReadRows(format, file)
+	if err != nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeFileInvalid).WithCause(err))
+		return
+	}
+
+	rows, err := parseBuildingImportRows(records)
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := validateBuildingImportRows(ctx, ctl.store, rows); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	report := newBuildingImportReport(rows, dryRun, apierror.Language(c))
+	if dryRun {
+		c.JSON(http.StatusOK, report)
+		return
+	}
+	if report.Invalid > 0 {
+		apierror.Respond(c, apierror.Unprocessable(apierror.CodeImportInvalid).WithMeta("report", report))
+		return
+	}
+
+	if err := commitBuildingImport(ctx, ctl.store, rows); err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	report.Committed = true
+	c.JSON(http.StatusCreated, report)
+}
+
+// parseBuildingImportRows 将表格行解析为导入数据，第一行必须是表头
+func parseBuildingImportRows(records [][]string) ([]BuildingImportRow, error) {
+	if len(records) == 0 {
+		return nil, apierror.BadRequest(apierror.CodeImportEmpty)
+	}
+
+	index := spreadsheet.HeaderIndex(records[0])
+	if _, ok := index["name"]; !ok {
+		return nil, apierror.BadRequest(apierror.CodeImportMissingColumn).With("column", "name")
+	}
+
+	var rows []BuildingImportRow
+	for i, record := range records[1:] {
+		// 跳过完全空白的行
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		row := BuildingImportRow{
+			Row:        i + 2,
+			Name:       spreadsheet.Cell(record, index, "name"),
+			Address:    spreadsheet.Cell(record, index, "address"),
+			BuildingID: spreadsheet.Cell(record, index, "blg_id"),
+			Timezone:   spreadsheet.Cell(record, index, "timezone"),
+			Tier:       billing.NormalizeTier(spreadsheet.Cell(record, index, "tier")),
+		}
+
+		// 时区、语言与计费等级为可选列，语言标签与等级保存为规范写法
+		if apiErr := validTimezone(row.Timezone); apiErr != nil {
+			row.Errors = append(row.Errors, apiErr.Details...)
+		}
+		locale, apiErr := validLocale(spreadsheet.Cell(record, index, "locale"))
+		if apiErr != nil {
+			row.Errors = append(row.Errors, apiErr.Details...)
+			locale = spreadsheet.Cell(record, index, "locale")
+		}
+		row.Locale = locale
+		if len(row.Tier) > 50 {
+			row.Errors = append(row.Errors, apierror.Field("tier", "max", map[string]any{"param": 50}))
+		}
+
+		ids, invalid := parseIDList(spreadsheet.Cell(record, index, "advertisement_ids", "ad_ids"))
+		if invalid != "" {
+			row.Errors = append(row.Errors, apierror.Field("advertisement_ids", "invalid", map[string]any{"value": invalid}))
+		}
+		row.AdvertisementIDs = ids
+
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, apierror.BadRequest(apierror.CodeImportEmpty)
+	}
+	return rows, nil
+}
+
+// parseIDList 解析以分号、竖线或逗号分隔的 ID 列表，遇到无效 ID 时返回该值
+func parseIDList(value string) ([]uint, string) {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ';' || r == '|' || r == ',' || r == ' '
+	})
+
+	var ids []uint
+	seen := make(map[uint]struct{}, len(fields))
+	for _, field := range fields {
+		id, err := strconv.ParseUint(field, 10, 64)
+		if err != nil || id == 0 {
+			return nil, field
+		}
+		// 同一行重复的 ID 只关联一次
+		if _, ok := seen[uint(id)]; ok {
+			continue
+		}
+		seen[uint(id)] = struct{}{}
+		ids = append(ids, uint(id))
+	}
+	return ids, ""
+}
+
+// validateBuildingImportRows 校验必填字段、文件内重复、与已有大厦重名以及广告 ID 是否存在
+func validateBuildingImportRows(ctx context.Context, store repository.Store, rows []BuildingImportRow) error {
+	seen := make(map[string]int)
+	var names []string
+	adIDSet := make(map[uint]struct{})
+
+	for i := range rows {
+		row := &rows[i]
+		if row.Name == "" {
+			row.Errors = append(row.Errors, apierror.Field("name", "required", nil))
+			continue
+		}
+		if first, ok := seen[row.Name]; ok {
+			row.Errors = append(row.Errors, apierror.Field("name", "duplicate", map[string]any{"row": first}))
+			continue
+		}
+		seen[row.Name] = row.Row
+		names = append(names, row.Name)
+
+		for _, id := range row.AdvertisementIDs {
+			adIDSet[id] = struct{}{}
+		}
+	}
+
+	// 检查与数据库中已有大厦的重名（Name 唯一）
+	existingNames := make(map[string]struct{})
+	if len(names) > 0 {
+		existing, err := store.Buildings().ExistingNames(ctx, names)
+		if err != nil {
+			return err
+		}
+		for _, name := range existing {
+			existingNames[name] = struct{}{}
+		}
+	}
+
+	// 检查广告 ID 是否存在
+	existingAds := make(map[uint]struct{})
+	if len(adIDSet) > 0 {
+		adIDs := make([]uint, 0, len(adIDSet))
+		for id := range adIDSet {
+			adIDs = append(adIDs, id)
+		}
+		found, err := store.Ads().FindByIDs(ctx, adIDs)
+		if err != nil {
+			return err
+		}
+		for _, ad := range found {
+			existingAds[ad.ID] = struct{}{}
+		}
+	}
+
+	for i := range rows {
+		row := &rows[i]
+		if _, ok := existingNames[row.Name]; ok {
+			row.Errors = append(row.Errors, apierror.Field("name", "taken", nil))
+		}
+		for _, id := range row.AdvertisementIDs {
+			if _, ok := existingAds[id]; !ok {
+				row.Errors = append(row.Errors, apierror.Field("advertisement_ids", "not_found", map[string]any{"value": id}))
+			}
+		}
+	}
+	return nil
+}
+
+// newBuildingImportReport 汇总校验结果，行错误信息按 lang 本地化
+func newBuildingImportReport(rows []BuildingImportRow, dryRun bool, lang apierror.Lang) BuildingImportReport {
+	report := BuildingImportReport{DryRun: dryRun, Total: len(rows), Rows: rows}
+	for i := range rows {
+		row := &rows[i]
+		row.Errors = apierror.LocalizeFields(lang, row.Errors)
+		if len(row.Errors) > 0 {
+			report.Invalid++
+		} else {
+			report.Valid++
+		}
+	}
+	return report
+}
+
+// commitBuildingImport 在同一事务中创建所有大厦及其广告关联
+func commitBuildingImport(ctx context.Context, store repository.Store, rows []BuildingImportRow) error {
+	return store.Transaction(ctx, func(tx repository.Store) error {
+		for _, row := range rows {
+			building := models.Building{
+				Name:       row.Name,
+				Address:    row.Address,
+				BuildingID: row.BuildingID,
+				Timezone:   row.Timezone,
+				Locale:     row.Locale,
+				Tier:       row.Tier,
+			}
+			if err := tx.Buildings().Create(ctx, &building); err != nil {
+				// 校验后其他请求可能已创建同名大厦
+				if errors.Is(err, repository.ErrDuplicate) {
+					return apierror.Conflict(apierror.CodeBuildingNameTaken).WithMeta("name", row.Name)
+				}
+				return err
+			}
+
+			ads, err := tx.Ads().FindByIDs(ctx, row.AdvertisementIDs)
+			if err != nil {
+				return err
+			}
+
+			// 创建 AdvertisementBuilding 关联记录
+			events := make([]webhooks.Event, 0, len(ads))
+			for _, ad := range ads {
+				placement, err := createPlacement(ctx, tx, ad, building.ID)
+				if err != nil {
+					return err
+				}
+				events = append(events, webhooks.PlacementEvent(models.EventPlacementCreated, *placement))
+			}
+			if err := webhooks.Emit(ctx, tx, events...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
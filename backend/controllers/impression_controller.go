@@ -0,0 +1,286 @@
+package controllers
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/dayparting"
+	"github.com/10240418/advertisement-management-system/backend/impressions"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/region"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// 曝光报表与人流量查询的默认天数，以及报表与计费期的最长天数
+const (
+	defaultReportDays = 7
+	maxReportDays     = 92
+)
+
+// ImpressionDay 当地一天的人流量与估算曝光数
+type ImpressionDay struct {
+	Date        string `json:"date"`
+	Hours       int    `json:"hours"` // 上传了人流量的小时数
+	Visitors    int64  `json:"visitors"`
+	Impressions int64  `json:"impressions"`
+}
+
+// AdImpressionDay 广告在当地一天的估算曝光数
+type AdImpressionDay struct {
+	Date        string `json:"date"`
+	Impressions int64  `json:"impressions"`
+}
+
+// AdImpressions 广告在大厦中的估算曝光数
+type AdImpressions struct {
+	AdvertisementID uint              `json:"advertisement_id"`
+	Title           string            `json:"title"`
+	Impressions     int64             `json:"impressions"`
+	Days            []AdImpressionDay `json:"days"`
+}
+
+// BuildingImpressions 广告在一栋大厦中的估算曝光数，各天的 visitors 为大厦的人流量
+type BuildingImpressions struct {
+	BuildingID  uint            `json:"building_id"`
+	Name        string          `json:"name"`
+	Timezone    string          `json:"timezone"`
+	Impressions int64           `json:"impressions"`
+	Days        []ImpressionDay `json:"days"`
+}
+
+// ImpressionController 按大厦人流量估算广告曝光数的报表
+type ImpressionController struct {
+	store        repository.Store
+	engine       *dayparting.Engine
+	loopCapacity time.Duration
+}
+
+// NewImpressionController 创建 ImpressionController，loopCapacity 与播放预览相同
+func NewImpressionController(store repository.Store, engine *dayparting.Engine, loopCapacity time.Duration) *ImpressionController {
+	return &ImpressionController{store: store, engine: engine, loopCapacity: loopCapacity}
+}
+
+// GetBuildingImpressions 估算大厦在 from、to 两个当地日期之间（包含两端）每天的曝光数及各广告的曝光数
+func (ctl *ImpressionController) GetBuildingImpressions(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	building, err := ctl.store.Buildings().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return
+	}
+	settings := ctl.engine.Region(building)
+	from, to, apiErr := reportDates(c, settings.Location, time.Now())
+	if apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	days, err := ctl.estimate(ctx, building, settings.Location, from, to)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	placements, err := ctl.store.Placements().ListByBuilding(ctx, id)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	ads, err := ctl.store.Ads().FindByIDs(ctx, adIDsOfPlacements(placements))
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	var visitors, total int64
+	daily := make([]ImpressionDay, len(days))
+	for i, day := range days {
+		daily[i] = impressionDay(day, 0)
+		visitors += day.Visitors
+		total += daily[i].Impressions
+	}
+	byAd := make([]AdImpressions, 0, len(ads))
+	for _, ad := range ads {
+		report := AdImpressions{AdvertisementID: ad.ID, Title: ad.Title, Days: make([]AdImpressionDay, len(days))}
+		for i, day := range days {
+			report.Days[i] = AdImpressionDay{Date: day.Date, Impressions: round(day.Impressions[ad.ID])}
+			report.Impressions += report.Days[i].Impressions
+		}
+		byAd = append(byAd, report)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"building_id": id,
+		"timezone":    settings.Timezone,
+		"from":        from,
+		"to":          to,
+		"visitors":    visitors,
+		"impressions": total,
+		"days":        daily,
+		"ads":         byAd,
+	})
+}
+
+// GetAdImpressions 估算广告在各投放大厦中 from、to 两个日期之间（包含两端）每天的曝光数，
+// 日期按各大厦所在时区计算
+func (ctl *ImpressionController) GetAdImpressions(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	ad, err := ctl.store.Ads().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeAdNotFound))
+		return
+	}
+	placements, err := ctl.store.Placements().ListByAdvertisement(ctx, id)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	buildings, err := ctl.store.Buildings().FindByIDs(ctx, buildingIDsOfPlacements(placements))
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	// 未指定日期时，默认范围按第一栋大厦的时区计算，各大厦使用相同的当地日期
+	var loc *time.Location
+	if len(buildings) > 0 {
+		loc = ctl.engine.Region(&buildings[0]).Location
+	} else {
+		loc = ctl.engine.Region(nil).Location
+	}
+	from, to, apiErr := reportDates(c, loc, time.Now())
+	if apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	var total int64
+	reports := make([]BuildingImpressions, 0, len(buildings))
+	for i := range buildings {
+		building := &buildings[i]
+		settings := ctl.engine.Region(building)
+		days, err := ctl.estimate(ctx, building, settings.Location, from, to)
+		if err != nil {
+			apierror.Respond(c, apierror.Internal(err))
+			return
+		}
+		report := BuildingImpressions{BuildingID: building.ID, Name: building.Name, Timezone: settings.Timezone, Days: make([]ImpressionDay, len(days))}
+		for j, day := range days {
+			report.Days[j] = impressionDay(day, ad.ID)
+			report.Impressions += report.Days[j].Impressions
+		}
+		total += report.Impressions
+		reports = append(reports, report)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"advertisement_id": ad.ID,
+		"title":            ad.Title,
+		"from":             from,
+		"to":               to,
+		"impressions":      total,
+		"buildings":        reports,
+	})
+}
+
+// estimate 按大厦的人流量与当前投放估算 from、to 之间每个当地日期的曝光数
+func (ctl *ImpressionController) estimate(ctx context.Context, building *models.Building, loc *time.Location, from, to string) ([]impressions.Day, error) {
+	start, end := dateBounds(from, to, loc)
+	counts, err := ctl.store.Traffic().List(ctx, building.ID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	zones, placements, err := playZones(ctx, ctl.store, building)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := ctl.engine.Prepare(ctx, placements)
+	if err != nil {
+		return nil, err
+	}
+	hours := impressions.Estimate(plan, zones, counts, ctl.loopCapacity, loc)
+	return impressions.Daily(hours, region.Days(start, end, loc)), nil
+}
+
+// impressionDay 将一天的估算结果转换为响应，adID 为 0 时汇总全部广告
+func impressionDay(day impressions.Day, adID uint) ImpressionDay {
+	result := ImpressionDay{Date: day.Date, Hours: day.Hours, Visitors: day.Visitors}
+	if adID != 0 {
+		result.Impressions = round(day.Impressions[adID])
+		return result
+	}
+	var sum float64
+	for _, value := range day.Impressions {
+		sum += value
+	}
+	result.Impressions = round(sum)
+	return result
+}
+
+// round 将估算的曝光数四舍五入为整数
+func round(value float64) int64 {
+	return int64(math.Round(value))
+}
+
+// reportDates 解析查询参数 from、to（YYYY-MM-DD 当地日期，包含两端），默认为截至今天的最近 7 天
+func reportDates(c *gin.Context, loc *time.Location, now time.Time) (string, string, *apierror.Error) {
+	apiErr := apierror.BadRequest(apierror.CodeValidationFailed)
+	today := region.DayOf(now, loc).Start
+	to := today
+	if value := c.Query("to"); value != "" {
+		if t, err := time.ParseInLocation(models.DateLayout, value, loc); err == nil {
+			to = t
+		} else {
+			apiErr.WithDetails(apierror.Field("to", "date", nil))
+		}
+	}
+	from := to.AddDate(0, 0, 1-defaultReportDays)
+	if value := c.Query("from"); value != "" {
+		if t, err := time.ParseInLocation(models.DateLayout, value, loc); err == nil {
+			from = t
+		} else {
+			apiErr.WithDetails(apierror.Field("from", "date", nil))
+		}
+	}
+	if len(apiErr.Details) > 0 {
+		return "", "", apiErr
+	}
+
+	if apiErr := checkDateRange("from", "to", from, to); apiErr != nil {
+		return "", "", apiErr
+	}
+	return from.Format(models.DateLayout), to.Format(models.DateLayout), nil
+}
+
+// checkDateRange 校验包含两端的日期范围：to 不早于 from，且最多 maxReportDays 天
+func checkDateRange(fromField, toField string, from, to time.Time) *apierror.Error {
+	switch {
+	case to.Before(from):
+		return apierror.BadRequest(apierror.CodeValidationFailed).
+			WithDetails(apierror.Field(toField, "not_before", map[string]any{"param": fromField}))
+	case !to.Before(from.AddDate(0, 0, maxReportDays)):
+		return apierror.BadRequest(apierror.CodeValidationFailed).
+			WithDetails(apierror.Field(toField, "max_days", map[string]any{"param": fromField, "days": maxReportDays}))
+	}
+	return nil
+}
+
+// dateBounds 返回 from、to 两个当地日期（包含两端）覆盖的时间范围 [start, end)
+func dateBounds(from, to string, loc *time.Location) (time.Time, time.Time) {
+	start, _ := time.ParseInLocation(models.DateLayout, from, loc)
+	end, _ := time.ParseInLocation(models.DateLayout, to, loc)
+	return start, region.DayOf(end, loc).End
+}
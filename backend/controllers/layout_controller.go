@@ -0,0 +1,446 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/dayparting"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/preview"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/10240418/advertisement-management-system/backend/webhooks"
+	"github.com/gin-gonic/gin"
+)
+
+// LayoutInput 创建或更新屏幕布局的输入，更新时整体替换
+type LayoutInput struct {
+	Name        string              `json:"name" binding:"required,max=100"`
+	Description string              `json:"description" binding:"max=500"`
+	Width       int                 `json:"width" binding:"required,gt=0"`
+	Height      int                 `json:"height" binding:"required,gt=0"`
+	Zones       []models.LayoutZone `json:"zones" binding:"required"`
+}
+
+// AssignLayoutInput 设置大厦屏幕布局的输入，layout_id 为 null 时恢复全屏播放
+type AssignLayoutInput struct {
+	LayoutID *uint `json:"layout_id"`
+}
+
+// UpdatePlacementZoneInput 设置广告在大厦布局中所属区域的输入，zone 为空表示默认媒体区域
+type UpdatePlacementZoneInput struct {
+	Zone string `json:"zone" binding:"max=50"`
+}
+
+// ResolvedZone 大厦布局中的一个区域及其播放内容
+type ResolvedZone struct {
+	models.LayoutZone
+	Items []ZoneItem `json:"items"`
+}
+
+// ZoneItem 区域中播放的一条广告，滚动字幕区域只返回文字
+type ZoneItem struct {
+	AdvertisementID uint   `json:"advertisement_id"`
+	Title           string `json:"title"`
+	Description     string `json:"description,omitempty"`
+	ImageURL        string `json:"image_url,omitempty"`
+	VideoURL        string `json:"video_url,omitempty"`
+	Text            string `json:"text,omitempty"`
+	PlayDuration    int64  `json:"play_duration"` // 秒
+}
+
+// LayoutController 管理屏幕布局模板，以及大厦布局与各区域的播放内容
+type LayoutController struct {
+	store  repository.Store
+	engine *dayparting.Engine
+}
+
+// NewLayoutController 创建 LayoutController，engine 用于过滤当前不在分时规则内的广告
+func NewLayoutController(store repository.Store, engine *dayparting.Engine) *LayoutController {
+	return &LayoutController{store: store, engine: engine}
+}
+
+// ListLayouts 分页获取屏幕布局，按名称排序
+func (ctl *LayoutController) ListLayouts(c *gin.Context) {
+	pageNum, pageSize, opts := pagination(c)
+
+	layouts, count, err := ctl.store.Layouts().List(c.Request.Context(), opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":     layouts,
+		"total":    count,
+		"pageNum":  pageNum,
+		"pageSize": pageSize,
+	})
+}
+
+// GetLayout 获取单个屏幕布局
+func (ctl *LayoutController) GetLayout(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	layout, err := ctl.store.Layouts().Get(c.Request.Context(), id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeLayoutNotFound))
+		return
+	}
+
+	c.JSON(http.StatusOK, layout)
+}
+
+// CreateLayout 创建屏幕布局
+func (ctl *LayoutController) CreateLayout(c *gin.Context) {
+	var input LayoutInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	var layout models.Layout
+	if apiErr := input.apply(&layout); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := ctl.store.Layouts().Create(ctx, &layout); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			apierror.Respond(c, apierror.Conflict(apierror.CodeLayoutNameTaken))
+			return
+		}
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "创建屏幕布局", "id", layout.ID, "name", layout.Name, "zones", len(layout.Zones))
+	c.JSON(http.StatusCreated, layout)
+}
+
+// UpdateLayout 替换屏幕布局的画布与区域
+// 被删除区域中的广告在播放时回到默认媒体区域，不需要修改投放记录
+func (ctl *LayoutController) UpdateLayout(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input LayoutInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	layout, err := ctl.store.Layouts().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeLayoutNotFound))
+		return
+	}
+	if apiErr := input.apply(layout); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+	if err := ctl.store.Layouts().Save(ctx, layout); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			apierror.Respond(c, apierror.Conflict(apierror.CodeLayoutNameTaken))
+			return
+		}
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, layout)
+}
+
+// DeleteLayout 删除屏幕布局，仍有大厦使用时返回 409 和这些大厦的 ID
+func (ctl *LayoutController) DeleteLayout(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		buildingIDs, err := tx.Layouts().BuildingIDs(ctx, id)
+		if err != nil {
+			return err
+		}
+		if len(buildingIDs) > 0 {
+			return apierror.Conflict(apierror.CodeLayoutInUse).WithMeta("building_ids", buildingIDs)
+		}
+		if err := tx.Layouts().Delete(ctx, id); err != nil {
+			return notFound(err, apierror.CodeLayoutNotFound)
+		}
+		return nil
+	})
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "删除屏幕布局", "id", id)
+	c.JSON(http.StatusOK, gin.H{"message": "屏幕布局已删除"})
+}
+
+// AssignBuildingLayout 设置或清除大厦使用的屏幕布局
+func (ctl *LayoutController) AssignBuildingLayout(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input AssignLayoutInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	building, err := ctl.store.Buildings().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return
+	}
+	if input.LayoutID != nil {
+		if _, err := ctl.store.Layouts().Get(ctx, *input.LayoutID); err != nil {
+			apierror.Respond(c, notFound(err, apierror.CodeLayoutNotFound))
+			return
+		}
+	}
+
+	building.LayoutID = input.LayoutID
+	if err := ctl.store.Buildings().Save(ctx, building); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, building)
+}
+
+// UpdatePlacementZone 将大厦中的一条广告分配到布局的某个区域
+func (ctl *LayoutController) UpdatePlacementZone(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	adID, ok := paramID(c, "ad_id")
+	if !ok {
+		return
+	}
+	var input UpdatePlacementZoneInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	zone := strings.TrimSpace(input.Zone)
+
+	ctx := c.Request.Context()
+	building, err := ctl.store.Buildings().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return
+	}
+	if zone != "" {
+		layout, err := buildingLayout(ctx, ctl.store, building)
+		if err != nil {
+			apierror.Respond(c, apierror.Internal(err))
+			return
+		}
+		target, ok := layout.Zone(zone)
+		if !ok {
+			apierror.Respond(c, apierror.BadRequest(apierror.CodeUnknownZone).With("zone", zone))
+			return
+		}
+		if target.Kind == models.ZoneWidget {
+			apierror.Respond(c, apierror.BadRequest(apierror.CodeZoneNotPlayable).With("zone", zone))
+			return
+		}
+	}
+
+	placement, err := ctl.store.Placements().Get(ctx, adID, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodePlacementNotFound))
+		return
+	}
+	placement.Zone = zone
+	err = ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Placements().Save(ctx, placement); err != nil {
+			return err
+		}
+		return webhooks.Emit(ctx, tx, webhooks.PlacementEvent(models.EventPlacementUpdated, *placement))
+	})
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, placement)
+}
+
+// GetBuildingLayout 返回大厦的屏幕布局及每个区域的播放内容
+// 未设置布局时使用全屏布局；未指定区域或区域已不存在的广告放入默认媒体区域，
+// 停用的广告以及按分时规则当前不能播放的广告不返回
+func (ctl *LayoutController) GetBuildingLayout(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	building, err := ctl.store.Buildings().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return
+	}
+	layout, err := buildingLayout(ctx, ctl.store, building)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	placements, err := ctl.store.Placements().ListByBuilding(ctx, id)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	ads, err := ctl.store.Ads().FindByIDs(ctx, adIDsOfPlacements(placements))
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	adsByID := make(map[uint]models.Advertisement, len(ads))
+	for _, ad := range ads {
+		adsByID[ad.ID] = ad
+	}
+	plan, err := ctl.engine.Prepare(ctx, placements)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	settings := ctl.engine.Region(building)
+	now := time.Now().In(settings.Location)
+
+	zones := make([]ResolvedZone, len(layout.Zones))
+	index := make(map[string]int, len(layout.Zones))
+	for i, zone := range layout.Zones {
+		zones[i] = ResolvedZone{LayoutZone: zone, Items: []ZoneItem{}}
+		index[zone.Name] = i
+	}
+	for _, placement := range placements {
+		ad, ok := adsByID[placement.AdvertisementID]
+		if !ok || !preview.Check(plan, preview.Entry{Placement: placement, Ad: ad}, now).Eligible {
+			continue
+		}
+		zone, ok := layout.PlayZone(placement.Zone)
+		if !ok {
+			continue
+		}
+		item := ZoneItem{AdvertisementID: ad.ID, Title: ad.Title, PlayDuration: placement.PlayDuration}
+		if zone.Kind == models.ZoneTicker {
+			item.Text = strings.TrimSpace(ad.Title + " " + ad.Description)
+		} else {
+			item.Description = ad.Description
+			item.ImageURL = ad.ImageURL
+			item.VideoURL = ad.VideoURL
+		}
+		i := index[zone.Name]
+		zones[i].Items = append(zones[i].Items, item)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"building_id":  building.ID,
+		"layout_id":    building.LayoutID,
+		"name":         layout.Name,
+		"width":        layout.Width,
+		"height":       layout.Height,
+		"zones":        zones,
+		"timezone":     settings.Timezone,
+		"locale":       settings.Locale,
+		"generated_at": now,
+	})
+}
+
+// buildingLayout 返回大厦使用的布局，未设置时返回全屏布局
+func buildingLayout(ctx context.Context, store repository.Store, building *models.Building) (*models.Layout, error) {
+	if building.LayoutID == nil {
+		layout := models.FullScreenLayout()
+		return &layout, nil
+	}
+	return store.Layouts().Get(ctx, *building.LayoutID)
+}
+
+// apply 校验输入并写入布局：区域名称唯一、类型有效、位于画布之内，且至少有一个媒体区域
+func (input LayoutInput) apply(layout *models.Layout) *apierror.Error {
+	apiErr := requireNonBlank("name", input.Name)
+	names := make(map[string]bool, len(input.Zones))
+	hasMedia := false
+	zones := make([]models.LayoutZone, 0, len(input.Zones))
+	for i, zone := range input.Zones {
+		field := fmt.Sprintf("zones[%d]", i)
+		zone.Name = strings.TrimSpace(zone.Name)
+		switch {
+		case zone.Name == "":
+			apiErr.WithDetails(apierror.Field(field+".name", "required", nil))
+		case len(zone.Name) > 50:
+			apiErr.WithDetails(apierror.Field(field+".name", "max", map[string]any{"param": 50}))
+		case names[zone.Name]:
+			apiErr.WithDetails(apierror.Field(field+".name", "taken", nil))
+		}
+		names[zone.Name] = true
+
+		if !slices.Contains(models.ZoneKinds, zone.Kind) {
+			apiErr.WithDetails(apierror.Field(field+".kind", "oneof",
+				map[string]any{"param": strings.Join(models.ZoneKinds, " ")}))
+		}
+		if zone.Kind == models.ZoneWidget {
+			if !slices.Contains(models.LayoutWidgets, zone.Widget) {
+				apiErr.WithDetails(apierror.Field(field+".widget", "oneof",
+					map[string]any{"param": strings.Join(models.LayoutWidgets, " ")}))
+			}
+		} else {
+			zone.Widget = ""
+		}
+		hasMedia = hasMedia || zone.Kind == models.ZoneMedia
+
+		if zone.Width <= 0 {
+			apiErr.WithDetails(apierror.Field(field+".width", "gt", map[string]any{"param": 0}))
+		}
+		if zone.Height <= 0 {
+			apiErr.WithDetails(apierror.Field(field+".height", "gt", map[string]any{"param": 0}))
+		}
+		if zone.X < 0 || zone.Y < 0 || zone.X+zone.Width > input.Width || zone.Y+zone.Height > input.Height {
+			apiErr.WithDetails(apierror.Field(field, "zone_bounds", nil))
+		}
+		zones = append(zones, zone)
+	}
+	if !hasMedia {
+		apiErr.WithDetails(apierror.Field("zones", "media_zone_required", nil))
+	}
+	if len(apiErr.Details) > 0 {
+		return apiErr
+	}
+
+	layout.Name = strings.TrimSpace(input.Name)
+	layout.Description = input.Description
+	layout.Width = input.Width
+	layout.Height = input.Height
+	layout.Zones = zones
+	return nil
+}
+
+// adIDsOfPlacements 返回投放记录中的广告 ID
+func adIDsOfPlacements(placements []models.AdvertisementBuilding) []uint {
+	ids := make([]uint, 0, len(placements))
+	for _, placement := range placements {
+		ids = append(ids, placement.AdvertisementID)
+	}
+	return ids
+}
@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/manifest"
+	"github.com/10240418/advertisement-management-system/backend/metrics"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// 清单响应头，签名针对响应体的原始字节
+const (
+	ManifestSignatureHeader = "X-Manifest-Signature"
+	ManifestKeyIDHeader     = "X-Manifest-Key-ID"
+	ManifestVersionHeader   = "X-Manifest-Version"
+)
+
+// ManifestController 提供播放端离线缓存使用的签名清单
+type ManifestController struct {
+	store     repository.Store
+	manifests *manifest.Service
+}
+
+// NewManifestController 创建 ManifestController
+func NewManifestController(store repository.Store, manifests *manifest.Service) *ManifestController {
+	return &ManifestController{store: store, manifests: manifests}
+}
+
+// GetBuildingManifest 获取大厦的完整清单，If-None-Match 与当前版本一致时返回 304
+func (ctl *ManifestController) GetBuildingManifest(c *gin.Context) {
+	building, ok := ctl.building(c)
+	if !ok {
+		return
+	}
+
+	current, err := ctl.manifests.Current(c.Request.Context(), building)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	metrics.PlaylistFetched(deviceID(c))
+
+	etag := `"` + current.ContentHash + `"`
+	c.Header("ETag", etag)
+	c.Header(ManifestVersionHeader, strconv.Itoa(current.Version))
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	ctl.writeSigned(c, []byte(current.Body))
+}
+
+// GetBuildingManifestDelta 获取自 since 版本以来新增和移除的素材
+func (ctl *ManifestController) GetBuildingManifestDelta(c *gin.Context) {
+	building, ok := ctl.building(c)
+	if !ok {
+		return
+	}
+	since, err := strconv.Atoi(c.Query("since"))
+	if err != nil || since < 1 {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeValidationFailed).
+			WithDetails(apierror.Field("since", "min", map[string]any{"param": 1})))
+		return
+	}
+
+	body, current, err := ctl.manifests.Delta(c.Request.Context(), building, since)
+	if errors.Is(err, manifest.ErrVersionNotFound) {
+		apierror.Respond(c, apierror.NotFound(apierror.CodeManifestVersion).
+			With("since", since).
+			WithMeta("version", current.Version))
+		return
+	}
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	metrics.PlaylistFetched(deviceID(c))
+
+	c.Header(ManifestVersionHeader, strconv.Itoa(current.Version))
+	ctl.writeSigned(c, body)
+}
+
+// GetManifestPublicKey 获取校验清单签名的公钥
+func (ctl *ManifestController) GetManifestPublicKey(c *gin.Context) {
+	signer := ctl.manifests.Signer()
+	c.JSON(http.StatusOK, gin.H{
+		"algorithm":  "Ed25519",
+		"key_id":     signer.KeyID(),
+		"public_key": signer.PublicKey(),
+	})
+}
+
+// building 读取路径中的大厦，不存在时返回 404
+func (ctl *ManifestController) building(c *gin.Context) (*models.Building, bool) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return nil, false
+	}
+	building, err := ctl.store.Buildings().Get(c.Request.Context(), id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return nil, false
+	}
+	return building, true
+}
+
+// writeSigned 原样输出响应体，并在响应头中附带签名
+func (ctl *ManifestController) writeSigned(c *gin.Context, body []byte) {
+	signer := ctl.manifests.Signer()
+	c.Header(ManifestSignatureHeader, signer.Sign(body))
+	c.Header(ManifestKeyIDHeader, signer.KeyID())
+	c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
@@ -0,0 +1,432 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/region"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/10240418/advertisement-management-system/backend/richtext"
+	"github.com/gin-gonic/gin"
+)
+
+// NoticeInput 创建或更新通知的输入，更新时整体替换，starts_at 为空时创建取当前时间、更新保持原值
+type NoticeInput struct {
+	Title    string     `json:"title" binding:"required,max=200"`
+	Content  string     `json:"content" binding:"required,max=20000"` // HTML，保存前按白名单清理
+	Priority int        `json:"priority" binding:"gte=0,lte=100"`
+	StartsAt *time.Time `json:"starts_at"`
+	EndsAt   *time.Time `json:"ends_at"`
+}
+
+// NoticeController 处理通知及通知与大厦关联相关的请求
+type NoticeController struct {
+	store   repository.Store
+	regions *region.Resolver
+}
+
+// NewNoticeController 创建 NoticeController
+func NewNoticeController(store repository.Store, regions *region.Resolver) *NoticeController {
+	return &NoticeController{store: store, regions: regions}
+}
+
+// GetNotices 获取所有通知，并支持分页和排序
+func (ctl *NoticeController) GetNotices(c *gin.Context) {
+	pageNum, pageSize, opts := pagination(c)
+
+	notices, count, err := ctl.store.Notices().List(c.Request.Context(), opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":     notices,
+		"total":    count,
+		"pageNum":  pageNum,
+		"pageSize": pageSize,
+	})
+}
+
+// GetNotice 获取单个通知
+func (ctl *NoticeController) GetNotice(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	notice, err := ctl.store.Notices().Get(c.Request.Context(), id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeNoticeNotFound))
+		return
+	}
+
+	c.JSON(http.StatusOK, notice)
+}
+
+// CreateNotice 创建通知
+func (ctl *NoticeController) CreateNotice(c *gin.Context) {
+	var input NoticeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	notice := models.Notice{StartsAt: time.Now(), CreatedBy: c.GetString("username")}
+	if apiErr := input.apply(&notice); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := ctl.store.Notices().Create(ctx, &notice); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "发布通知", "id", notice.ID, "title", notice.Title, "priority", notice.Priority)
+	c.JSON(http.StatusCreated, notice)
+}
+
+// UpdateNotice 更新通知
+func (ctl *NoticeController) UpdateNotice(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input NoticeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	notice, err := ctl.store.Notices().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeNoticeNotFound))
+		return
+	}
+	if apiErr := input.apply(notice); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+	if err := ctl.store.Notices().Save(ctx, notice); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeNoticeNotFound))
+		return
+	}
+
+	c.JSON(http.StatusOK, notice)
+}
+
+// DeleteNotice 删除通知及其与大厦的关联
+func (ctl *NoticeController) DeleteNotice(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := ctl.store.Notices().Delete(ctx, id); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeNoticeNotFound))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "删除通知", "id", id)
+	c.JSON(http.StatusOK, gin.H{"message": "通知删除成功"})
+}
+
+// AddNoticesToBuilding 通过 Building ID 添加多个 Notice 关联
+func (ctl *NoticeController) AddNoticesToBuilding(c *gin.Context) {
+	buildingID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input struct {
+		NoticeIDs []uint `json:"notice_ids" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		building, err := tx.Buildings().Get(ctx, buildingID)
+		if err != nil {
+			return notFound(err, apierror.CodeBuildingNotFound)
+		}
+
+		notices, err := tx.Notices().FindByIDs(ctx, input.NoticeIDs)
+		if err != nil {
+			return err
+		}
+		if len(notices) != len(uniqueIDs(input.NoticeIDs)) {
+			return apierror.BadRequest(apierror.CodeUnknownNoticeIDs).WithMeta("missing_ids", missingIDs(input.NoticeIDs, noticeIDsOf(notices)))
+		}
+
+		for _, notice := range notices {
+			if err := linkNotice(ctx, tx, notice.ID, building.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "通知与建筑关联成功"})
+}
+
+// RemoveNoticesFromBuilding 通过 Building ID 删除多个 Notice 关联
+func (ctl *NoticeController) RemoveNoticesFromBuilding(c *gin.Context) {
+	buildingID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input struct {
+		NoticeIDs []uint `json:"notice_ids" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, err := ctl.store.Buildings().Get(ctx, buildingID); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return
+	}
+	if err := ctl.store.Notices().UnlinkFromBuilding(ctx, buildingID, input.NoticeIDs); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "通知与建筑关联删除成功"})
+}
+
+// AddBuildingsToNotice 通过 Notice ID 添加多个 Building 关联
+func (ctl *NoticeController) AddBuildingsToNotice(c *gin.Context) {
+	noticeID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input struct {
+		BuildingIDs []uint `json:"building_ids" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	err := ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		notice, err := tx.Notices().Get(ctx, noticeID)
+		if err != nil {
+			return notFound(err, apierror.CodeNoticeNotFound)
+		}
+
+		buildings, err := tx.Buildings().FindByIDs(ctx, input.BuildingIDs)
+		if err != nil {
+			return err
+		}
+		if len(buildings) != len(uniqueIDs(input.BuildingIDs)) {
+			return apierror.BadRequest(apierror.CodeUnknownBuildingIDs).WithMeta("missing_ids", missingIDs(input.BuildingIDs, buildingIDsOf(buildings)))
+		}
+
+		for _, building := range buildings {
+			if err := linkNotice(ctx, tx, notice.ID, building.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "通知与建筑关联成功"})
+}
+
+// RemoveBuildingsFromNotice 通过 Notice ID 删除多个 Building 关联
+func (ctl *NoticeController) RemoveBuildingsFromNotice(c *gin.Context) {
+	noticeID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input struct {
+		BuildingIDs []uint `json:"building_ids" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, err := ctl.store.Notices().Get(ctx, noticeID); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeNoticeNotFound))
+		return
+	}
+	if err := ctl.store.Notices().UnlinkFromNotice(ctx, noticeID, input.BuildingIDs); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "通知与建筑关联删除成功"})
+}
+
+// GetNoticesByBuilding 获取指定 Building ID 关联的所有通知，包括未生效和已过期的
+func (ctl *NoticeController) GetNoticesByBuilding(c *gin.Context) {
+	buildingID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	links, err := ctl.store.Notices().ListByBuilding(ctx, buildingID)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	noticeIDs := make([]uint, 0, len(links))
+	for _, link := range links {
+		noticeIDs = append(noticeIDs, link.NoticeID)
+	}
+	notices, err := ctl.store.Notices().FindByIDs(ctx, noticeIDs)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"notices": notices,
+	})
+}
+
+// GetBuildingsByNotice 获取指定 Notice ID 关联的所有大厦
+func (ctl *NoticeController) GetBuildingsByNotice(c *gin.Context) {
+	noticeID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	links, err := ctl.store.Notices().ListByNotice(ctx, noticeID)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	buildingIDs := make([]uint, 0, len(links))
+	for _, link := range links {
+		buildingIDs = append(buildingIDs, link.BuildingID)
+	}
+	buildings, err := ctl.store.Buildings().FindByIDs(ctx, buildingIDs)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"buildings": buildings,
+	})
+}
+
+// GetActiveNotices 获取大厦当前有效的通知，按优先级从高到低排序，供播放端轮询；
+// 时间按大厦所在时区返回，便于播放端直接显示
+func (ctl *NoticeController) GetActiveNotices(c *gin.Context) {
+	buildingID, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	building, err := ctl.store.Buildings().Get(ctx, buildingID)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return
+	}
+	notices, err := ctl.store.Notices().ActiveForBuilding(ctx, buildingID, time.Now())
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	settings := ctl.regions.For(building)
+	for i := range notices {
+		localizeNotice(&notices[i], settings.Location)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"notices":  notices,
+		"timezone": settings.Timezone,
+		"locale":   settings.Locale,
+	})
+}
+
+// localizeNotice 将通知的时间转换到 loc 时区
+func localizeNotice(notice *models.Notice, loc *time.Location) {
+	notice.CreatedAt = notice.CreatedAt.In(loc)
+	notice.UpdatedAt = notice.UpdatedAt.In(loc)
+	notice.StartsAt = notice.StartsAt.In(loc)
+	if notice.EndsAt != nil {
+		endsAt := notice.EndsAt.In(loc)
+		notice.EndsAt = &endsAt
+	}
+}
+
+// apply 校验输入并写入通知：清理富文本，且失效时间必须晚于生效时间
+func (input NoticeInput) apply(notice *models.Notice) *apierror.Error {
+	apiErr := requireNonBlank("title", input.Title)
+	content := richtext.Sanitize(input.Content)
+	if richtext.Text(content) == "" {
+		apiErr.WithDetails(apierror.Field("content", "required", nil))
+	}
+	startsAt := notice.StartsAt
+	if input.StartsAt != nil {
+		startsAt = *input.StartsAt
+	}
+	if input.EndsAt != nil && !input.EndsAt.After(startsAt) {
+		apiErr.WithDetails(apierror.Field("ends_at", "after", map[string]any{"param": "starts_at"}))
+	}
+	if len(apiErr.Details) > 0 {
+		return apiErr
+	}
+
+	notice.Title = strings.TrimSpace(input.Title)
+	notice.Content = content
+	notice.Priority = input.Priority
+	notice.StartsAt = startsAt
+	notice.EndsAt = input.EndsAt
+	return nil
+}
+
+// linkNotice 关联通知与大厦，重复关联返回 409
+func linkNotice(ctx context.Context, tx repository.Store, noticeID, buildingID uint) error {
+	link := models.BuildingNotice{NoticeID: noticeID, BuildingID: buildingID}
+	if err := tx.Notices().Link(ctx, &link); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return apierror.Conflict(apierror.CodeNoticeLinked).
+				WithMeta("notice_id", noticeID).
+				WithMeta("building_id", buildingID)
+		}
+		return err
+	}
+	return nil
+}
+
+// noticeIDsOf 返回通知的 ID 列表
+func noticeIDsOf(notices []models.Notice) []uint {
+	ids := make([]uint, len(notices))
+	for i, notice := range notices {
+		ids[i] = notice.ID
+	}
+	return ids
+}
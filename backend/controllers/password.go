@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/config"
+)
+
+// bcrypt 只使用密码的前 72 个字节，更长的密码会被静默截断
+const maxPasswordBytes = 72
+
+// checkPassword 按密码策略校验 field 字段中的新密码，返回所有未满足的规则
+func checkPassword(policy config.PasswordPolicy, field, username, password string) *apierror.Error {
+	var details []apierror.FieldError
+	if utf8.RuneCountInString(password) < policy.MinLength {
+		details = append(details, apierror.Field(field, "password_length", map[string]any{"param": policy.MinLength}))
+	}
+	if len(password) > maxPasswordBytes {
+		details = append(details, apierror.Field(field, "password_too_long", map[string]any{"param": maxPasswordBytes}))
+	}
+	if passwordClasses(password) < policy.MinClasses {
+		details = append(details, apierror.Field(field, "password_classes", map[string]any{"param": policy.MinClasses}))
+	}
+	if strings.EqualFold(password, username) {
+		details = append(details, apierror.Field(field, "password_username", nil))
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	return apierror.BadRequest(apierror.CodeValidationFailed).WithDetails(details...)
+}
+
+// passwordClasses 统计密码包含的字符类别数：小写字母、大写字母、数字、其他符号
+func passwordClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+	count := 0
+	for _, ok := range []bool{lower, upper, digit, symbol} {
+		if ok {
+			count++
+		}
+	}
+	return count
+}
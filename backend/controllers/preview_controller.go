@@ -0,0 +1,170 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/dayparting"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/preview"
+	"github.com/10240418/advertisement-management-system/backend/region"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// 播放预览的最长时间范围与时间线的最多条目数
+const (
+	maxPreviewDays  = 7
+	maxPreviewSlots = 20000
+)
+
+// PreviewController 处理大厦播放预览的请求
+type PreviewController struct {
+	store        repository.Store
+	engine       *dayparting.Engine
+	loopCapacity time.Duration
+}
+
+// NewPreviewController 创建 PreviewController，loopCapacity 为每个区域一轮播放的最长时长，0 表示不限制
+func NewPreviewController(store repository.Store, engine *dayparting.Engine, loopCapacity time.Duration) *PreviewController {
+	return &PreviewController{store: store, engine: engine, loopCapacity: loopCapacity}
+}
+
+// GetBuildingPreview 模拟大厦在 [from, to) 内各区域的播放时间线，并说明广告不能播放的原因
+func (ctl *PreviewController) GetBuildingPreview(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	building, err := ctl.store.Buildings().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return
+	}
+	settings := ctl.engine.Region(building)
+	from, to, apiErr := previewRange(c, settings.Location, time.Now())
+	if apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	zones, placements, err := playZones(ctx, ctl.store, building)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	plan, err := ctl.engine.Prepare(ctx, placements)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	result := preview.Simulate(plan, zones, from, to, preview.Options{LoopCapacity: ctl.loopCapacity, MaxSlots: maxPreviewSlots})
+	c.JSON(http.StatusOK, gin.H{
+		"building_id":   building.ID,
+		"timezone":      settings.Timezone,
+		"locale":        settings.Locale,
+		"from":          from,
+		"to":            to,
+		"loop_capacity": int64(ctl.loopCapacity / time.Second),
+		"slots":         result.Slots,
+		"exclusions":    result.Exclusions,
+		"totals":        result.Totals,
+		"truncated":     result.Truncated,
+	})
+}
+
+// playZones 返回大厦布局中的各播放区域及其中的投放，区域按布局中的顺序排列，
+// 投放归入实际播放的区域；同时返回大厦的全部投放记录
+func playZones(ctx context.Context, store repository.Store, building *models.Building) ([]preview.Zone, []models.AdvertisementBuilding, error) {
+	layout, err := buildingLayout(ctx, store, building)
+	if err != nil {
+		return nil, nil, err
+	}
+	placements, err := store.Placements().ListByBuilding(ctx, building.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	ads, err := store.Ads().FindByIDs(ctx, adIDsOfPlacements(placements))
+	if err != nil {
+		return nil, nil, err
+	}
+	adsByID := make(map[uint]models.Advertisement, len(ads))
+	for _, ad := range ads {
+		adsByID[ad.ID] = ad
+	}
+
+	zones := make([]preview.Zone, 0, len(layout.Zones))
+	index := make(map[string]int, len(layout.Zones))
+	for _, zone := range layout.Zones {
+		if zone.Kind == models.ZoneWidget {
+			continue
+		}
+		index[zone.Name] = len(zones)
+		zones = append(zones, preview.Zone{Name: zone.Name})
+	}
+	for _, placement := range placements {
+		ad, ok := adsByID[placement.AdvertisementID]
+		if !ok {
+			continue
+		}
+		zone, ok := layout.PlayZone(placement.Zone)
+		if !ok {
+			continue
+		}
+		i := index[zone.Name]
+		zones[i].Entries = append(zones[i].Entries, preview.Entry{Placement: placement, Ad: ad})
+	}
+	return zones, placements, nil
+}
+
+// previewRange 解析 from、to 查询参数，值为 RFC 3339 时间或大厦当地日期 YYYY-MM-DD；
+// from 默认为当地明天零点，to 为日期时包含当天，缺省时为 from 所在当地日期的结束
+func previewRange(c *gin.Context, loc *time.Location, now time.Time) (time.Time, time.Time, *apierror.Error) {
+	apiErr := apierror.BadRequest(apierror.CodeValidationFailed)
+	from := region.DayOf(now, loc).End
+	if value := c.Query("from"); value != "" {
+		if t, _, ok := parsePreviewTime(value, loc); ok {
+			from = t
+		} else {
+			apiErr.WithDetails(apierror.Field("from", "type", map[string]any{"param": "RFC 3339 time or YYYY-MM-DD date"}))
+		}
+	}
+	to := region.DayOf(from, loc).End
+	if value := c.Query("to"); value != "" {
+		if t, isDate, ok := parsePreviewTime(value, loc); ok {
+			to = t
+			if isDate {
+				to = region.DayOf(t, loc).End
+			}
+		} else {
+			apiErr.WithDetails(apierror.Field("to", "type", map[string]any{"param": "RFC 3339 time or YYYY-MM-DD date"}))
+		}
+	}
+	if len(apiErr.Details) > 0 {
+		return time.Time{}, time.Time{}, apiErr
+	}
+
+	switch {
+	case !to.After(from):
+		return time.Time{}, time.Time{}, apiErr.WithDetails(apierror.Field("to", "after", map[string]any{"param": "from"}))
+	case to.After(from.AddDate(0, 0, maxPreviewDays)):
+		return time.Time{}, time.Time{}, apiErr.WithDetails(apierror.Field("to", "max_days", map[string]any{"param": "from", "days": maxPreviewDays}))
+	}
+	return from.In(loc), to.In(loc), nil
+}
+
+// parsePreviewTime 解析 RFC 3339 时间或当地日期，日期返回当地零点
+func parsePreviewTime(value string, loc *time.Location) (t time.Time, isDate, ok bool) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, true
+	}
+	if t, err := time.ParseInLocation(models.DateLayout, value, loc); err == nil {
+		return t, true, true
+	}
+	return time.Time{}, false, false
+}
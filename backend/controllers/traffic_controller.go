@@ -0,0 +1,305 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/dayparting"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/10240418/advertisement-management-system/backend/spreadsheet"
+	"github.com/gin-gonic/gin"
+)
+
+// 人流量文件中当地时间的格式，也接受 RFC 3339 时间
+var trafficHourLayouts = []string{"2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02 15:04:05", "2006-01-02T15:04:05"}
+
+// TrafficCountInput 一小时的人流量
+type TrafficCountInput struct {
+	Hour     time.Time `json:"hour" binding:"required"` // 大厦当地的整点
+	Visitors int64     `json:"visitors" binding:"gte=0"`
+}
+
+// TrafficInput 上传人流量的输入，同一小时已有数据时覆盖
+type TrafficInput struct {
+	Counts []TrafficCountInput `json:"counts" binding:"required,min=1,max=5000,dive"`
+}
+
+// TrafficImportRow 人流量文件中的一行及其校验结果
+type TrafficImportRow struct {
+	Row      int                   `json:"row"`  // 文件中的行号，从 1 开始，包含表头
+	Hour     string                `json:"hour"` // 文件中的原始值
+	Visitors int64                 `json:"visitors"`
+	Errors   []apierror.FieldError `json:"errors,omitempty"`
+	start    time.Time
+}
+
+// TrafficImportReport 人流量导入的校验报告
+type TrafficImportReport struct {
+	DryRun    bool               `json:"dry_run"`
+	Committed bool               `json:"committed"`
+	Total     int                `json:"total"`
+	Valid     int                `json:"valid"`
+	Invalid   int                `json:"invalid"`
+	Rows      []TrafficImportRow `json:"rows"`
+}
+
+// TrafficController 处理大厦每小时人流量的上传与查询
+type TrafficController struct {
+	store  repository.Store
+	engine *dayparting.Engine
+}
+
+// NewTrafficController 创建 TrafficController
+func NewTrafficController(store repository.Store, engine *dayparting.Engine) *TrafficController {
+	return &TrafficController{store: store, engine: engine}
+}
+
+// ListTraffic 获取大厦在 from、to 两个当地日期之间（包含两端）每小时的人流量，默认最近 7 天
+func (ctl *TrafficController) ListTraffic(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	building, err := ctl.store.Buildings().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return
+	}
+	settings := ctl.engine.Region(building)
+	from, to, apiErr := reportDates(c, settings.Location, time.Now())
+	if apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	start, end := dateBounds(from, to, settings.Location)
+	counts, err := ctl.store.Traffic().List(ctx, id, start, end)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	for i := range counts {
+		counts[i].Hour = counts[i].Hour.In(settings.Location)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"building_id": id,
+		"timezone":    settings.Timezone,
+		"from":        from,
+		"to":          to,
+		"data":        counts,
+	})
+}
+
+// PutTraffic 上传大厦每小时的人流量，同一小时已有数据时覆盖
+func (ctl *TrafficController) PutTraffic(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input TrafficInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	building, err := ctl.store.Buildings().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return
+	}
+	loc := ctl.engine.Region(building).Location
+
+	apiErr := apierror.BadRequest(apierror.CodeValidationFailed)
+	counts := make([]models.TrafficCount, 0, len(input.Counts))
+	seen := make(map[int64]int, len(input.Counts))
+	for i, count := range input.Counts {
+		field := fmt.Sprintf("counts[%d].hour", i)
+		if !onTheHour(count.Hour, loc) {
+			apiErr.WithDetails(apierror.Field(field, "on_the_hour", nil))
+			continue
+		}
+		if first, ok := seen[count.Hour.Unix()]; ok {
+			apiErr.WithDetails(apierror.Field(field, "duplicate_of", map[string]any{"other": fmt.Sprintf("counts[%d].hour", first)}))
+			continue
+		}
+		seen[count.Hour.Unix()] = i
+		counts = append(counts, models.TrafficCount{BuildingID: id, Hour: count.Hour.UTC(), Visitors: count.Visitors})
+	}
+	if len(apiErr.Details) > 0 {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	if err := ctl.store.Traffic().Upsert(ctx, counts); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "上传人流量", "building_id", id, "hours", len(counts))
+	c.JSON(http.StatusOK, gin.H{"message": "人流量已保存", "count": len(counts)})
+}
+
+// ImportTraffic 通过 CSV 或 XLSX 文件上传大厦每小时的人流量，表头为 hour、visitors。
+// hour 为 RFC 3339 时间或大厦当地时间 YYYY-MM-DD HH:MM；与大厦导入一样默认仅做校验（dry_run=true）
+func (ctl *TrafficController) ImportTraffic(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	dryRun := c.DefaultQuery("dry_run", "true") != "false"
+
+	ctx := c.Request.Context()
+	building, err := ctl.store.Buildings().Get(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeBuildingNotFound))
+		return
+	}
+	loc := ctl.engine.Region(building).Location
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportFileSize)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeFileRequired).With("field", "file"))
+		return
+	}
+	format, err := spreadsheet.FormatFromFilename(fileHeader.Filename)
+	if err != nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeUnsupportedFormat))
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeFileUnreadable).WithCause(err))
+		return
+	}
+	defer file.Close()
+	records, err := spreadsheet.ReadRows(format, file)
+	if err != nil {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeFileInvalid).WithCause(err))
+		return
+	}
+
+	rows, err := parseTrafficImportRows(records, loc)
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	report := TrafficImportReport{DryRun: dryRun, Total: len(rows), Rows: rows}
+	lang := apierror.Language(c)
+	for i := range rows {
+		row := &rows[i]
+		row.Errors = apierror.LocalizeFields(lang, row.Errors)
+		if len(row.Errors) > 0 {
+			report.Invalid++
+		} else {
+			report.Valid++
+		}
+	}
+	if dryRun {
+		c.JSON(http.StatusOK, report)
+		return
+	}
+	if report.Invalid > 0 {
+		apierror.Respond(c, apierror.Unprocessable(apierror.CodeImportInvalid).WithMeta("report", report))
+		return
+	}
+
+	counts := make([]models.TrafficCount, len(rows))
+	for i, row := range rows {
+		counts[i] = models.TrafficCount{BuildingID: id, Hour: row.start.UTC(), Visitors: row.Visitors}
+	}
+	if err := ctl.store.Traffic().Upsert(ctx, counts); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "导入人流量", "building_id", id, "hours", len(counts))
+	report.Committed = true
+	c.JSON(http.StatusCreated, report)
+}
+
+// parseTrafficImportRows 将表格行解析为人流量并逐行校验，第一行必须是表头
+func parseTrafficImportRows(records [][]string, loc *time.Location) ([]TrafficImportRow, error) {
+	if len(records) == 0 {
+		return nil, apierror.BadRequest(apierror.CodeImportEmpty)
+	}
+	index := spreadsheet.HeaderIndex(records[0])
+	for _, column := range []string{"hour", "visitors"} {
+		if _, ok := index[column]; !ok {
+			return nil, apierror.BadRequest(apierror.CodeImportMissingColumn).With("column", column)
+		}
+	}
+
+	var rows []TrafficImportRow
+	seen := make(map[int64]int)
+	for i, record := range records[1:] {
+		// 跳过完全空白的行
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		row := TrafficImportRow{Row: i + 2, Hour: spreadsheet.Cell(record, index, "hour")}
+
+		visitors, err := strconv.ParseInt(spreadsheet.Cell(record, index, "visitors"), 10, 64)
+		switch {
+		case err != nil:
+			row.Errors = append(row.Errors, apierror.Field("visitors", "type", map[string]any{"param": "integer"}))
+		case visitors < 0:
+			row.Errors = append(row.Errors, apierror.Field("visitors", "gte", map[string]any{"param": 0}))
+		}
+		row.Visitors = visitors
+
+		start, ok := parseTrafficHour(row.Hour, loc)
+		switch {
+		case row.Hour == "":
+			row.Errors = append(row.Errors, apierror.Field("hour", "required", nil))
+		case !ok:
+			row.Errors = append(row.Errors, apierror.Field("hour", "type", map[string]any{"param": "RFC 3339 time or YYYY-MM-DD HH:MM"}))
+		case !onTheHour(start, loc):
+			row.Errors = append(row.Errors, apierror.Field("hour", "on_the_hour", nil))
+		default:
+			if first, dup := seen[start.Unix()]; dup {
+				row.Errors = append(row.Errors, apierror.Field("hour", "duplicate", map[string]any{"row": first}))
+			} else {
+				seen[start.Unix()] = row.Row
+			}
+		}
+		row.start = start
+
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, apierror.BadRequest(apierror.CodeImportEmpty)
+	}
+	return rows, nil
+}
+
+// parseTrafficHour 解析 RFC 3339 时间或大厦当地时间
+func parseTrafficHour(value string, loc *time.Location) (time.Time, bool) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, true
+	}
+	for _, layout := range trafficHourLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// onTheHour 判断 t 是否为 loc 中的整点，时区偏移不是整小时的地区按当地时间判断
+func onTheHour(t time.Time, loc *time.Location) bool {
+	local := t.In(loc)
+	return local.Minute() == 0 && local.Second() == 0 && local.Nanosecond() == 0
+}
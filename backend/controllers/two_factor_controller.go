@@ -0,0 +1,324 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/config"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/middleware"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/ratelimit"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/10240418/advertisement-management-system/backend/twofactor"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// secondFactor 两步验证的第二因素，验证码与恢复码二选一
+type secondFactor struct {
+	Code         string `json:"code"`          // 验证器应用中的 6 位验证码
+	RecoveryCode string `json:"recovery_code"` // 一次性恢复码
+}
+
+// VerifyLoginTOTP 登录第二步：提交挑战令牌与验证码（或恢复码），通过后签发访问令牌
+func (ctl *AdminController) VerifyLoginTOTP(c *gin.Context) {
+	var input struct {
+		ChallengeToken string `json:"challenge_token" binding:"required"`
+		secondFactor
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	if apiErr := input.secondFactor.validate(); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	claims, err := config.ValidateChallengeToken(input.ChallengeToken)
+	if err != nil {
+		apierror.Respond(c, apierror.Unauthorized(apierror.CodeInvalidChallenge))
+		return
+	}
+
+	// 与密码登录共用按用户名的限流
+	if !ratelimit.Enforce(c, ctl.limiter, "login-user:"+claims.Username, ratelimit.PerMinute(ctl.auth.LoginPerUser)) {
+		return
+	}
+
+	ctx := c.Request.Context()
+	admin, err := ctl.store.Admins().GetByUsername(ctx, claims.Username)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Respond(c, apierror.Unauthorized(apierror.CodeInvalidChallenge))
+			return
+		}
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	// 挑战签发后修改了密码或关闭了两步验证，需要重新登录
+	if !admin.TOTPEnabled || admin.TokenRevoked(claims.IssuedAt) {
+		apierror.Respond(c, apierror.Unauthorized(apierror.CodeInvalidChallenge))
+		return
+	}
+
+	now := time.Now()
+	if ctl.auth.LockoutThreshold > 0 && admin.Locked(now) {
+		apierror.Respond(c, accountLocked(admin.LockedUntil.Sub(now)))
+		return
+	}
+
+	ok, err := ctl.checkSecondFactor(ctx, admin, input.secondFactor, now)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	if !ok {
+		apierror.Respond(c, ctl.loginFailure(ctx, admin, now, apierror.CodeInvalidTOTPCode))
+		return
+	}
+
+	ctl.completeLogin(c, admin, now)
+}
+
+// EnrollTOTP 为当前管理员生成新的两步验证密钥，返回二维码与 otpauth 链接，验证通过前不会启用
+func (ctl *AdminController) EnrollTOTP(c *gin.Context) {
+	admin := middleware.CurrentAdmin(c)
+	if admin.TOTPEnabled {
+		apierror.Respond(c, apierror.Conflict(apierror.CodeTOTPAlreadyEnabled))
+		return
+	}
+
+	enrollment, err := twofactor.NewEnrollment(ctl.auth.TOTP.Issuer, admin.Username)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	if err := ctl.store.Admins().SetTOTP(c.Request.Context(), admin.ID, enrollment.Secret, false); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"secret":           enrollment.Secret,
+		"provisioning_uri": enrollment.ProvisioningURI,
+		"qr_code":          enrollment.QRCode,
+	})
+}
+
+// ConfirmTOTP 提交验证器中的验证码完成绑定，启用两步验证并返回恢复码（仅返回这一次）
+func (ctl *AdminController) ConfirmTOTP(c *gin.Context) {
+	var input struct {
+		Code string `json:"code" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	admin := middleware.CurrentAdmin(c)
+	if admin.TOTPEnabled {
+		apierror.Respond(c, apierror.Conflict(apierror.CodeTOTPAlreadyEnabled))
+		return
+	}
+	if admin.TOTPSecret == "" {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeTOTPNotEnrolled))
+		return
+	}
+
+	step, ok := twofactor.Match(admin.TOTPSecret, input.Code, time.Now())
+	if !ok {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeInvalidTOTPCode))
+		return
+	}
+
+	codes, hashes, err := twofactor.NewRecoveryCodes()
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	err = ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Admins().SetTOTP(ctx, admin.ID, admin.TOTPSecret, true); err != nil {
+			return err
+		}
+		// 记录本次验证码的时间步，防止它在登录时被重放
+		if _, err := tx.Admins().ConsumeTOTPStep(ctx, admin.ID, step); err != nil {
+			return err
+		}
+		return tx.Admins().ReplaceRecoveryCodes(ctx, admin.ID, hashes)
+	})
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "启用两步验证", "username", admin.Username)
+	c.JSON(http.StatusOK, gin.H{"message": "两步验证已启用", "recovery_codes": codes})
+}
+
+// RegenerateRecoveryCodes 使用验证码或恢复码确认后重新生成恢复码，旧恢复码全部失效
+func (ctl *AdminController) RegenerateRecoveryCodes(c *gin.Context) {
+	var input secondFactor
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	if apiErr := input.validate(); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	admin := middleware.CurrentAdmin(c)
+	if !admin.TOTPEnabled {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeTOTPNotEnabled))
+		return
+	}
+
+	ctx := c.Request.Context()
+	ok, err := ctl.checkSecondFactor(ctx, admin, input, time.Now())
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	if !ok {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeInvalidTOTPCode))
+		return
+	}
+
+	codes, hashes, err := twofactor.NewRecoveryCodes()
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	if err := ctl.store.Admins().ReplaceRecoveryCodes(ctx, admin.ID, hashes); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "恢复码已重新生成", "recovery_codes": codes})
+}
+
+// DisableTOTP 使用密码与验证码（或恢复码）确认后关闭当前管理员的两步验证
+func (ctl *AdminController) DisableTOTP(c *gin.Context) {
+	var input struct {
+		Password string `json:"password" binding:"required"`
+		secondFactor
+	}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+	if apiErr := input.secondFactor.validate(); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+
+	admin := middleware.CurrentAdmin(c)
+	if !admin.TOTPEnabled {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeTOTPNotEnabled))
+		return
+	}
+	if ctl.auth.TOTP.RequireSuperAdmin && admin.Role == models.RoleSuperAdmin {
+		apierror.Respond(c, apierror.Forbidden(apierror.CodeTOTPRequired))
+		return
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(strings.TrimSpace(input.Password))); err != nil {
+		apierror.Respond(c, apierror.Unauthorized(apierror.CodeWrongPassword))
+		return
+	}
+
+	ctx := c.Request.Context()
+	ok, err := ctl.checkSecondFactor(ctx, admin, input.secondFactor, time.Now())
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	if !ok {
+		apierror.Respond(c, apierror.BadRequest(apierror.CodeInvalidTOTPCode))
+		return
+	}
+
+	err = ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		return clearTOTP(ctx, tx.Admins(), admin.ID)
+	})
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "关闭两步验证", "username", admin.Username)
+	c.JSON(http.StatusOK, gin.H{"message": "两步验证已关闭"})
+}
+
+// ResetAdminTOTP 超级管理员为丢失验证器的管理员关闭两步验证，操作写入审计日志
+func (ctl *AdminController) ResetAdminTOTP(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	target, err := ctl.store.Admins().GetByID(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeAdminNotFound))
+		return
+	}
+
+	actor := middleware.CurrentAdmin(c)
+	err = ctl.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := clearTOTP(ctx, tx.Admins(), target.ID); err != nil {
+			return err
+		}
+		return tx.AuditLogs().Create(ctx, &models.AdminAuditLog{
+			ActorID:        actor.ID,
+			ActorUsername:  actor.Username,
+			Action:         models.AuditTOTPReset,
+			TargetID:       target.ID,
+			TargetUsername: target.Username,
+			ClientIP:       c.ClientIP(),
+			RequestID:      logging.RequestID(ctx),
+		})
+	})
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "重置管理员两步验证", "actor", actor.Username, "target", target.Username)
+	c.JSON(http.StatusOK, gin.H{"message": "两步验证已重置"})
+}
+
+// checkSecondFactor 校验验证码或恢复码，验证码只能使用一次，恢复码使用后作废
+func (ctl *AdminController) checkSecondFactor(ctx context.Context, admin *models.Administrator, factor secondFactor, now time.Time) (bool, error) {
+	if factor.RecoveryCode != "" {
+		return ctl.store.Admins().UseRecoveryCode(ctx, admin.ID, twofactor.HashRecoveryCode(factor.RecoveryCode), now)
+	}
+	step, ok := twofactor.Match(admin.TOTPSecret, factor.Code, now)
+	if !ok {
+		return false, nil
+	}
+	return ctl.store.Admins().ConsumeTOTPStep(ctx, admin.ID, step)
+}
+
+// validate 要求验证码与恢复码至少提供一个
+func (f secondFactor) validate() *apierror.Error {
+	if strings.TrimSpace(f.Code) == "" && strings.TrimSpace(f.RecoveryCode) == "" {
+		return requireNonBlank("code", f.Code)
+	}
+	return nil
+}
+
+// clearTOTP 关闭两步验证并删除全部恢复码，调用方负责放在同一事务中
+func clearTOTP(ctx context.Context, admins repository.AdminRepository, id uint) error {
+	if err := admins.SetTOTP(ctx, id, "", false); err != nil {
+		return err
+	}
+	return admins.ReplaceRecoveryCodes(ctx, id, nil)
+}
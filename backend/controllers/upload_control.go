@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha1"
 	"encoding/base64"
@@ -8,29 +9,35 @@ import (
 	"fmt"
 	"hash"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
-	"os"
 	"time"
 
+	"github.com/10240418/advertisement-management-system/backend/apierror"
 	"github.com/10240418/advertisement-management-system/backend/config"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/metrics"
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
-// 设置策略令牌的过期时间（秒）
-var expire_time int64 = 30
-
-// FileService 结构体，包含一个指向gorm.DB的指针
+// FileService 负责生成 OSS 上传参数
 type FileService struct {
-	db *gorm.DB
+	oss config.OSSConfig
 }
 
 // NewFileService 创建一个新的FileService实例
-func NewFileService(db *gorm.DB) *FileService {
-	return &FileService{
-		db: db,
-	}
+func NewFileService(oss config.OSSConfig) *FileService {
+	return &FileService{oss: oss}
+}
+
+// UploadController 处理上传相关的请求
+type UploadController struct {
+	files *FileService
+}
+
+// NewUploadController 创建 UploadController
+func NewUploadController(files *FileService) *UploadController {
+	return &UploadController{files: files}
 }
 
 // ConfigStruct 用于生成上传策略的结构体
@@ -57,6 +64,19 @@ type PolicyToken struct {
 	Callback    string `json:"callback"`  // 回调参数
 }
 
+// LogValue 实现 slog.LogValuer，日志中隐藏签名、策略与回调内容
+func (p PolicyToken) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("accessid", p.AccessKeyId),
+		slog.String("host", p.Host),
+		slog.Int64("expire", p.Expire),
+		slog.String("dir", p.Directory),
+		slog.String("signature", logging.Redacted),
+		slog.String("policy", logging.Redacted),
+		slog.String("callback", logging.Redacted),
+	)
+}
+
 // getGMTISO8501 将Unix时间戳转换为GMT ISO 8501格式的字符串
 func getGMTISO8501(expire_end int64) string {
 	var tokenExpire = time.Unix(expire_end, 0).UTC().Format("2006-01-02T15:04:05Z")
@@ -64,10 +84,11 @@ func getGMTISO8501(expire_end int64) string {
 }
 
 // GetPolicyToken 生成上传策略令牌
-func GetPolicyToken(upload_dir string, callbackUrl string) (*map[string]interface{}, error) {
+func (s *FileService) GetPolicyToken(ctx context.Context, upload_dir string, callbackUrl string) (*map[string]interface{}, error) {
+	logger := logging.FromContext(ctx)
 	now := time.Now().Unix()
 	// 计算策略的过期时间
-	expire_end := now + expire_time
+	expire_end := now + int64(s.oss.PolicyExpire/time.Second)
 	var tokenExpire = getGMTISO8501(expire_end)
 
 	// 创建上传策略的JSON结构
@@ -86,7 +107,7 @@ func GetPolicyToken(upload_dir string, callbackUrl string) (*map[string]interfac
 	}
 	debyte := base64.StdEncoding.EncodeToString(result)
 	// 创建HMAC-SHA1哈希
-	h := hmac.New(func() hash.Hash { return sha1.New() }, []byte(os.Getenv("ACCESS_KEY_SECRET")))
+	h := hmac.New(func() hash.Hash { return sha1.New() }, []byte(s.oss.AccessKeySecret))
 	_, err = io.WriteString(h, debyte)
 	if err != nil {
 		return nil, fmt.Errorf("写入HMAC哈希失败: %v", err)
@@ -100,28 +121,28 @@ func GetPolicyToken(upload_dir string, callbackUrl string) (*map[string]interfac
 	callbackParam.CallbackBodyType = "application/x-www-form-urlencoded"
 	callback_str, err := json.Marshal(callbackParam)
 	if err != nil {
-		log.Println("回调参数JSON序列化错误:", err)
+		logger.Error("回调参数JSON序列化错误", slog.Any("error", err))
 	}
 	// 对回调参数进行Base64编码
 	callbackBase64 := base64.StdEncoding.EncodeToString(callback_str)
 
 	// 构建策略令牌
 	var policyToken PolicyToken
-	policyToken.AccessKeyId = os.Getenv("ACCESS_KEY_ID")
-	policyToken.Host = os.Getenv("HOST")
+	policyToken.AccessKeyId = s.oss.AccessKeyID
+	policyToken.Host = s.oss.Host
 	policyToken.Expire = expire_end
 	policyToken.Signature = string(signedStr)
 	policyToken.Directory = upload_dir
 	policyToken.Policy = string(debyte)
 	policyToken.Callback = string(callbackBase64)
 
-	// 添加日志输出
-	log.Printf("PolicyToken: %+v", policyToken)
+	// 签名等敏感字段由 PolicyToken.LogValue 脱敏
+	logger.Debug("生成策略令牌", slog.Any("policy_token", policyToken))
 
 	// 将策略令牌序列化为JSON
 	response, err := json.Marshal(policyToken)
 	if err != nil {
-		log.Println("策略令牌JSON序列化错误:", err)
+		logger.Error("策略令牌JSON序列化错误", slog.Any("error", err))
 	}
 
 	// 将JSON反序列化为map
@@ -134,8 +155,8 @@ func GetPolicyToken(upload_dir string, callbackUrl string) (*map[string]interfac
 }
 
 // GetUploadParams 获取上传参数，包括策略令牌
-func (s *FileService) GetUploadParams(uploadDir string, callbackUrl string) (*map[string]interface{}, error) {
-	policy, err := GetPolicyToken(uploadDir, callbackUrl)
+func (s *FileService) GetUploadParams(ctx context.Context, uploadDir string, callbackUrl string) (*map[string]interface{}, error) {
+	policy, err := s.GetPolicyToken(ctx, uploadDir, callbackUrl)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +169,8 @@ func (s *FileService) UploadCallback() error {
 }
 
 // GetUploadParams 处理上传参数的HTTP请求（支持JSON和表单格式）
-func GetUploadParams(c *gin.Context) {
+func (ctl *UploadController) GetUploadParams(c *gin.Context) {
+	logger := logging.FromContext(c.Request.Context())
 	var req struct {
 		UploadDir   string `json:"upload_dir" binding:"required"`
 		CallbackURL string `json:"callback_url" binding:"required"`
@@ -160,31 +182,21 @@ func GetUploadParams(c *gin.Context) {
 		req.UploadDir = c.PostForm("upload_dir")
 		req.CallbackURL = c.PostForm("callback_url")
 		if req.UploadDir == "" || req.CallbackURL == "" {
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": "请求参数格式错误或缺少必要字段",
-			})
-			log.Printf("请求参数解析失败: %v", err)
+			apierror.Respond(c, requireNonBlank("upload_dir", req.UploadDir, "callback_url", req.CallbackURL))
 			return
 		}
 	}
 
-	log.Printf("Received upload_dir: %s, callback_url: %s", req.UploadDir, req.CallbackURL)
-
-	// 创建 FileService 实例
-	fileService := NewFileService(config.DB)
-
 	// 调用 FileService 的 GetUploadParams 方法
-	policy, err := fileService.GetUploadParams(req.UploadDir, req.CallbackURL)
+	policy, err := ctl.files.GetUploadParams(c.Request.Context(), req.UploadDir, req.CallbackURL)
+	metrics.UploadPolicyIssued(err)
 	if err != nil {
 		// 如果有错误，返回错误信息和500状态码
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		log.Printf("获取策略令牌失败: %v", err)
+		apierror.Respond(c, apierror.New(http.StatusInternalServerError, apierror.CodeUploadPolicyFailed).WithCause(err))
 		return
 	}
 
 	// 成功时，返回策略令牌和200状态码
 	c.JSON(http.StatusOK, policy)
-	log.Printf("成功返回策略令牌: %+v", policy)
+	logger.Info("签发上传策略", slog.String("upload_dir", req.UploadDir), slog.String("callback_url", req.CallbackURL))
 }
@@ -0,0 +1,254 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/middleware"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/10240418/advertisement-management-system/backend/webhooks"
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookInput 创建或更新事件推送订阅的输入，更新时未提供的字段保持不变
+type WebhookInput struct {
+	Name   *string  `json:"name" binding:"omitempty,max=100"`
+	URL    *string  `json:"url" binding:"omitempty,max=2000"`
+	Events []string `json:"events"`
+	Active *bool    `json:"active"`
+}
+
+// WebhookController 管理事件推送订阅与投递记录
+type WebhookController struct {
+	store repository.Store
+}
+
+// NewWebhookController 创建 WebhookController
+func NewWebhookController(store repository.Store) *WebhookController {
+	return &WebhookController{store: store}
+}
+
+// ListWebhooks 分页获取事件推送订阅，不包含签名密钥
+func (ctl *WebhookController) ListWebhooks(c *gin.Context) {
+	pageNum, pageSize, opts := pagination(c)
+
+	subs, count, err := ctl.store.Webhooks().ListSubscriptions(c.Request.Context(), opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":     subs,
+		"total":    count,
+		"pageNum":  pageNum,
+		"pageSize": pageSize,
+	})
+}
+
+// CreateWebhook 创建事件推送订阅，签名密钥只在响应中返回这一次
+func (ctl *WebhookController) CreateWebhook(c *gin.Context) {
+	var input WebhookInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	sub := models.WebhookSubscription{Active: true}
+	if apiErr := input.apply(&sub, true); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+	secret, err := webhooks.NewSecret()
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	admin := middleware.CurrentAdmin(c)
+	sub.Secret = secret
+	sub.CreatedByID = admin.ID
+
+	ctx := c.Request.Context()
+	if err := ctl.store.Webhooks().CreateSubscription(ctx, &sub); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "创建事件推送订阅",
+		"id", sub.ID, "url", sub.URL, "events", sub.Events, "created_by", admin.Username)
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "订阅已创建，请立即保存签名密钥，之后无法再次查看",
+		"secret":  secret,
+		"webhook": sub,
+	})
+}
+
+// UpdateWebhook 修改订阅的名称、地址、事件或启用状态
+func (ctl *WebhookController) UpdateWebhook(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	var input WebhookInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierror.Respond(c, apierror.FromBinding(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	sub, err := ctl.store.Webhooks().GetSubscription(ctx, id)
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeWebhookNotFound))
+		return
+	}
+	if apiErr := input.apply(sub, false); apiErr != nil {
+		apierror.Respond(c, apiErr)
+		return
+	}
+	if err := ctl.store.Webhooks().SaveSubscription(ctx, sub); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeWebhookNotFound))
+		return
+	}
+
+	c.JSON(http.StatusOK, sub)
+}
+
+// DeleteWebhook 删除订阅及其全部投递记录，未发送的事件不再投递
+func (ctl *WebhookController) DeleteWebhook(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := ctl.store.Webhooks().DeleteSubscription(ctx, id); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeWebhookNotFound))
+		return
+	}
+
+	logging.FromContext(ctx).InfoContext(ctx, "删除事件推送订阅", "id", id, "deleted_by", middleware.CurrentAdmin(c).Username)
+	c.JSON(http.StatusOK, gin.H{"message": "订阅已删除"})
+}
+
+// ListWebhookDeliveries 分页获取订阅的投递日志，最新的在前
+func (ctl *WebhookController) ListWebhookDeliveries(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	pageNum, pageSize, opts := pagination(c)
+
+	ctx := c.Request.Context()
+	if _, err := ctl.store.Webhooks().GetSubscription(ctx, id); err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeWebhookNotFound))
+		return
+	}
+	deliveries, count, err := ctl.store.Webhooks().ListDeliveries(ctx, id, opts)
+	if err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":     deliveries,
+		"total":    count,
+		"pageNum":  pageNum,
+		"pageSize": pageSize,
+	})
+}
+
+// RetryWebhookDelivery 将已结束的投递重新加入发送队列，尝试次数从零开始计算
+func (ctl *WebhookController) RetryWebhookDelivery(c *gin.Context) {
+	id, ok := paramID(c, "id")
+	if !ok {
+		return
+	}
+	deliveryID, ok := paramID(c, "delivery_id")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	delivery, err := ctl.store.Webhooks().GetDelivery(ctx, deliveryID)
+	if err == nil && delivery.SubscriptionID != id {
+		err = repository.ErrNotFound
+	}
+	if err != nil {
+		apierror.Respond(c, notFound(err, apierror.CodeDeliveryNotFound))
+		return
+	}
+	if delivery.Status == models.DeliveryPending {
+		apierror.Respond(c, apierror.Conflict(apierror.CodeDeliveryPending))
+		return
+	}
+
+	delivery.Status = models.DeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+	delivery.DeliveredAt = nil
+	if err := ctl.store.Webhooks().UpdateDelivery(ctx, delivery); err != nil {
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, delivery)
+}
+
+// apply 校验输入并写入订阅，creating 为 true 时名称、地址与事件为必填
+func (input WebhookInput) apply(sub *models.WebhookSubscription, creating bool) *apierror.Error {
+	apiErr := apierror.BadRequest(apierror.CodeValidationFailed)
+	if input.Name != nil || creating {
+		name := ""
+		if input.Name != nil {
+			name = strings.TrimSpace(*input.Name)
+		}
+		if name == "" {
+			apiErr.WithDetails(apierror.Field("name", "required", nil))
+		}
+		sub.Name = name
+	}
+	if input.URL != nil || creating {
+		raw := ""
+		if input.URL != nil {
+			raw = strings.TrimSpace(*input.URL)
+		}
+		if raw == "" {
+			apiErr.WithDetails(apierror.Field("url", "required", nil))
+		} else if u, err := url.Parse(raw); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			apiErr.WithDetails(apierror.Field("url", "url", nil))
+		}
+		sub.URL = raw
+	}
+	if input.Events != nil || creating {
+		events := make([]string, 0, len(input.Events))
+		for i, event := range input.Events {
+			if !slices.Contains(models.WebhookEvents, event) {
+				apiErr.WithDetails(apierror.Field(fmt.Sprintf("events[%d]", i), "oneof",
+					map[string]any{"param": strings.Join(models.WebhookEvents, " ")}))
+				continue
+			}
+			if !slices.Contains(events, event) {
+				events = append(events, event)
+			}
+		}
+		if len(input.Events) == 0 {
+			apiErr.WithDetails(apierror.Field("events", "required", nil))
+		}
+		slices.Sort(events)
+		sub.Events = events
+	}
+	if input.Active != nil {
+		sub.Active = *input.Active
+	}
+	if len(apiErr.Details) > 0 {
+		return apiErr
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+package dayparting
+
+// dayparting 按分时规则判断投放在某一时刻是否可以播放
+//
+// 分时规则由若干播放时段组成，每个时段是一条按天重复的 RRULE 加上当地时间的开始、结束时刻；
+// 规则可以引用命名日历（节假日等），排除日历中的日期不播放，设置了包含日历时只在其中的日期播放。
+// 所有日期和时刻都按大厦所在时区解释，大厦未设置时区时使用 DEFAULT_TIMEZONE
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/region"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+// 判断结果的原因
+const (
+	ReasonEligible       = "eligible"
+	ReasonOutsideWindow  = "outside_window"
+	ReasonExcludedDate   = "excluded_calendar"
+	ReasonNotInCalendar  = "not_in_calendar"
+	ReasonDaypartMissing = "daypart_missing"
+)
+
+// Decision 投放在某一时刻的判断结果
+type Decision struct {
+	Eligible   bool   `json:"eligible"`
+	Reason     string `json:"reason"`
+	CalendarID *uint  `json:"calendar_id,omitempty"` // 因排除日历不能播放时命中的日历
+}
+
+// Engine 加载分时规则与日历并判断投放是否可播放
+type Engine struct {
+	store  repository.Store
+	region *region.Resolver
+}
+
+// NewEngine 创建判断引擎，regions 提供大厦所在时区
+func NewEngine(store repository.Store, regions *region.Resolver) *Engine {
+	return &Engine{store: store, region: regions}
+}
+
+// Region 返回大厦所在时区与语言
+func (e *Engine) Region(building *models.Building) region.Settings {
+	return e.region.For(building)
+}
+
+// Prepare 加载 placements 用到的分时规则和日历，返回可以反复判断的计划
+func (e *Engine) Prepare(ctx context.Context, placements []models.AdvertisementBuilding) (*Plan, error) {
+	var ids []uint
+	for _, placement := range placements {
+		if placement.DaypartID != nil {
+			ids = append(ids, *placement.DaypartID)
+		}
+	}
+	plan := &Plan{dayparts: map[uint]*daypart{}, calendars: map[uint]*calendar{}}
+	if len(ids) == 0 {
+		return plan, nil
+	}
+	dayparts, err := e.store.Dayparts().FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var calendarIDs []uint
+	for _, d := range dayparts {
+		calendarIDs = append(calendarIDs, d.IncludeCalendarIDs...)
+		calendarIDs = append(calendarIDs, d.ExcludeCalendarIDs...)
+	}
+	events, err := e.store.Calendars().EventsFor(ctx, calendarIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range calendarIDs {
+		plan.calendars[id] = &calendar{}
+	}
+	for _, event := range events {
+		compiled, err := compileEvent(event)
+		if err != nil {
+			return nil, fmt.Errorf("日历事件 %d: %w", event.ID, err)
+		}
+		plan.calendars[event.CalendarID].events = append(plan.calendars[event.CalendarID].events, compiled)
+	}
+	for _, d := range dayparts {
+		compiled, err := compileDaypart(d)
+		if err != nil {
+			return nil, fmt.Errorf("分时规则 %d: %w", d.ID, err)
+		}
+		plan.dayparts[d.ID] = compiled
+	}
+	return plan, nil
+}
+
+// Evaluate 判断单个投放在 at 时刻是否可播放，location 为大厦所在时区
+func (e *Engine) Evaluate(ctx context.Context, placement models.AdvertisementBuilding, location *time.Location, at time.Time) (Decision, error) {
+	plan, err := e.Prepare(ctx, []models.AdvertisementBuilding{placement})
+	if err != nil {
+		return Decision{}, err
+	}
+	return plan.Check(placement, at.In(location)), nil
+}
+
+// Plan 已加载的分时规则和日历
+type Plan struct {
+	dayparts  map[uint]*daypart
+	calendars map[uint]*calendar
+}
+
+// Check 判断投放在 local 时刻是否可播放，local 必须已经转换到大厦所在时区
+func (p *Plan) Check(placement models.AdvertisementBuilding, local time.Time) Decision {
+	if placement.DaypartID == nil {
+		return Decision{Eligible: true, Reason: ReasonEligible}
+	}
+	d, ok := p.dayparts[*placement.DaypartID]
+	if !ok {
+		return Decision{Reason: ReasonDaypartMissing}
+	}
+	date := truncate(local)
+	for _, id := range d.exclude {
+		if p.calendars[id].covers(date) {
+			calendarID := id
+			return Decision{Reason: ReasonExcludedDate, CalendarID: &calendarID}
+		}
+	}
+	if len(d.include) > 0 {
+		included := false
+		for _, id := range d.include {
+			if p.calendars[id].covers(date) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return Decision{Reason: ReasonNotInCalendar}
+		}
+	}
+	minute := local.Hour()*60 + local.Minute()
+	for _, w := range d.windows {
+		if minute >= w.from && minute < w.to && w.occurs(date) {
+			return Decision{Eligible: true, Reason: ReasonEligible}
+		}
+	}
+	return Decision{Reason: ReasonOutsideWindow}
+}
+
+// daypart 编译后的分时规则
+type daypart struct {
+	windows          []window
+	include, exclude []uint
+}
+
+// window 编译后的播放时段，from、to 为当天的分钟数
+type window struct {
+	rule     *Rule
+	start    time.Time // 没有 RRULE 时的起始日期
+	from, to int
+}
+
+func (w window) occurs(date time.Time) bool {
+	if w.rule != nil {
+		return w.rule.Occurs(date)
+	}
+	return w.start.IsZero() || !date.Before(w.start)
+}
+
+// calendar 编译后的日历
+type calendar struct {
+	events []event
+}
+
+// covers 判断日期是否在日历的任一事件中
+func (c *calendar) covers(date time.Time) bool {
+	if c == nil {
+		return false
+	}
+	for _, e := range c.events {
+		if e.covers(date) {
+			return true
+		}
+	}
+	return false
+}
+
+// event 编译后的日历事件，days 为每次发生覆盖的天数
+type event struct {
+	rule  *Rule
+	start time.Time
+	days  int
+}
+
+func (e event) covers(date time.Time) bool {
+	if date.Before(e.start) {
+		return false
+	}
+	if e.rule == nil {
+		return date.Before(e.start.AddDate(0, 0, e.days))
+	}
+	// 多天事件：只要前 days 天内有一次发生就覆盖当天
+	for i := 0; i < e.days; i++ {
+		if e.rule.Occurs(date.AddDate(0, 0, -i)) {
+			return true
+		}
+	}
+	return false
+}
+
+func compileEvent(e models.CalendarEvent) (event, error) {
+	start, err := ParseDate(e.StartDate)
+	if err != nil {
+		return event{}, err
+	}
+	end, err := ParseDate(e.EndDate)
+	if err != nil {
+		return event{}, err
+	}
+	compiled := event{start: start, days: max(int(end.Sub(start).Hours()/24), 1)}
+	if e.RRule != "" {
+		if compiled.rule, err = ParseRule(e.RRule, start); err != nil {
+			return event{}, err
+		}
+	}
+	return compiled, nil
+}
+
+func compileDaypart(d models.Daypart) (*daypart, error) {
+	compiled := &daypart{include: d.IncludeCalendarIDs, exclude: d.ExcludeCalendarIDs}
+	for i, w := range d.Windows {
+		cw, err := compileWindow(w)
+		if err != nil {
+			return nil, fmt.Errorf("时段 %d: %w", i, err)
+		}
+		compiled.windows = append(compiled.windows, cw)
+	}
+	return compiled, nil
+}
+
+// compileWindow 编译播放时段
+func compileWindow(w models.DaypartWindow) (window, error) {
+	var compiled window
+	var ok bool
+	if compiled.from, ok = ParseClock(w.Start); !ok || compiled.from >= 24*60 {
+		return window{}, fmt.Errorf("无效的开始时间 %q", w.Start)
+	}
+	if compiled.to, ok = ParseClock(w.End); !ok {
+		return window{}, fmt.Errorf("无效的结束时间 %q", w.End)
+	}
+	if compiled.to <= compiled.from {
+		return window{}, fmt.Errorf("结束时间 %s 必须晚于开始时间 %s", w.End, w.Start)
+	}
+	if w.StartDate != "" {
+		var err error
+		if compiled.start, err = ParseDate(w.StartDate); err != nil {
+			return window{}, fmt.Errorf("无效的起始日期 %q", w.StartDate)
+		}
+	}
+	if w.RRule != "" {
+		var err error
+		if compiled.rule, err = ParseRule(w.RRule, compiled.start); err != nil {
+			return window{}, err
+		}
+	}
+	return compiled, nil
+}
+
+// ParseClock 解析 HH:MM 格式的时刻，返回当天的分钟数，允许 24:00 表示一天结束
+func ParseClock(value string) (int, bool) {
+	hh, mm, ok := strings.Cut(value, ":")
+	if !ok || len(hh) != 2 || len(mm) != 2 {
+		return 0, false
+	}
+	h, err1 := strconv.Atoi(hh)
+	m, err2 := strconv.Atoi(mm)
+	if err1 != nil || err2 != nil || h < 0 || m < 0 || m > 59 || h > 24 || (h == 24 && m != 0) {
+		return 0, false
+	}
+	return h*60 + m, true
+}
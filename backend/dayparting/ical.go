@@ -0,0 +1,257 @@
+package dayparting
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+)
+
+// ErrNotICalendar 文件不是 iCalendar 格式
+var ErrNotICalendar = errors.New("不是有效的 iCalendar 文件")
+
+// maxEventDays 单个事件最多覆盖的天数
+const maxEventDays = 366
+
+// Skipped 导入时跳过的事件及原因
+type Skipped struct {
+	UID     string `json:"uid"`
+	Summary string `json:"summary"`
+	Reason  string `json:"reason"`
+}
+
+// property iCalendar 中的一行属性
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// ParseICalendar 读取 iCalendar 文件中的 VEVENT，按全天事件导入
+// 带时间的事件覆盖其开始到结束之间的每一天；已取消的事件、无法解析的事件，以及带 EXDATE（例外日期）
+// 或 RECURRENCE-ID（单次修改）的事件会被跳过并返回原因，后两者导入后会覆盖本应排除的日期
+func ParseICalendar(r io.Reader) ([]models.CalendarEvent, []Skipped, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, nil, ErrNotICalendar
+	}
+
+	var events []models.CalendarEvent
+	var skipped []Skipped
+	var current []property
+	inEvent, depth := false, 0
+	for _, line := range lines {
+		prop, ok := parseProperty(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT") && !inEvent:
+			inEvent, depth, current = true, 0, nil
+		case prop.name == "BEGIN" && inEvent:
+			depth++ // VALARM 等嵌套组件
+		case prop.name == "END" && inEvent && depth > 0:
+			depth--
+		case prop.name == "END" && inEvent && strings.EqualFold(prop.value, "VEVENT"):
+			inEvent = false
+			event, skip := buildEvent(current)
+			if skip != nil {
+				skipped = append(skipped, *skip)
+				continue
+			}
+			events = append(events, event)
+		case inEvent && depth == 0:
+			current = append(current, prop)
+		}
+	}
+	return events, skipped, nil
+}
+
+// buildEvent 把 VEVENT 的属性转换为日历事件
+func buildEvent(props []property) (models.CalendarEvent, *Skipped) {
+	var event models.CalendarEvent
+	var start, end *property
+	var duration, unsupported string
+	for i := range props {
+		p := &props[i]
+		switch p.name {
+		case "UID":
+			event.UID = p.value
+		case "SUMMARY":
+			event.Summary = unescape(p.value)
+		case "DTSTART":
+			start = p
+		case "DTEND":
+			end = p
+		case "DURATION":
+			duration = p.value
+		case "RRULE":
+			event.RRule = p.value
+		case "EXDATE":
+			unsupported = "不支持 EXDATE（重复事件的例外日期），请在日历中手工添加各次事件"
+		case "RECURRENCE-ID":
+			unsupported = "不支持 RECURRENCE-ID（重复事件的单次修改），请在日历中手工调整"
+		case "STATUS":
+			if strings.EqualFold(p.value, "CANCELLED") {
+				return event, skip(event, "事件已取消")
+			}
+		}
+	}
+	if unsupported != "" {
+		return event, skip(event, unsupported)
+	}
+	if start == nil {
+		return event, skip(event, "缺少 DTSTART")
+	}
+	startDate, _, err := parseDateValue(start.value)
+	if err != nil {
+		return event, skip(event, "无法解析 DTSTART")
+	}
+
+	// 结束日期不包含在内：DATE 格式的 DTEND 本身就是排他的，带时间的事件结束于零点时也不包含当天
+	endDate := startDate.AddDate(0, 0, 1)
+	switch {
+	case end != nil:
+		date, midnight, err := parseDateValue(end.value)
+		if err != nil {
+			return event, skip(event, "无法解析 DTEND")
+		}
+		endDate = date
+		if !midnight {
+			endDate = date.AddDate(0, 0, 1)
+		}
+	case duration != "":
+		days, err := parseDuration(duration)
+		if err != nil {
+			return event, skip(event, err.Error())
+		}
+		endDate = startDate.AddDate(0, 0, max(days, 1))
+	}
+	if !endDate.After(startDate) {
+		endDate = startDate.AddDate(0, 0, 1)
+	}
+	if endDate.Sub(startDate) > maxEventDays*24*time.Hour {
+		return event, skip(event, fmt.Sprintf("事件超过 %d 天", maxEventDays))
+	}
+	if event.RRule != "" {
+		if _, err := ParseRule(event.RRule, startDate); err != nil {
+			return event, skip(event, "RRULE "+err.Error())
+		}
+	}
+	event.StartDate = startDate.Format(models.DateLayout)
+	event.EndDate = endDate.Format(models.DateLayout)
+	if event.Summary == "" {
+		event.Summary = event.UID
+	}
+	return event, nil
+}
+
+func skip(event models.CalendarEvent, reason string) *Skipped {
+	return &Skipped{UID: event.UID, Summary: event.Summary, Reason: reason}
+}
+
+// unfold 读取全部内容行并合并折行（以空格或制表符开头的行属于上一行）
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, ErrNotICalendar
+	}
+	if len(lines) > 0 {
+		lines[0] = strings.TrimPrefix(lines[0], "\ufeff")
+	}
+	return lines, nil
+}
+
+// parseProperty 解析 NAME;PARAM=VALUE:value 格式的内容行，参数值可以用双引号包含冒号
+func parseProperty(line string) (property, bool) {
+	quoted := false
+	colon := -1
+	for i, ch := range line {
+		if ch == '"' {
+			quoted = !quoted
+		} else if ch == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon <= 0 {
+		return property{}, false
+	}
+	parts := strings.Split(line[:colon], ";")
+	prop := property{name: strings.ToUpper(parts[0]), params: map[string]string{}, value: line[colon+1:]}
+	for _, param := range parts[1:] {
+		key, val, _ := strings.Cut(param, "=")
+		prop.params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+	}
+	return prop, true
+}
+
+// parseDateValue 解析 DATE（20240101）或 DATE-TIME（20240101T090000、20240101T090000Z）的日期部分
+// midnight 表示该值正好是一天的开始
+func parseDateValue(value string) (date time.Time, midnight bool, err error) {
+	value = strings.TrimSpace(value)
+	if len(value) < 8 {
+		return time.Time{}, false, errors.New("日期过短")
+	}
+	date, err = time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	rest := strings.TrimSuffix(value[8:], "Z")
+	return date, rest == "" || rest == "T000000", nil
+}
+
+// parseDuration 解析 DURATION 中的天数，只支持 PnW、PnD（可带时间部分，按整天向上取整）
+func parseDuration(value string) (int, error) {
+	value = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(value)), "+")
+	if !strings.HasPrefix(value, "P") {
+		return 0, fmt.Errorf("无法解析 DURATION %q", value)
+	}
+	datePart, timePart, _ := strings.Cut(value[1:], "T")
+	days := 0
+	if n, ok := strings.CutSuffix(datePart, "W"); ok {
+		weeks, err := strconv.Atoi(n)
+		if err != nil {
+			return 0, fmt.Errorf("无法解析 DURATION %q", value)
+		}
+		days = weeks * 7
+	} else if n, ok := strings.CutSuffix(datePart, "D"); ok {
+		var err error
+		if days, err = strconv.Atoi(n); err != nil {
+			return 0, fmt.Errorf("无法解析 DURATION %q", value)
+		}
+	} else if datePart != "" {
+		return 0, fmt.Errorf("无法解析 DURATION %q", value)
+	}
+	if timePart != "" {
+		days++
+	}
+	return days, nil
+}
+
+// unescape 还原 TEXT 值中的转义字符
+func unescape(value string) string {
+	replacer := strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return strings.TrimSpace(replacer.Replace(value))
+}
@@ -0,0 +1,358 @@
+package dayparting
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+)
+
+// 只支持按天及以上粒度的重复规则（RFC 5545 RRULE 的子集），一天之内的时段由分时规则的 Start/End 表示
+
+// Frequency 重复频率
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+// maxScanDays COUNT 规则向后查找最后一次发生日期的上限，约 100 年
+const maxScanDays = 366 * 100
+
+// weekdayNum BYDAY 中的一项，N 为 0 表示每个该星期几，正负数表示当月（或当年）的第几个、倒数第几个
+type weekdayNum struct {
+	N   int
+	Day time.Weekday
+}
+
+// Rule 解析后的重复规则
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      time.Time // 零值表示不限
+	ByDay      []weekdayNum
+	ByMonthDay []int
+	ByMonth    []int
+
+	start time.Time // DTSTART，零值表示未指定
+	last  time.Time // 设置了 COUNT 时最后一次发生的日期
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// ParseDate 解析 YYYY-MM-DD 格式的日期，返回 UTC 零点
+func ParseDate(value string) (time.Time, error) {
+	return time.Parse(models.DateLayout, value)
+}
+
+// ParseRule 解析 RRULE，start 为规则的起始日期（零值表示未指定）
+// INTERVAL 大于 1、COUNT 以及需要从起始日期推断日期的规则（例如没有 BYDAY 的 WEEKLY）必须指定起始日期
+func ParseRule(value string, start time.Time) (*Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, errors.New("RRULE 为空")
+	}
+	if !start.IsZero() {
+		start = truncate(start)
+	}
+	rule := &Rule{Interval: 1, Freq: -1, start: start}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		val = strings.ToUpper(strings.TrimSpace(val))
+		if !ok || key == "" || val == "" {
+			return nil, fmt.Errorf("无法解析 %q", part)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%s 重复出现", key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			err = rule.parseFreq(val)
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(val)
+			if err == nil && rule.Interval < 1 {
+				err = errors.New("INTERVAL 必须大于 0")
+			}
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(val)
+			if err == nil && rule.Count < 1 {
+				err = errors.New("COUNT 必须大于 0")
+			}
+		case "UNTIL":
+			rule.Until, err = parseUntil(val)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(val)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseInts(val, 1, 31, true)
+		case "BYMONTH":
+			rule.ByMonth, err = parseInts(val, 1, 12, false)
+		case "WKST":
+			// 只影响 WEEKLY 且 INTERVAL 大于 1 时的分周，固定按周一开始
+			if _, ok := weekdays[val]; !ok {
+				err = fmt.Errorf("无效的 WKST %q", val)
+			}
+		default:
+			err = fmt.Errorf("不支持 %s", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if rule.Freq < 0 {
+		return nil, errors.New("缺少 FREQ")
+	}
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		return nil, errors.New("COUNT 与 UNTIL 不能同时使用")
+	}
+	for _, d := range rule.ByDay {
+		if d.N != 0 && rule.Freq != Monthly && rule.Freq != Yearly {
+			return nil, errors.New("只有 MONTHLY、YEARLY 规则的 BYDAY 可以带序号")
+		}
+	}
+	if rule.Freq == Weekly && len(rule.ByMonthDay) > 0 {
+		return nil, errors.New("WEEKLY 规则不能使用 BYMONTHDAY")
+	}
+	if start.IsZero() && rule.needsStart() {
+		return nil, errors.New("INTERVAL、COUNT 或没有 BYDAY/BYMONTHDAY 的规则需要起始日期")
+	}
+	if rule.Count > 0 {
+		rule.last = rule.findLast()
+	}
+	return rule, nil
+}
+
+// Occurs 判断规则是否在 date 当天发生，date 只使用年月日
+func (r *Rule) Occurs(date time.Time) bool {
+	date = truncate(date)
+	if !r.start.IsZero() && date.Before(r.start) {
+		return false
+	}
+	if !r.Until.IsZero() && date.After(r.Until) {
+		return false
+	}
+	if r.Count > 0 && (r.last.IsZero() || date.After(r.last)) {
+		return false
+	}
+	return r.matches(date)
+}
+
+// matches 不考虑 UNTIL、COUNT 时 date 是否命中
+func (r *Rule) matches(date time.Time) bool {
+	if len(r.ByMonth) > 0 && !slices.Contains(r.ByMonth, int(date.Month())) {
+		return false
+	}
+	if !r.inInterval(date) {
+		return false
+	}
+	switch r.Freq {
+	case Daily:
+		return r.matchMonthDay(date) && r.matchDay(date, false)
+	case Weekly:
+		if len(r.ByDay) == 0 {
+			return date.Weekday() == r.start.Weekday()
+		}
+		return r.matchDay(date, false)
+	case Monthly:
+		if len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+			return date.Day() == r.start.Day()
+		}
+		return r.matchMonthDay(date) && r.matchDay(date, false)
+	default:
+		if len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+			if len(r.ByMonth) == 0 && date.Month() != r.start.Month() {
+				return false
+			}
+			return date.Day() == r.start.Day()
+		}
+		// 有 BYMONTH 时 BYDAY 的序号按月计算，否则按年计算
+		return r.matchMonthDay(date) && r.matchDay(date, len(r.ByMonth) == 0)
+	}
+}
+
+// inInterval 判断 date 所在的天、周、月、年是否落在 INTERVAL 的步长上
+func (r *Rule) inInterval(date time.Time) bool {
+	if r.Interval == 1 {
+		return true
+	}
+	var n int
+	switch r.Freq {
+	case Daily:
+		n = int(date.Sub(r.start).Hours() / 24)
+	case Weekly:
+		n = int(weekStart(date).Sub(weekStart(r.start)).Hours() / 24 / 7)
+	case Monthly:
+		n = (date.Year()-r.start.Year())*12 + int(date.Month()) - int(r.start.Month())
+	default:
+		n = date.Year() - r.start.Year()
+	}
+	return n%r.Interval == 0
+}
+
+func (r *Rule) matchMonthDay(date time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	days := daysIn(date.Year(), date.Month())
+	for _, d := range r.ByMonthDay {
+		if d == date.Day() || (d < 0 && days+d+1 == date.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchDay(date time.Time, yearly bool) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, d := range r.ByDay {
+		if d.Day != date.Weekday() {
+			continue
+		}
+		if d.N == 0 {
+			return true
+		}
+		// 当天是本月（本年）第几个、倒数第几个该星期几
+		var index, total int
+		if yearly {
+			index = (date.YearDay()-1)/7 + 1
+			total = index + (daysInYear(date.Year())-date.YearDay())/7
+		} else {
+			index = (date.Day()-1)/7 + 1
+			total = index + (daysIn(date.Year(), date.Month())-date.Day())/7
+		}
+		if d.N == index || (d.N < 0 && total+d.N+1 == index) {
+			return true
+		}
+	}
+	return false
+}
+
+// needsStart 规则是否依赖起始日期
+func (r *Rule) needsStart() bool {
+	if r.Interval > 1 || r.Count > 0 {
+		return true
+	}
+	switch r.Freq {
+	case Weekly:
+		return len(r.ByDay) == 0
+	case Monthly, Yearly:
+		return len(r.ByDay) == 0 && len(r.ByMonthDay) == 0
+	}
+	return false
+}
+
+// findLast 从起始日期开始数出第 COUNT 次发生的日期，找不到时返回零值
+func (r *Rule) findLast() time.Time {
+	found := 0
+	for date, i := r.start, 0; i < maxScanDays; date, i = date.AddDate(0, 0, 1), i+1 {
+		if r.matches(date) {
+			found++
+			if found == r.Count {
+				return date
+			}
+		}
+	}
+	return time.Time{}
+}
+
+func (r *Rule) parseFreq(val string) error {
+	switch val {
+	case "DAILY":
+		r.Freq = Daily
+	case "WEEKLY":
+		r.Freq = Weekly
+	case "MONTHLY":
+		r.Freq = Monthly
+	case "YEARLY":
+		r.Freq = Yearly
+	case "SECONDLY", "MINUTELY", "HOURLY":
+		return fmt.Errorf("不支持 FREQ=%s，一天之内的时段请使用开始、结束时间", val)
+	default:
+		return fmt.Errorf("无效的 FREQ %q", val)
+	}
+	return nil
+}
+
+// parseUntil 解析 UNTIL，支持 DATE 和 DATE-TIME 两种格式，只取日期部分
+func parseUntil(val string) (time.Time, error) {
+	if len(val) < 8 {
+		return time.Time{}, fmt.Errorf("无效的 UNTIL %q", val)
+	}
+	t, err := time.Parse("20060102", val[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("无效的 UNTIL %q", val)
+	}
+	return t, nil
+}
+
+func parseByDay(val string) ([]weekdayNum, error) {
+	var result []weekdayNum
+	for _, item := range strings.Split(val, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("无效的 BYDAY %q", item)
+		}
+		day, ok := weekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("无效的 BYDAY %q", item)
+		}
+		n := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			var err error
+			n, err = strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("无效的 BYDAY %q", item)
+			}
+		}
+		result = append(result, weekdayNum{N: n, Day: day})
+	}
+	return result, nil
+}
+
+// parseInts 解析逗号分隔的整数列表，negative 表示允许 -max 到 -1 的倒数值
+func parseInts(val string, min, max int, negative bool) ([]int, error) {
+	var result []int
+	for _, item := range strings.Split(val, ",") {
+		n, err := strconv.Atoi(item)
+		valid := err == nil && ((n >= min && n <= max) || (negative && n <= -min && n >= -max))
+		if !valid {
+			return nil, fmt.Errorf("无效的取值 %q", item)
+		}
+		result = append(result, n)
+	}
+	return result, nil
+}
+
+func truncate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// weekStart 返回 date 所在周的周一
+func weekStart(date time.Time) time.Time {
+	offset := (int(date.Weekday()) + 6) % 7
+	return date.AddDate(0, 0, -offset)
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func daysInYear(year int) int {
+	return time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
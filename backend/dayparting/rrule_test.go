@@ -0,0 +1,196 @@
+package dayparting
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// occurrences 返回 [from, to] 内规则发生的日期
+func occurrences(rule *Rule, from, to time.Time) []string {
+	dates := []string{}
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		if rule.Occurs(date) {
+			dates = append(dates, date.Format("2006-01-02"))
+		}
+	}
+	return dates
+}
+
+func mustDate(t *testing.T, value string) time.Time {
+	t.Helper()
+	date, err := ParseDate(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return date
+}
+
+// TestRuleRFC5545 RFC 5545 3.8.5.3 中的示例（只保留日期），以及序号、月末与闰年的补充用例。
+// to 为检查的最后一天，检查从 start 开始
+func TestRuleRFC5545(t *testing.T) {
+	tests := []struct {
+		name  string
+		rrule string
+		start string
+		to    string
+		want  string // 空格分隔的 MM-DD 或 YYYY-MM-DD，省略年份时沿用上一个日期的年份
+	}{
+		{"每天 10 次", "FREQ=DAILY;COUNT=10", "1997-09-02", "1997-12-31",
+			"1997-09-02 09-03 09-04 09-05 09-06 09-07 09-08 09-09 09-10 09-11"},
+		{"每隔一天", "FREQ=DAILY;INTERVAL=2", "1997-09-02", "1997-09-12",
+			"1997-09-02 09-04 09-06 09-08 09-10 09-12"},
+		{"每 10 天共 5 次", "FREQ=DAILY;INTERVAL=10;COUNT=5", "1997-09-02", "1997-12-31",
+			"1997-09-02 09-12 09-22 10-02 10-12"},
+		{"每天直到 UNTIL（包含当天）", "FREQ=DAILY;UNTIL=19970905", "1997-09-02", "1997-12-31",
+			"1997-09-02 09-03 09-04 09-05"},
+		{"每周 10 次", "FREQ=WEEKLY;COUNT=10", "1997-09-02", "1998-12-31",
+			"1997-09-02 09-09 09-16 09-23 09-30 10-07 10-14 10-21 10-28 11-04"},
+		{"每隔一周", "FREQ=WEEKLY;INTERVAL=2;WKST=SU", "1997-09-02", "1998-02-17",
+			"1997-09-02 09-16 09-30 10-14 10-28 11-11 11-25 12-09 12-23 1998-01-06 01-20 02-03 02-17"},
+		{"每周二、四共五周", "FREQ=WEEKLY;COUNT=10;WKST=SU;BYDAY=TU,TH", "1997-09-02", "1998-12-31",
+			"1997-09-02 09-04 09-09 09-11 09-16 09-18 09-23 09-25 09-30 10-02"},
+		{"隔周一、三、五", "FREQ=WEEKLY;INTERVAL=2;UNTIL=19971222;WKST=SU;BYDAY=MO,WE,FR", "1997-09-01", "1998-12-31",
+			"1997-09-01 09-03 09-05 09-15 09-17 09-19 09-29 10-01 10-03 10-13 10-15 10-17 10-27 10-29 10-31 11-10 11-12 11-14 11-24 11-26 11-28 12-08 12-10 12-12 12-22"},
+		{"隔周二、四共 8 次", "FREQ=WEEKLY;INTERVAL=2;COUNT=8;WKST=SU;BYDAY=TU,TH", "1997-09-02", "1998-12-31",
+			"1997-09-02 09-04 09-16 09-18 09-30 10-02 10-14 10-16"},
+		// 起始日期是周三，同一周（周一开始）的周日仍然发生
+		{"隔周从周中开始", "FREQ=WEEKLY;INTERVAL=2;WKST=MO;BYDAY=MO,SU", "2026-10-14", "2026-11-16",
+			"2026-10-18 10-26 11-01 11-09 11-15"},
+		{"每月第一个周五", "FREQ=MONTHLY;COUNT=10;BYDAY=1FR", "1997-09-05", "1998-12-31",
+			"1997-09-05 10-03 11-07 12-05 1998-01-02 02-06 03-06 04-03 05-01 06-05"},
+		{"隔月第一个和最后一个周日", "FREQ=MONTHLY;INTERVAL=2;COUNT=10;BYDAY=1SU,-1SU", "1997-09-07", "1998-12-31",
+			"1997-09-07 09-28 11-02 11-30 1998-01-04 01-25 03-01 03-29 05-03 05-31"},
+		{"每月倒数第二个周一", "FREQ=MONTHLY;COUNT=6;BYDAY=-2MO", "1997-09-22", "1998-12-31",
+			"1997-09-22 10-20 11-17 12-22 1998-01-19 02-16"},
+		{"每月倒数第三天", "FREQ=MONTHLY;BYMONTHDAY=-3", "1997-09-28", "1998-02-28",
+			"1997-09-28 10-29 11-28 12-29 1998-01-29 02-26"},
+		{"每月第一天和最后一天", "FREQ=MONTHLY;COUNT=10;BYMONTHDAY=1,-1", "1997-09-30", "1998-12-31",
+			"1997-09-30 10-01 10-31 11-01 11-30 12-01 12-31 1998-01-01 01-31 02-01"},
+		{"闰年的月末", "FREQ=MONTHLY;BYMONTHDAY=-1", "2024-01-01", "2024-04-30",
+			"2024-01-31 02-29 03-31 04-30"},
+		{"每 18 个月的 10 到 15 日", "FREQ=MONTHLY;INTERVAL=18;COUNT=10;BYMONTHDAY=10,11,12,13,14,15", "1997-09-10", "2000-12-31",
+			"1997-09-10 09-11 09-12 09-13 09-14 09-15 1999-03-10 03-11 03-12 03-13"},
+		{"黑色星期五", "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13", "1997-09-02", "2000-12-31",
+			"1998-02-13 03-13 11-13 1999-08-13 2000-10-13"},
+		{"每年第 20 个周一", "FREQ=YEARLY;BYDAY=20MO", "1997-05-19", "1999-12-31",
+			"1997-05-19 1998-05-18 1999-05-17"},
+		{"每年三月的每个周四", "FREQ=YEARLY;BYMONTH=3;BYDAY=TH", "1997-03-13", "1998-12-31",
+			"1997-03-13 03-20 03-27 1998-03-05 03-12 03-19 03-26"},
+		{"每 4 年十一月第一个周一之后的周二", "FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8", "1996-11-05", "2004-12-31",
+			"1996-11-05 2000-11-07 2004-11-02"},
+		// 没有 BYMONTH 时 YEARLY 的序号按年计算，有 BYMONTH 时按月计算
+		{"每年第一个周一", "FREQ=YEARLY;BYDAY=1MO", "1997-01-01", "1998-12-31",
+			"1997-01-06 1998-01-05"},
+		{"每年最后一个周五", "FREQ=YEARLY;BYDAY=-1FR", "1997-01-01", "1998-12-31",
+			"1997-12-26 1998-12-25"},
+		{"每年一月最后一个周一", "FREQ=YEARLY;BYMONTH=1;BYDAY=-1MO", "1997-01-01", "1998-12-31",
+			"1997-01-27 1998-01-26"},
+		{"十一月第四个周四", "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH", "2001-01-01", "2001-12-31",
+			"2001-11-22"},
+		{"十一月最后一个周四", "FREQ=YEARLY;BYMONTH=11;BYDAY=-1TH", "2001-01-01", "2001-12-31",
+			"2001-11-29"},
+		// 按月计算时每个月都有第一个周一
+		{"每月第一个周一", "FREQ=MONTHLY;BYDAY=1MO", "1997-01-01", "1997-04-30",
+			"1997-01-06 02-03 03-03 04-07"},
+		// 不存在的日期被忽略，COUNT 只计算实际发生的日期
+		{"闰日每年", "FREQ=YEARLY;COUNT=3", "2024-02-29", "2040-12-31",
+			"2024-02-29 2028-02-29 2032-02-29"},
+		{"COUNT 永远达不到", "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30;COUNT=1", "2024-01-01", "2030-12-31", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := mustDate(t, tt.start)
+			rule, err := ParseRule(tt.rrule, start)
+			if err != nil {
+				t.Fatalf("ParseRule(%q): %v", tt.rrule, err)
+			}
+			got := occurrences(rule, start, mustDate(t, tt.to))
+			if want := expandDates(tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s\n得到 %v\n期望 %v", tt.rrule, got, want)
+			}
+		})
+	}
+}
+
+// expandDates 补全省略了年份的日期
+func expandDates(value string) []string {
+	dates := []string{}
+	year := ""
+	for _, date := range strings.Fields(value) {
+		if len(date) == len("2006-01-02") {
+			year = date[:5]
+		} else {
+			date = year + date
+		}
+		dates = append(dates, date)
+	}
+	return dates
+}
+
+// TestRuleWithoutStart 不依赖起始日期的规则可以不指定起始日期
+func TestRuleWithoutStart(t *testing.T) {
+	rule, err := ParseRule("RRULE:FREQ=WEEKLY;BYDAY=SA,SU", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := occurrences(rule, mustDate(t, "2026-10-12"), mustDate(t, "2026-10-25"))
+	if want := []string{"2026-10-17", "2026-10-18", "2026-10-24", "2026-10-25"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("得到 %v，期望 %v", got, want)
+	}
+}
+
+// TestRuleBeforeStart 起始日期之前不发生
+func TestRuleBeforeStart(t *testing.T) {
+	rule, err := ParseRule("FREQ=DAILY", mustDate(t, "2026-10-15"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := occurrences(rule, mustDate(t, "2026-10-13"), mustDate(t, "2026-10-16"))
+	if want := []string{"2026-10-15", "2026-10-16"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("得到 %v，期望 %v", got, want)
+	}
+}
+
+func TestParseRuleErrors(t *testing.T) {
+	start := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		rrule string
+		start time.Time
+	}{
+		{"", start},
+		{"BYDAY=MO", start},
+		{"FREQ=HOURLY", start},
+		{"FREQ=SOMETIMES", start},
+		{"FREQ=DAILY;FREQ=WEEKLY", start},
+		{"FREQ=DAILY;INTERVAL=0", start},
+		{"FREQ=DAILY;COUNT=0", start},
+		{"FREQ=DAILY;COUNT=3;UNTIL=20261231", start},
+		{"FREQ=DAILY;UNTIL=2026", start},
+		{"FREQ=WEEKLY;BYDAY=1MO", start},
+		{"FREQ=DAILY;BYDAY=-1FR", start},
+		{"FREQ=WEEKLY;BYMONTHDAY=1", start},
+		{"FREQ=MONTHLY;BYDAY=0MO", start},
+		{"FREQ=MONTHLY;BYDAY=54MO", start},
+		{"FREQ=MONTHLY;BYDAY=XX", start},
+		{"FREQ=MONTHLY;BYMONTHDAY=32", start},
+		{"FREQ=MONTHLY;BYMONTHDAY=0", start},
+		{"FREQ=YEARLY;BYMONTH=-1", start},
+		{"FREQ=WEEKLY;WKST=XX", start},
+		{"FREQ=MONTHLY;BYDAY=MO;BYSETPOS=-1", start},
+		{"FREQ=YEARLY;BYWEEKNO=20", start},
+		{"FREQ=DAILY;;", start},
+		// 需要起始日期
+		{"FREQ=DAILY;INTERVAL=2", time.Time{}},
+		{"FREQ=DAILY;COUNT=5", time.Time{}},
+		{"FREQ=WEEKLY", time.Time{}},
+		{"FREQ=MONTHLY", time.Time{}},
+		{"FREQ=YEARLY;BYMONTH=3", time.Time{}},
+	}
+	for _, tt := range tests {
+		if _, err := ParseRule(tt.rrule, tt.start); err == nil {
+			t.Errorf("ParseRule(%q)（起始日期为空：%v）没有返回错误", tt.rrule, tt.start.IsZero())
+		}
+	}
+}
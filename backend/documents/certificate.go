@@ -0,0 +1,79 @@
+package documents
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// CertificatePlacement 投放证明中的单个大厦投放记录
+type CertificatePlacement struct {
+	BuildingID   uint
+	BuildingName string
+	BlgID        string
+	Address      string
+	PlayDuration int64 // 以秒为单位
+}
+
+// PlacementCertificate 广告投放证明的内容
+type PlacementCertificate struct {
+	AdvertisementID uint
+	Title           string
+	Status          string
+	VideoDuration   int64 // 以秒为单位
+	CreatedAt       time.Time
+	GeneratedAt     time.Time
+	Placements      []CertificatePlacement
+}
+
+// WritePlacementCertificate 生成广告投放证明 PDF 并写入 w
+func WritePlacementCertificate(w io.Writer, cert PlacementCertificate) error {
+	doc := newDocument(fmt.Sprintf("Placement Certificate #%d", cert.AdvertisementID))
+	doc.pdf.AddPage()
+
+	doc.font("B", 18)
+	doc.cell(0, 12, "Placement Certificate", "", 1, "C")
+	doc.pdf.Ln(4)
+
+	doc.font("", 11)
+	rows := [][2]string{
+		{"Advertisement ID", fmt.Sprintf("%d", cert.AdvertisementID)},
+		{"Title", cert.Title},
+		{"Status", cert.Status},
+		{"Video duration", fmt.Sprintf("%d s", cert.VideoDuration)},
+		{"Created at", cert.CreatedAt.Format(time.RFC3339)},
+		{"Generated at", cert.GeneratedAt.Format(time.RFC3339)},
+		{"Buildings", fmt.Sprintf("%d", len(cert.Placements))},
+	}
+	for _, row := range rows {
+		doc.cell(45, 7, row[0], "", 0, "L")
+		doc.cell(0, 7, row[1], "", 1, "L")
+	}
+	doc.pdf.Ln(6)
+
+	// 投放明细表
+	widths := []float64{20, 65, 30, 50, 25}
+	headers := []string{"ID", "Building", "BLG ID", "Address", "Play (s)"}
+	doc.font("B", 10)
+	for i, header := range headers {
+		doc.cell(widths[i], 8, header, "1", 0, "C")
+	}
+	doc.pdf.Ln(-1)
+
+	doc.font("", 9)
+	var total int64
+	for _, p := range cert.Placements {
+		doc.cell(widths[0], 7, fmt.Sprintf("%d", p.BuildingID), "1", 0, "C")
+		doc.cell(widths[1], 7, p.BuildingName, "1", 0, "L")
+		doc.cell(widths[2], 7, p.BlgID, "1", 0, "L")
+		doc.cell(widths[3], 7, p.Address, "1", 0, "L")
+		doc.cell(widths[4], 7, fmt.Sprintf("%d", p.PlayDuration), "1", 1, "R")
+		total += p.PlayDuration
+	}
+
+	doc.font("B", 9)
+	doc.cell(widths[0]+widths[1]+widths[2]+widths[3], 7, "Total play duration per loop", "1", 0, "R")
+	doc.cell(widths[4], 7, fmt.Sprintf("%d", total), "1", 1, "R")
+
+	return doc.output(w)
+}
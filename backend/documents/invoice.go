@@ -0,0 +1,92 @@
+package documents
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// InvoiceLine 发票中单栋大厦的费用明细
+type InvoiceLine struct {
+	BuildingID   uint
+	BuildingName string
+	Tier         string
+	PlayDuration int64 // 以秒为单位
+	Hours        int
+	Seconds      int64
+	Amount       int64 // 以货币的最小单位计
+}
+
+// Invoice 发票的内容
+type Invoice struct {
+	ID                 uint
+	AdvertisementID    uint
+	AdvertisementTitle string
+	RateCardName       string
+	Currency           string
+	PeriodStart        string
+	PeriodEnd          string
+	Status             string
+	Total              int64
+	IssuedAt           time.Time
+	VoidedAt           *time.Time
+	Lines              []InvoiceLine
+}
+
+// WriteInvoice 生成发票 PDF 并写入 w
+func WriteInvoice(w io.Writer, invoice Invoice) error {
+	doc := newDocument(fmt.Sprintf("Invoice #%d", invoice.ID))
+	doc.pdf.AddPage()
+
+	doc.font("B", 18)
+	doc.cell(0, 12, fmt.Sprintf("Invoice #%d", invoice.ID), "", 1, "C")
+	doc.pdf.Ln(4)
+
+	doc.font("", 11)
+	rows := [][2]string{
+		{"Advertisement", fmt.Sprintf("#%d %s", invoice.AdvertisementID, invoice.AdvertisementTitle)},
+		{"Rate card", invoice.RateCardName},
+		{"Period", fmt.Sprintf("%s - %s", invoice.PeriodStart, invoice.PeriodEnd)},
+		{"Currency", invoice.Currency},
+		{"Status", invoice.Status},
+		{"Issued at", invoice.IssuedAt.Format(time.RFC3339)},
+	}
+	if invoice.VoidedAt != nil {
+		rows = append(rows, [2]string{"Voided at", invoice.VoidedAt.Format(time.RFC3339)})
+	}
+	for _, row := range rows {
+		doc.cell(45, 7, row[0], "", 0, "L")
+		doc.cell(0, 7, row[1], "", 1, "L")
+	}
+	doc.pdf.Ln(6)
+
+	// 费用明细表
+	widths := []float64{15, 60, 25, 20, 20, 25, 25}
+	headers := []string{"ID", "Building", "Tier", "Play (s)", "Hours", "Seconds", "Amount"}
+	doc.font("B", 10)
+	for i, header := range headers {
+		doc.cell(widths[i], 8, header, "1", 0, "C")
+	}
+	doc.pdf.Ln(-1)
+
+	doc.font("", 9)
+	for _, line := range invoice.Lines {
+		doc.cell(widths[0], 7, fmt.Sprintf("%d", line.BuildingID), "1", 0, "C")
+		doc.cell(widths[1], 7, line.BuildingName, "1", 0, "L")
+		doc.cell(widths[2], 7, line.Tier, "1", 0, "L")
+		doc.cell(widths[3], 7, fmt.Sprintf("%d", line.PlayDuration), "1", 0, "R")
+		doc.cell(widths[4], 7, fmt.Sprintf("%d", line.Hours), "1", 0, "R")
+		doc.cell(widths[5], 7, fmt.Sprintf("%d", line.Seconds), "1", 0, "R")
+		doc.cell(widths[6], 7, fmt.Sprintf("%d", line.Amount), "1", 1, "R")
+	}
+
+	doc.font("B", 9)
+	var labelWidth float64
+	for _, width := range widths[:len(widths)-1] {
+		labelWidth += width
+	}
+	doc.cell(labelWidth, 7, fmt.Sprintf("Total (%s, minor units)", invoice.Currency), "1", 0, "R")
+	doc.cell(widths[len(widths)-1], 7, fmt.Sprintf("%d", invoice.Total), "1", 1, "R")
+
+	return doc.output(w)
+}
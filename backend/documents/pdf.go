@@ -0,0 +1,60 @@
+package documents
+
+// documents 负责生成 PDF 文档（投放证明等）
+
+import (
+	"io"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const utf8FontFamily = "utf8"
+
+// 中文等非拉丁字符需要 UTF-8 字体，由 SetFontPath 指定 TTF 文件
+var fontPath string
+
+// SetFontPath 设置生成 PDF 时使用的 UTF-8 字体文件，为空时使用内置 Helvetica
+func SetFontPath(path string) {
+	fontPath = path
+}
+
+// document 包装 fpdf，统一处理字体与文本编码
+type document struct {
+	pdf    *fpdf.Fpdf
+	family string
+	tr     func(string) string
+}
+
+// newDocument 创建 A4 纵向文档，未配置或无法加载 UTF-8 字体时回退到内置 Helvetica
+func newDocument(title string) *document {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle(title, true)
+	pdf.SetCreator("advertisement-management-system", true)
+	pdf.SetAutoPageBreak(true, 15)
+
+	if fontPath != "" {
+		pdf.AddUTF8Font(utf8FontFamily, "", fontPath)
+		pdf.AddUTF8Font(utf8FontFamily, "B", fontPath)
+		if !pdf.Err() {
+			return &document{pdf: pdf, family: utf8FontFamily, tr: func(s string) string { return s }}
+		}
+		pdf.ClearError()
+	}
+
+	return &document{pdf: pdf, family: "Helvetica", tr: pdf.UnicodeTranslatorFromDescriptor("")}
+}
+
+// font 设置字体样式与字号
+func (d *document) font(style string, size float64) {
+	d.pdf.SetFont(d.family, style, size)
+}
+
+// cell 输出单元格文本
+func (d *document) cell(w, h float64, text, border string, ln int, align string) {
+	d.pdf.CellFormat(w, h, d.tr(text), border, ln, align, false, 0, "")
+}
+
+// output 将文档写入 w
+func (d *document) output(w io.Writer) error {
+	return d.pdf.Output(w)
+}
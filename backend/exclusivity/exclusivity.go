@@ -0,0 +1,99 @@
+package exclusivity
+
+// exclusivity 检查大厦的广告类别排他规则
+//
+// 广告可以设置广告主类别（例如 banking），大厦可以为类别设置规则：limit 规则限制同一类别的广告数，
+// sponsor 规则只允许赞助广告使用该类别。新增投放、修改广告类别或规则时，
+// 用变更之后大厦的全部广告检查规则
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+)
+
+// Conflict 违反的一条规则及涉及的投放
+type Conflict struct {
+	RuleID      uint        `json:"rule_id"`
+	BuildingID  uint        `json:"building_id"`
+	Category    string      `json:"category"`
+	Kind        string      `json:"kind"`
+	MaxAds      int         `json:"max_ads"`
+	SponsorAdID *uint       `json:"sponsor_ad_id,omitempty"`
+	Placements  []Placement `json:"placements"`
+}
+
+// Placement 冲突涉及的投放
+type Placement struct {
+	AdvertisementID uint   `json:"advertisement_id"`
+	BuildingID      uint   `json:"building_id"`
+	Title           string `json:"title"`
+	Pending         bool   `json:"pending"` // 本次请求新增的投放或修改了类别的广告
+}
+
+// NormalizeCategory 返回类别的规范写法：去除首尾空白并转为小写
+func NormalizeCategory(category string) string {
+	return strings.ToLower(strings.TrimSpace(category))
+}
+
+// Check 检查大厦投放 ads 之后是否违反 rules。pending 为本次新增投放或修改了类别的广告 ID，
+// 不为 nil 时只返回涉及其中广告的冲突；为 nil 时返回全部冲突，用于新建或修改规则
+func Check(buildingID uint, rules []models.ExclusivityRule, ads []models.Advertisement, pending []uint) []Conflict {
+	sorted := slices.Clone(ads)
+	slices.SortFunc(sorted, func(a, b models.Advertisement) int { return cmp.Compare(a.ID, b.ID) })
+
+	var conflicts []Conflict
+	for _, rule := range rules {
+		// 接口写入的类别已经规范化，这里再规范化一次以兼容此前写入的数据
+		category := NormalizeCategory(rule.Category)
+		var offending []models.Advertisement
+		matched := 0
+		for _, ad := range sorted {
+			if category == "" || NormalizeCategory(ad.Category) != category {
+				continue
+			}
+			matched++
+			switch rule.Kind {
+			case models.ExclusivityLimit:
+				offending = append(offending, ad)
+			case models.ExclusivitySponsor:
+				if rule.SponsorAdID == nil || ad.ID != *rule.SponsorAdID {
+					offending = append(offending, ad)
+				}
+			}
+		}
+		if rule.Kind == models.ExclusivityLimit && matched <= rule.MaxAds {
+			continue
+		}
+		if len(offending) == 0 {
+			continue
+		}
+
+		conflict := Conflict{
+			RuleID:      rule.ID,
+			BuildingID:  buildingID,
+			Category:    category,
+			Kind:        rule.Kind,
+			MaxAds:      rule.MaxAds,
+			SponsorAdID: rule.SponsorAdID,
+			Placements:  make([]Placement, 0, len(offending)),
+		}
+		involved := pending == nil
+		for _, ad := range offending {
+			isPending := slices.Contains(pending, ad.ID)
+			involved = involved || isPending
+			conflict.Placements = append(conflict.Placements, Placement{
+				AdvertisementID: ad.ID,
+				BuildingID:      buildingID,
+				Title:           ad.Title,
+				Pending:         isPending,
+			})
+		}
+		if involved {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	return conflicts
+}
@@ -0,0 +1,183 @@
+package exclusivity
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+)
+
+func ad(id uint, category string) models.Advertisement {
+	a := models.Advertisement{Title: category + " 广告", Category: category}
+	a.ID = id
+	return a
+}
+
+func ptr(id uint) *uint { return &id }
+
+func TestNormalizeCategory(t *testing.T) {
+	tests := map[string]string{
+		"banking":      "banking",
+		"  Banking \t": "banking",
+		"TELECOM":      "telecom",
+		"   ":          "",
+	}
+	for input, want := range tests {
+		if got := NormalizeCategory(input); got != want {
+			t.Errorf("NormalizeCategory(%q) = %q，期望 %q", input, got, want)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	const building = 7
+	limit := func(max int) models.ExclusivityRule {
+		return models.ExclusivityRule{ID: 1, BuildingID: building, Category: "banking", Kind: models.ExclusivityLimit, MaxAds: max}
+	}
+	sponsor := func(adID *uint) models.ExclusivityRule {
+		return models.ExclusivityRule{ID: 2, BuildingID: building, Category: "banking", Kind: models.ExclusivitySponsor, MaxAds: 1, SponsorAdID: adID}
+	}
+	placement := func(id uint, pending bool) Placement {
+		return Placement{AdvertisementID: id, BuildingID: building, Title: "banking 广告", Pending: pending}
+	}
+
+	tests := []struct {
+		name    string
+		rules   []models.ExclusivityRule
+		ads     []models.Advertisement
+		pending []uint
+		want    []Conflict
+	}{
+		{
+			name:  "limit 规则未超出",
+			rules: []models.ExclusivityRule{limit(2)},
+			ads:   []models.Advertisement{ad(1, "banking"), ad(2, "telecom"), ad(3, "")},
+		},
+		{
+			name:  "limit 规则恰好达到上限",
+			rules: []models.ExclusivityRule{limit(2)},
+			ads:   []models.Advertisement{ad(1, "banking"), ad(2, "banking"), ad(3, "telecom")},
+		},
+		{
+			name:  "limit 规则超出上限时列出该类别的全部广告并按 ID 排序",
+			rules: []models.ExclusivityRule{limit(2)},
+			ads:   []models.Advertisement{ad(3, "banking"), ad(1, "banking"), ad(2, "telecom"), ad(5, "banking")},
+			want: []Conflict{{
+				RuleID: 1, BuildingID: building, Category: "banking", Kind: models.ExclusivityLimit, MaxAds: 2,
+				Placements: []Placement{placement(1, false), placement(3, false), placement(5, false)},
+			}},
+		},
+		{
+			name:  "limit 为 0 时不允许投放该类别",
+			rules: []models.ExclusivityRule{limit(0)},
+			ads:   []models.Advertisement{ad(1, "banking")},
+			want: []Conflict{{
+				RuleID: 1, BuildingID: building, Category: "banking", Kind: models.ExclusivityLimit, MaxAds: 0,
+				Placements: []Placement{placement(1, false)},
+			}},
+		},
+		{
+			name:  "sponsor 规则只投放赞助广告",
+			rules: []models.ExclusivityRule{sponsor(ptr(1))},
+			ads:   []models.Advertisement{ad(1, "banking"), ad(2, "telecom")},
+		},
+		{
+			name:  "sponsor 规则下的其他广告",
+			rules: []models.ExclusivityRule{sponsor(ptr(1))},
+			ads:   []models.Advertisement{ad(1, "banking"), ad(2, "banking"), ad(4, "banking")},
+			want: []Conflict{{
+				RuleID: 2, BuildingID: building, Category: "banking", Kind: models.ExclusivitySponsor, MaxAds: 1, SponsorAdID: ptr(1),
+				Placements: []Placement{placement(2, false), placement(4, false)},
+			}},
+		},
+		{
+			name:  "赞助广告未投放时其他广告同样冲突",
+			rules: []models.ExclusivityRule{sponsor(ptr(9))},
+			ads:   []models.Advertisement{ad(2, "banking")},
+			want: []Conflict{{
+				RuleID: 2, BuildingID: building, Category: "banking", Kind: models.ExclusivitySponsor, MaxAds: 1, SponsorAdID: ptr(9),
+				Placements: []Placement{placement(2, false)},
+			}},
+		},
+		{
+			name:  "没有赞助广告的 sponsor 规则不允许投放该类别",
+			rules: []models.ExclusivityRule{sponsor(nil)},
+			ads:   []models.Advertisement{ad(1, "banking"), ad(2, "telecom")},
+			want: []Conflict{{
+				RuleID: 2, BuildingID: building, Category: "banking", Kind: models.ExclusivitySponsor, MaxAds: 1,
+				Placements: []Placement{placement(1, false)},
+			}},
+		},
+		{
+			name:    "pending 为空切片时不返回任何冲突",
+			rules:   []models.ExclusivityRule{limit(1)},
+			ads:     []models.Advertisement{ad(1, "banking"), ad(2, "banking")},
+			pending: []uint{},
+		},
+		{
+			name:    "pending 不涉及冲突的广告",
+			rules:   []models.ExclusivityRule{limit(1)},
+			ads:     []models.Advertisement{ad(1, "banking"), ad(2, "banking"), ad(3, "telecom")},
+			pending: []uint{3},
+		},
+		{
+			name:    "pending 涉及冲突的广告时标记 Pending",
+			rules:   []models.ExclusivityRule{limit(1)},
+			ads:     []models.Advertisement{ad(1, "banking"), ad(2, "banking")},
+			pending: []uint{2},
+			want: []Conflict{{
+				RuleID: 1, BuildingID: building, Category: "banking", Kind: models.ExclusivityLimit, MaxAds: 1,
+				Placements: []Placement{placement(1, false), placement(2, true)},
+			}},
+		},
+		{
+			name:    "pending 只过滤不涉及的规则",
+			rules:   []models.ExclusivityRule{limit(1), {ID: 3, BuildingID: building, Category: "telecom", Kind: models.ExclusivityLimit, MaxAds: 1}},
+			ads:     []models.Advertisement{ad(1, "banking"), ad(2, "banking"), ad(3, "telecom"), ad(4, "telecom")},
+			pending: []uint{4},
+			want: []Conflict{{
+				RuleID: 3, BuildingID: building, Category: "telecom", Kind: models.ExclusivityLimit, MaxAds: 1,
+				Placements: []Placement{
+					{AdvertisementID: 3, BuildingID: building, Title: "telecom 广告"},
+					{AdvertisementID: 4, BuildingID: building, Title: "telecom 广告", Pending: true},
+				},
+			}},
+		},
+		{
+			name:    "赞助广告本身在 pending 中不构成冲突",
+			rules:   []models.ExclusivityRule{sponsor(ptr(1))},
+			ads:     []models.Advertisement{ad(1, "banking"), ad(2, "banking")},
+			pending: []uint{1},
+		},
+		{
+			name:  "类别按规范写法匹配",
+			rules: []models.ExclusivityRule{{ID: 1, BuildingID: building, Category: " Banking", Kind: models.ExclusivityLimit, MaxAds: 1}},
+			ads:   []models.Advertisement{ad(1, "banking"), ad(2, "BANKING "), ad(3, "bank")},
+			want: []Conflict{{
+				RuleID: 1, BuildingID: building, Category: "banking", Kind: models.ExclusivityLimit, MaxAds: 1,
+				Placements: []Placement{
+					{AdvertisementID: 1, BuildingID: building, Title: "banking 广告"},
+					{AdvertisementID: 2, BuildingID: building, Title: "BANKING  广告"},
+				},
+			}},
+		},
+		{
+			name:  "没有类别的广告不受规则约束",
+			rules: []models.ExclusivityRule{{ID: 1, BuildingID: building, Category: "", Kind: models.ExclusivityLimit, MaxAds: 0}},
+			ads:   []models.Advertisement{ad(1, ""), ad(2, "  ")},
+		},
+		{
+			name: "没有规则",
+			ads:  []models.Advertisement{ad(1, "banking")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Check(building, tt.rules, tt.ads, tt.pending)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Check() =\n%+v\n期望\n%+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package impressions
+
+// impressions 按大厦每小时的人流量估算广告的曝光数
+//
+// 每个播放区域独立轮播，一小时内到访的人都会经过各区域的屏幕。某一小时广告的曝光数为
+// 人流量 × 广告的播放时长 / 所在区域一轮的总时长，即广告在轮播中所占的时间比例。
+// 一轮包含该小时开始时能够播放的投放（判断规则与播放预览共用 preview.Check），
+// 并按广告 ID 顺序放入一轮的容量。
+// 估算使用当前的投放、分时规则与广告设置，没有上传人流量的小时曝光数为 0
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/dayparting"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/preview"
+	"github.com/10240418/advertisement-management-system/backend/region"
+)
+
+// Hour 一小时的人流量及各广告的估算曝光数
+type Hour struct {
+	Start       time.Time
+	Visitors    int64
+	Impressions map[uint]float64 // 广告 ID → 曝光数
+}
+
+// Day 当地一天的汇总
+type Day struct {
+	Date        string
+	Hours       int // 上传了人流量的小时数
+	Visitors    int64
+	Impressions map[uint]float64
+}
+
+// Estimate 估算每个有人流量的小时中各广告的曝光数，loc 为大厦所在时区
+func Estimate(plan *dayparting.Plan, zones []preview.Zone, counts []models.TrafficCount, loopCapacity time.Duration, loc *time.Location) []Hour {
+	sorted := make([]preview.Zone, len(zones))
+	for i, zone := range zones {
+		sorted[i] = preview.Zone{Name: zone.Name, Entries: slices.Clone(zone.Entries)}
+		slices.SortFunc(sorted[i].Entries, func(a, b preview.Entry) int { return cmp.Compare(a.Ad.ID, b.Ad.ID) })
+	}
+
+	capacity := int64(loopCapacity / time.Second)
+	hours := make([]Hour, 0, len(counts))
+	for _, count := range counts {
+		hour := Hour{Start: count.Hour.In(loc), Visitors: count.Visitors, Impressions: make(map[uint]float64)}
+		for _, zone := range sorted {
+			var loop []preview.Entry
+			var total int64
+			for _, entry := range zone.Entries {
+				duration := entry.Placement.PlayDuration
+				if !preview.Check(plan, entry, hour.Start).Eligible {
+					continue
+				}
+				if capacity > 0 && total+duration > capacity {
+					continue
+				}
+				loop = append(loop, entry)
+				total += duration
+			}
+			for _, entry := range loop {
+				hour.Impressions[entry.Ad.ID] += float64(count.Visitors) * float64(entry.Placement.PlayDuration) / float64(total)
+			}
+		}
+		hours = append(hours, hour)
+	}
+	return hours
+}
+
+// Daily 按当地日期汇总 hours，days 中没有数据的日期同样返回
+func Daily(hours []Hour, days []region.Day) []Day {
+	result := make([]Day, len(days))
+	for i, day := range days {
+		result[i] = Day{Date: day.Date, Impressions: make(map[uint]float64)}
+	}
+	for _, hour := range hours {
+		i := slices.IndexFunc(days, func(day region.Day) bool {
+			return !hour.Start.Before(day.Start) && hour.Start.Before(day.End)
+		})
+		if i < 0 {
+			continue
+		}
+		result[i].Hours++
+		result[i].Visitors += hour.Visitors
+		for adID, impressions := range hour.Impressions {
+			result[i].Impressions[adID] += impressions
+		}
+	}
+	return result
+}
@@ -0,0 +1,144 @@
+package impressions
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	_ "time/tzdata" // 测试环境可能没有时区数据库
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/preview"
+	"github.com/10240418/advertisement-management-system/backend/region"
+	"gorm.io/gorm"
+)
+
+// entry 返回广告 id 播放 duration 秒的投放，status 为广告状态
+func entry(id uint, duration int64, status string) preview.Entry {
+	return preview.Entry{
+		Placement: models.AdvertisementBuilding{AdvertisementID: id, PlayDuration: duration},
+		Ad:        models.Advertisement{Model: gorm.Model{ID: id}, Status: status},
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	hour := time.Date(2026, 6, 1, 2, 0, 0, 0, time.UTC)
+	counts := []models.TrafficCount{{Hour: hour, Visitors: 1000}}
+
+	tests := []struct {
+		name     string
+		zones    []preview.Zone
+		capacity time.Duration
+		want     map[uint]float64
+	}{
+		{
+			name:  "按播放时长分配",
+			zones: []preview.Zone{{Entries: []preview.Entry{entry(2, 30, "active"), entry(1, 10, "active")}}},
+			want:  map[uint]float64{1: 250, 2: 750},
+		},
+		{
+			// 一轮中没有可以播放的广告时不产生曝光，也不会除以 0
+			name:  "空的一轮",
+			zones: []preview.Zone{{Entries: []preview.Entry{entry(1, 30, "inactive"), entry(2, 0, "active")}}},
+			want:  map[uint]float64{},
+		},
+		{
+			name:  "没有投放",
+			zones: nil,
+			want:  map[uint]float64{},
+		},
+		{
+			// 按广告 ID 顺序放入一轮，放不下的广告跳过，之后较短的广告仍然可以放入
+			name:     "超出容量",
+			zones:    []preview.Zone{{Entries: []preview.Entry{entry(3, 20, "active"), entry(2, 40, "active"), entry(1, 30, "active")}}},
+			capacity: time.Minute,
+			want:     map[uint]float64{1: 600, 3: 400},
+		},
+		{
+			name:     "单个广告超出容量",
+			zones:    []preview.Zone{{Entries: []preview.Entry{entry(1, 90, "active")}}},
+			capacity: time.Minute,
+			want:     map[uint]float64{},
+		},
+		{
+			// 每个区域独立轮播，同一广告在多个区域的曝光相加
+			name: "多个区域",
+			zones: []preview.Zone{
+				{Name: "main", Entries: []preview.Entry{entry(1, 30, "active"), entry(2, 30, "active")}},
+				{Name: "ticker", Entries: []preview.Entry{entry(1, 10, "active")}},
+			},
+			want: map[uint]float64{1: 1500, 2: 500},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hours := Estimate(nil, tt.zones, counts, tt.capacity, time.UTC)
+			if len(hours) != 1 {
+				t.Fatalf("返回 %d 小时，期望 1", len(hours))
+			}
+			if hours[0].Visitors != 1000 || !hours[0].Start.Equal(hour) {
+				t.Errorf("小时 %v 人流量 %d，期望 %v 人流量 1000", hours[0].Start, hours[0].Visitors, hour)
+			}
+			if !reflect.DeepEqual(hours[0].Impressions, tt.want) {
+				t.Errorf("曝光数 %v，期望 %v", hours[0].Impressions, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaily(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		from      string
+		wantHours []int
+	}{
+		{"普通的一天", "2026-06-01", []int{24, 24}},
+		{"夏令时开始", "2026-03-08", []int{23, 24}},
+		{"夏令时结束", "2026-11-01", []int{25, 24}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, err := time.ParseInLocation(models.DateLayout, tt.from, loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			days := region.Days(from, from.AddDate(0, 0, 2), loc)
+
+			// 两天中的每个整点各有 10 人，广告 1 每小时 2 次曝光，另加范围之外的一小时
+			var hours []Hour
+			for at := from.Add(-time.Hour); at.Before(days[len(days)-1].End); at = at.Add(time.Hour) {
+				hours = append(hours, Hour{Start: at.In(loc), Visitors: 10, Impressions: map[uint]float64{1: 2}})
+			}
+
+			got := Daily(hours, days)
+			if len(got) != len(tt.wantHours) {
+				t.Fatalf("返回 %d 天，期望 %d", len(got), len(tt.wantHours))
+			}
+			for i, day := range got {
+				want := tt.wantHours[i]
+				if day.Date != days[i].Date || day.Hours != want || day.Visitors != int64(10*want) || day.Impressions[1] != float64(2*want) {
+					t.Errorf("%s: %d 小时 %d 人 %v 曝光，期望 %d 小时 %d 人 %d 曝光",
+						day.Date, day.Hours, day.Visitors, day.Impressions[1], want, 10*want, 2*want)
+				}
+			}
+		})
+	}
+}
+
+func TestDailyWithoutData(t *testing.T) {
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	days := region.Days(from, from.AddDate(0, 0, 2), time.UTC)
+
+	got := Daily(nil, days)
+	want := []Day{
+		{Date: "2026-06-01", Impressions: map[uint]float64{}},
+		{Date: "2026-06-02", Impressions: map[uint]float64{}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Daily = %v，期望 %v", got, want)
+	}
+}
@@ -0,0 +1,143 @@
+package lifecycle
+
+// lifecycle 按上线时间与到期时间自动切换广告状态
+//
+// 后台任务周期扫描到达上线或到期时间的广告，将状态改为 active 或 inactive，
+// 并在同一事务中写入 ad.activated、ad.expired 事件。多个实例同时运行时，
+// 通过 Postgres advisory lock 保证同一时刻只有一个实例执行切换，其余实例跳过本次扫描。
+// 切换后记录 activated_at、expired_at，之后管理员手动修改状态不会被再次覆盖
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/config"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"github.com/10240418/advertisement-management-system/backend/webhooks"
+)
+
+// 切换广告状态时使用的 advisory lock 键，与数据库迁移使用的键不同
+const lockKey = 7284216
+
+// Scheduler 切换到达上线或到期时间的广告
+type Scheduler struct {
+	store  repository.Store
+	cfg    config.LifecycleConfig
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewScheduler 创建 Scheduler
+func NewScheduler(store repository.Store, cfg config.LifecycleConfig, logger *slog.Logger) *Scheduler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Scheduler{
+		store:  store,
+		cfg:    cfg,
+		logger: logger.With(slog.String("component", "lifecycle")),
+		now:    time.Now,
+	}
+}
+
+// Run 切换一批到期的广告，供后台任务周期调用；超出批量的广告在下一次扫描时处理
+func (s *Scheduler) Run(ctx context.Context) error {
+	now := s.now().UTC()
+	var activated, expired int
+	locked := false
+	err := s.store.Transaction(ctx, func(tx repository.Store) error {
+		var err error
+		if locked, err = tx.TryLock(ctx, lockKey); err != nil || !locked {
+			return err
+		}
+		ads, err := tx.Ads().ListDue(ctx, now, s.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		// 只写入状态与切换时间，并按当前数据重新判断，避免覆盖扫描之后管理员对广告的修改
+		events := make([]webhooks.Event, 0, len(ads))
+		for i := range ads {
+			event, ok := Transition(&ads[i], now)
+			if !ok {
+				continue
+			}
+			switched, err := tx.Ads().Transition(ctx, ads[i].ID, event, now)
+			if err != nil {
+				return err
+			}
+			if !switched {
+				continue
+			}
+			ad, err := tx.Ads().Get(ctx, ads[i].ID)
+			if err != nil {
+				return err
+			}
+			events = append(events, webhooks.AdEvent(event, *ad))
+			if event == models.EventAdActivated {
+				activated++
+			} else {
+				expired++
+			}
+		}
+		return webhooks.Emit(ctx, tx, events...)
+	})
+	if err != nil {
+		return fmt.Errorf("切换广告状态失败: %w", err)
+	}
+
+	if !locked {
+		s.logger.Debug("其他实例正在切换广告状态，跳过本次扫描")
+		return nil
+	}
+	if activated+expired > 0 {
+		s.logger.Info("已切换广告状态", slog.Int("activated", activated), slog.Int("expired", expired))
+	}
+	return nil
+}
+
+// Transition 按 now 切换广告状态并记录切换时间，返回应推送的事件；不需要切换时返回 false。
+// 到期优先于上线：上线与到期时间都已到达时广告直接到期
+func Transition(ad *models.Advertisement, now time.Time) (string, bool) {
+	reached := func(t *time.Time) bool { return t != nil && !t.After(now) }
+	switch {
+	case reached(ad.ExpiresAt) && ad.ExpiredAt == nil:
+		ad.Status = "inactive"
+		ad.ExpiredAt = &now
+		return models.EventAdExpired, true
+	case reached(ad.GoLiveAt) && ad.ActivatedAt == nil:
+		ad.Status = "active"
+		ad.ActivatedAt = &now
+		return models.EventAdActivated, true
+	}
+	return "", false
+}
+
+// 广告在某一时刻不处于播放期的原因
+const (
+	ReasonNotLive  = "not_live" // 未到上线时间
+	ReasonExpired  = "expired"  // 已到期
+	ReasonInactive = "inactive" // 广告已停用
+)
+
+// Reason 返回广告在 t 时不处于播放期的原因，处于播放期时返回空字符串。
+// 停用的广告视为一直停用，但因到期而停用的广告在到期前仍在播放
+func Reason(ad models.Advertisement, t time.Time) string {
+	switch {
+	case ad.GoLiveAt != nil && t.Before(*ad.GoLiveAt):
+		return ReasonNotLive
+	case ad.ExpiresAt != nil && !t.Before(*ad.ExpiresAt):
+		return ReasonExpired
+	case ad.Status == "inactive" && ad.ExpiredAt == nil:
+		return ReasonInactive
+	}
+	return ""
+}
+
+// Playing 判断广告在 t 时是否处于播放期
+func Playing(ad models.Advertisement, t time.Time) bool {
+	return Reason(ad, t) == ""
+}
@@ -0,0 +1,78 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// GormLogger 将 GORM 日志写入请求上下文中的日志器，SQL 语句只在 debug 级别输出
+// 慢查询以 warn 级别输出，查询错误（记录不存在除外）以 error 级别输出
+type GormLogger struct {
+	SlowThreshold time.Duration
+}
+
+var _ gormlogger.Interface = GormLogger{}
+
+// NewGormLogger 创建 GORM 日志适配器，slowThreshold 为 0 时不记录慢查询
+func NewGormLogger(slowThreshold time.Duration) GormLogger {
+	return GormLogger{SlowThreshold: slowThreshold}
+}
+
+// LogMode 日志级别由 slog 统一控制，此处忽略
+func (l GormLogger) LogMode(gormlogger.LogLevel) gormlogger.Interface {
+	return l
+}
+
+func (l GormLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	FromContext(ctx).InfoContext(ctx, fmt.Sprintf(msg, args...), slog.String("component", "gorm"))
+}
+
+func (l GormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	FromContext(ctx).WarnContext(ctx, fmt.Sprintf(msg, args...), slog.String("component", "gorm"))
+}
+
+func (l GormLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	FromContext(ctx).ErrorContext(ctx, fmt.Sprintf(msg, args...), slog.String("component", "gorm"))
+}
+
+// Trace 记录一次 SQL 执行
+func (l GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	logger := FromContext(ctx)
+	elapsed := time.Since(begin)
+
+	var level slog.Level
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound):
+		level = slog.LevelError
+	case l.SlowThreshold > 0 && elapsed > l.SlowThreshold:
+		level = slog.LevelWarn
+	default:
+		level = slog.LevelDebug
+	}
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	sql, rows := fc()
+	attrs := []slog.Attr{
+		slog.String("component", "gorm"),
+		slog.String("sql", sql),
+		slog.Int64("rows", rows),
+		slog.Duration("elapsed", elapsed),
+	}
+	msg := "sql query"
+	switch level {
+	case slog.LevelError:
+		attrs = append(attrs, slog.String("error", err.Error()))
+		msg = "sql query failed"
+	case slog.LevelWarn:
+		msg = "slow sql query"
+	}
+	logger.LogAttrs(ctx, level, msg, attrs...)
+}
@@ -0,0 +1,57 @@
+package logging
+
+// logging 提供基于 slog 的结构化日志，日志器随请求上下文传递并携带请求 ID
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+type contextKey struct{}
+
+// ParseLevel 解析日志级别：debug、info、warn、error
+func ParseLevel(level string) (slog.Level, error) {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(strings.TrimSpace(level))); err != nil {
+		return l, fmt.Errorf("日志级别 %q 无效，可选 debug、info、warn、error", level)
+	}
+	return l, nil
+}
+
+// New 创建日志器，format 为 json 或 text
+func New(w io.Writer, level, format string) (*slog.Logger, error) {
+	l, err := ParseLevel(level)
+	if err != nil {
+		return nil, err
+	}
+	opts := &slog.HandlerOptions{Level: l}
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", "json":
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	case "text":
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	default:
+		return nil, fmt.Errorf("日志格式 %q 无效，可选 json、text", format)
+	}
+}
+
+// WithLogger 返回携带 logger 的上下文
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext 返回上下文中的日志器，不存在时返回默认日志器
+func FromContext(ctx context.Context) *slog.Logger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(contextKey{}).(*slog.Logger); ok {
+			return logger
+		}
+	}
+	return slog.Default()
+}
+
+// Redacted 用于日志中替换敏感字段的值
+const Redacted = "[REDACTED]"
@@ -1,44 +1,169 @@
 package main
 
 import (
-	"log"
-
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
 	"time"
+	_ "time/tzdata" // 运行镜像可能没有时区数据库
 
 	"github.com/10240418/advertisement-management-system/backend/config"
-	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/controllers"
+	"github.com/10240418/advertisement-management-system/backend/documents"
+	"github.com/10240418/advertisement-management-system/backend/lifecycle"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/metrics"
+	"github.com/10240418/advertisement-management-system/backend/middleware"
+	"github.com/10240418/advertisement-management-system/backend/migrations"
+	"github.com/10240418/advertisement-management-system/backend/openapi"
+	"github.com/10240418/advertisement-management-system/backend/ratelimit"
+	"github.com/10240418/advertisement-management-system/backend/repository"
 	"github.com/10240418/advertisement-management-system/backend/routers"
+	"github.com/10240418/advertisement-management-system/backend/webhooks"
+	"github.com/10240418/advertisement-management-system/backend/worker"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
 )
 
 func main() {
-	// 加载环境变量
-	if err := godotenv.Load(); err != nil {
-		log.Fatalf("加载环境变量失败: %v", err)
+	// 识别 migrate 子命令，其余参数交给配置解析
+	args := os.Args[1:]
+	if len(args) > 0 && args[0] == "openapi" {
+		if err := runOpenAPI(args[1:]); err != nil {
+			fatal("openapi 子命令失败", err)
+		}
+		return
+	}
+	migrate := len(args) > 0 && args[0] == "migrate"
+	if migrate {
+		args = args[1:]
+	}
+
+	// 加载配置：配置文件、环境变量（含可选的 .env）与命令行参数
+	cfg, rest, err := config.Load(args)
+	if err != nil {
+		fatal("加载配置失败", err)
+	}
+
+	// 校验配置，有问题时列出全部错误并退出
+	validate := cfg.Validate
+	if migrate {
+		validate = func() error { return errors.Join(cfg.Database.Validate(), cfg.Log.Validate()) }
+	}
+	if err := validate(); err != nil {
+		fatal("配置无效", err)
 	}
 
+	// 初始化结构化日志，请求日志通过 logging.Middleware 携带请求 ID
+	logger, err := logging.New(os.Stderr, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		fatal("初始化日志失败", err)
+	}
+	slog.SetDefault(logger)
+
 	// 初始化数据库连接
-	if err := config.InitDB(); err != nil {
-		log.Fatalf("数据库连接失败: %v", err)
+	if err := config.InitDB(cfg.Database, cfg.Log.SlowThreshold); err != nil {
+		fatal("数据库连接失败", err)
+	}
+
+	// 暴露数据库连接池指标
+	if sqlDB, err := config.DB.DB(); err == nil {
+		if err := metrics.RegisterDB(sqlDB, "postgres"); err != nil {
+			slog.Warn("注册数据库指标失败", slog.Any("error", err))
+		}
 	}
 
-	// 运行数据库迁移
-	if err := config.DB.AutoMigrate(&models.Advertisement{}, &models.Building{}, &models.AdvertisementBuilding{}, &models.Administrator{}); err != nil {
-		log.Fatalf("数据库迁移失败: %v", err)
+	migrator, err := migrations.New(config.DB)
+	if err != nil {
+		fatal("加载数据库迁移失败", err)
 	}
 
-	// 设置路由
-	r := routers.SetupRouter()
+	// migrate 子命令：执行或查看数据库迁移
+	if migrate {
+		if err := runMigrate(migrator, rest); err != nil {
+			fatal("数据库迁移失败", err)
+		}
+		return
+	}
+
+	// 数据库结构版本与程序不一致时拒绝启动
+	if err := migrator.CheckVersion(context.Background()); err != nil {
+		fatal("拒绝启动", err)
+	}
+
+	config.InitJWT(cfg.JWT)
+	documents.SetFontPath(cfg.Documents.FontPath)
+
+	// 设置路由，控制器通过 Store 访问数据库；draining 在优雅关闭开始时关闭
+	draining := make(chan struct{})
+	store := repository.NewGormStore(config.DB)
+	r := routers.SetupRouter(cfg, store, ratelimit.NewMemoryStore(), draining)
+
+	// 路由与接口文档不一致时仅告警，routers 包的测试与 openapi check 子命令会报告差异
+	if err := openapi.Check(r.Routes()); err != nil {
+		slog.Warn("接口文档需要更新", slog.Any("error", err))
+	}
 
 	// 配置 CORS
 	configCORSMiddleware(r)
 
-	// 启动服务器
-	if err := r.Run(":8080"); err != nil {
-		log.Fatalf("启动服务器失败: %v", err)
+	// 后台周期任务
+	supervisor := worker.NewSupervisor(slog.Default())
+	dispatcher := webhooks.NewDispatcher(store, cfg.Webhooks, slog.Default())
+	scheduler := lifecycle.NewScheduler(store, cfg.Lifecycle, slog.Default())
+	if err := registerJobs(supervisor, dispatcher, cfg.Webhooks.PollInterval, scheduler, cfg.Lifecycle.Interval); err != nil {
+		fatal("注册后台任务失败", err)
+	}
+
+	// 启动服务器，收到 SIGTERM 后优雅关闭
+	srv := &http.Server{
+		Addr:              cfg.Server.Addr(),
+		Handler:           r,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	if err := serve(cfg.Server, srv, supervisor, draining); err != nil {
+		fatal("服务异常退出", err)
 	}
+
+	if sqlDB, err := config.DB.DB(); err == nil {
+		sqlDB.Close()
+	}
+}
+
+// registerJobs 注册后台周期任务
+func registerJobs(supervisor *worker.Supervisor, dispatcher *webhooks.Dispatcher, pollInterval time.Duration, scheduler *lifecycle.Scheduler, lifecycleInterval time.Duration) error {
+	return errors.Join(
+		supervisor.Add(worker.Job{
+			Name:     "prune-devices",
+			Interval: time.Minute,
+			Run: func(ctx context.Context) error {
+				if removed := metrics.Devices.Prune(); removed > 0 {
+					slog.Debug("清理不活跃设备", slog.Int("removed", removed))
+				}
+				return nil
+			},
+		}),
+		supervisor.Add(worker.Job{
+			Name:       "deliver-webhooks",
+			Interval:   pollInterval,
+			RunOnStart: true,
+			Run:        dispatcher.Run,
+		}),
+		supervisor.Add(worker.Job{
+			Name:       "ad-lifecycle",
+			Interval:   lifecycleInterval,
+			RunOnStart: true,
+			Run:        scheduler.Run,
+		}),
+	)
+}
+
+// fatal 记录错误日志并退出进程
+func fatal(msg string, err error) {
+	slog.Error(msg, slog.Any("error", err))
+	os.Exit(1)
 }
 
 // configCORSMiddleware 配置 CORS 中间件
@@ -46,8 +171,8 @@ func configCORSMiddleware(r *gin.Engine) {
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"}, // 根据需求调整允许的源
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", logging.RequestIDHeader, "X-Device-ID", middleware.APIKeyHeader},
+		ExposeHeaders:    []string{"Content-Length", logging.RequestIDHeader, "ETag", controllers.ManifestSignatureHeader, controllers.ManifestKeyIDHeader, controllers.ManifestVersionHeader},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
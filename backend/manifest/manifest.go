@@ -0,0 +1,376 @@
+package manifest
+
+// manifest 生成大厦的离线播放清单
+//
+// 清单列出播放端需要预缓存的全部素材（URL、大小、SHA-256）与每条广告的播放规则：
+// 播放时长、上线与到期时间，以及分时规则的时段和引用的日历。时段与日期按清单的 timezone 解释，
+// 播放端离线时按这些规则自行判断能否播放，判断方式与服务端的 preview.Check 相同。
+// 内容变化时生成新版本并保存，同一版本的响应体保持不变；响应体使用 Ed25519 签名，
+// 播放端可通过增量接口只获取自某个版本以来新增和移除的素材
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/lifecycle"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/region"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+// ErrVersionNotFound 增量的起始版本不存在或已超出保留的历史
+var ErrVersionNotFound = errors.New("清单版本不存在")
+
+// 素材类型
+const (
+	AssetImage = "image"
+	AssetVideo = "video"
+)
+
+// Manifest 完整清单
+type Manifest struct {
+	BuildingID  uint                   `json:"building_id"`
+	Version     int                    `json:"version"`
+	ContentHash string                 `json:"content_hash"` // timezone、items、calendars 与 assets 的 SHA-256，内容不变时保持不变
+	GeneratedAt time.Time              `json:"generated_at"`
+	Timezone    string                 `json:"timezone"` // 大厦所在时区，分时规则与日历按此解释
+	Items       []Item                 `json:"items"`
+	Calendars   []Calendar             `json:"calendars"` // 各条广告的分时规则引用的日历
+	Assets      []models.ManifestAsset `json:"assets"`
+}
+
+// Item 清单中的一条广告
+type Item struct {
+	AdvertisementID uint       `json:"advertisement_id"`
+	Title           string     `json:"title"`
+	Zone            string     `json:"zone,omitempty"` // 布局区域，为空表示默认媒体区域
+	Schedule        Schedule   `json:"schedule"`
+	Assets          []AssetRef `json:"assets"`
+}
+
+// Schedule 广告在该大厦的播放规则
+type Schedule struct {
+	PlayDuration int64      `json:"play_duration"`        // 秒
+	GoLiveAt     *time.Time `json:"go_live_at,omitempty"` // 此前不播放
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"` // 此后不播放
+	Daypart      *Daypart   `json:"daypart,omitempty"`    // 为空表示不受分时规则限制
+}
+
+// Daypart 分时规则：任一时段命中即可播放；日期在排除日历中时不播放，设置了包含日历时日期必须在其中之一
+type Daypart struct {
+	ID                 uint                   `json:"id"`
+	Windows            []models.DaypartWindow `json:"windows"`
+	IncludeCalendarIDs []uint                 `json:"include_calendar_ids"`
+	ExcludeCalendarIDs []uint                 `json:"exclude_calendar_ids"`
+}
+
+// Calendar 分时规则引用的日历
+type Calendar struct {
+	ID     uint            `json:"id"`
+	Events []CalendarEvent `json:"events"`
+}
+
+// CalendarEvent 日历中的全天事件，可以跨多天并按 RRULE 重复
+type CalendarEvent struct {
+	Summary   string `json:"summary"`
+	StartDate string `json:"start_date"`      // YYYY-MM-DD
+	EndDate   string `json:"end_date"`        // YYYY-MM-DD，不包含当天
+	RRule     string `json:"rrule,omitempty"` // 为空表示不重复
+}
+
+// AssetRef 广告使用的素材，详细信息见清单的 assets
+type AssetRef struct {
+	Type string `json:"type"` // image、video
+	URL  string `json:"url"`
+}
+
+// Delta 自 FromVersion 以来的素材变化，Timezone、Items 与 Calendars 为当前版本的完整播放规则
+type Delta struct {
+	BuildingID  uint                   `json:"building_id"`
+	FromVersion int                    `json:"from_version"`
+	Version     int                    `json:"version"`
+	ContentHash string                 `json:"content_hash"`
+	Added       []models.ManifestAsset `json:"added"`
+	Removed     []models.ManifestAsset `json:"removed"`
+	Timezone    string                 `json:"timezone"`
+	Items       []Item                 `json:"items"`
+	Calendars   []Calendar             `json:"calendars"`
+}
+
+// content 决定清单版本的内容
+type content struct {
+	Timezone  string                 `json:"timezone"`
+	Items     []Item                 `json:"items"`
+	Calendars []Calendar             `json:"calendars"`
+	Assets    []models.ManifestAsset `json:"assets"`
+}
+
+// Service 生成、保存并签名清单
+type Service struct {
+	store   repository.Store
+	signer  *Signer
+	regions *region.Resolver
+	history int
+}
+
+// NewService 创建 Service，regions 用于确定大厦所在时区，history 为每个大厦保留的版本数
+func NewService(store repository.Store, signer *Signer, regions *region.Resolver, history int) *Service {
+	return &Service{store: store, signer: signer, regions: regions, history: history}
+}
+
+// Signer 返回签名使用的 Signer
+func (s *Service) Signer() *Signer {
+	return s.signer
+}
+
+// Current 返回大厦当前的清单版本，内容与最新保存的版本不同时生成新版本
+func (s *Service) Current(ctx context.Context, building *models.Building) (*models.PlaylistManifest, error) {
+	buildingID := building.ID
+	current, err := s.build(ctx, building, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	hash, err := contentHash(current)
+	if err != nil {
+		return nil, err
+	}
+
+	// 并发请求可能同时生成同一版本，冲突时重新读取
+	for attempt := 0; ; attempt++ {
+		latest, err := s.store.Manifests().Latest(ctx, buildingID)
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return nil, err
+		}
+		if latest != nil && latest.ContentHash == hash {
+			return latest, nil
+		}
+
+		version := 1
+		if latest != nil {
+			version = latest.Version + 1
+		}
+		now := time.Now().UTC().Truncate(time.Second)
+		body, err := json.Marshal(Manifest{
+			BuildingID:  buildingID,
+			Version:     version,
+			ContentHash: hash,
+			GeneratedAt: now,
+			Timezone:    current.Timezone,
+			Items:       current.Items,
+			Calendars:   current.Calendars,
+			Assets:      current.Assets,
+		})
+		if err != nil {
+			return nil, err
+		}
+		record := &models.PlaylistManifest{
+			CreatedAt:   now,
+			BuildingID:  buildingID,
+			Version:     version,
+			ContentHash: hash,
+			Assets:      current.Assets,
+			Body:        string(body),
+		}
+		err = s.store.Manifests().Create(ctx, record)
+		if errors.Is(err, repository.ErrDuplicate) && attempt < 2 {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return record, s.store.Manifests().Prune(ctx, buildingID, s.history)
+	}
+}
+
+// Delta 返回自 since 版本以来的变化与当前版本
+func (s *Service) Delta(ctx context.Context, building *models.Building, since int) ([]byte, *models.PlaylistManifest, error) {
+	buildingID := building.ID
+	current, err := s.Current(ctx, building)
+	if err != nil {
+		return nil, nil, err
+	}
+	if since > current.Version {
+		return nil, current, ErrVersionNotFound
+	}
+	previous, err := s.store.Manifests().Get(ctx, buildingID, since)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, current, ErrVersionNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var full Manifest
+	if err := json.Unmarshal([]byte(current.Body), &full); err != nil {
+		return nil, nil, err
+	}
+	body, err := json.Marshal(Delta{
+		BuildingID:  buildingID,
+		FromVersion: since,
+		Version:     current.Version,
+		ContentHash: current.ContentHash,
+		Added:       difference(current.Assets, previous.Assets),
+		Removed:     difference(previous.Assets, current.Assets),
+		Timezone:    full.Timezone,
+		Items:       full.Items,
+		Calendars:   full.Calendars,
+	})
+	return body, current, err
+}
+
+// build 查询大厦当前投放的广告及其播放规则。停用或已到期的广告、引用的分时规则已不存在的投放
+// 不会再播放，不进入清单；未到上线时间的广告进入清单，便于播放端提前缓存素材
+func (s *Service) build(ctx context.Context, building *models.Building, now time.Time) (*content, error) {
+	placements, err := s.store.Placements().ListByBuilding(ctx, building.ID)
+	if err != nil {
+		return nil, err
+	}
+	byAd := make(map[uint]models.AdvertisementBuilding, len(placements))
+	adIDs := make([]uint, 0, len(placements))
+	var daypartIDs []uint
+	for _, placement := range placements {
+		byAd[placement.AdvertisementID] = placement
+		adIDs = append(adIDs, placement.AdvertisementID)
+		if placement.DaypartID != nil {
+			daypartIDs = append(daypartIDs, *placement.DaypartID)
+		}
+	}
+	ads, err := s.store.Ads().FindByIDs(ctx, adIDs)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(ads, func(a, b models.Advertisement) int { return cmp.Compare(a.ID, b.ID) })
+	dayparts := make(map[uint]models.Daypart)
+	if len(daypartIDs) > 0 {
+		found, err := s.store.Dayparts().FindByIDs(ctx, daypartIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, daypart := range found {
+			dayparts[daypart.ID] = daypart
+		}
+	}
+
+	result := &content{Timezone: s.regions.For(building).Timezone, Items: make([]Item, 0, len(ads)), Calendars: []Calendar{}}
+	assets := make(map[string]models.ManifestAsset)
+	calendarIDs := make(map[uint]bool)
+	for _, ad := range ads {
+		placement := byAd[ad.ID]
+		if reason := lifecycle.Reason(ad, now); reason == lifecycle.ReasonInactive || reason == lifecycle.ReasonExpired {
+			continue
+		}
+		schedule := Schedule{PlayDuration: placement.PlayDuration, GoLiveAt: ad.GoLiveAt, ExpiresAt: ad.ExpiresAt}
+		if placement.DaypartID != nil {
+			daypart, ok := dayparts[*placement.DaypartID]
+			if !ok {
+				continue
+			}
+			schedule.Daypart = &Daypart{
+				ID:                 daypart.ID,
+				Windows:            daypart.Windows,
+				IncludeCalendarIDs: daypart.IncludeCalendarIDs,
+				ExcludeCalendarIDs: daypart.ExcludeCalendarIDs,
+			}
+			for _, id := range slices.Concat(daypart.IncludeCalendarIDs, daypart.ExcludeCalendarIDs) {
+				calendarIDs[id] = true
+			}
+		}
+		item := Item{
+			AdvertisementID: ad.ID,
+			Title:           ad.Title,
+			Zone:            placement.Zone,
+			Schedule:        schedule,
+			Assets:          []AssetRef{},
+		}
+		for _, asset := range []struct {
+			kind string
+			meta models.ManifestAsset
+		}{
+			{AssetImage, models.ManifestAsset{URL: ad.ImageURL, Size: ad.ImageSize, SHA256: ad.ImageSHA256}},
+			{AssetVideo, models.ManifestAsset{URL: ad.VideoURL, Size: ad.VideoSize, SHA256: ad.VideoSHA256}},
+		} {
+			if asset.meta.URL == "" {
+				continue
+			}
+			item.Assets = append(item.Assets, AssetRef{Type: asset.kind, URL: asset.meta.URL})
+			// 同一 URL 被多条广告使用时只列出一次，优先保留已登记哈希的信息
+			if existing, ok := assets[asset.meta.URL]; !ok || existing.SHA256 == "" {
+				assets[asset.meta.URL] = asset.meta
+			}
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	if result.Calendars, err = s.calendars(ctx, calendarIDs); err != nil {
+		return nil, err
+	}
+	result.Assets = make([]models.ManifestAsset, 0, len(assets))
+	for _, asset := range assets {
+		result.Assets = append(result.Assets, asset)
+	}
+	slices.SortFunc(result.Assets, func(a, b models.ManifestAsset) int { return cmp.Compare(a.URL, b.URL) })
+	return result, nil
+}
+
+// calendars 按 ID 顺序返回日历及其事件，事件按开始日期排序；已删除的日历返回空的事件列表，
+// 与服务端判断时一样视为不包含任何日期
+func (s *Service) calendars(ctx context.Context, ids map[uint]bool) ([]Calendar, error) {
+	calendars := make([]Calendar, 0, len(ids))
+	if len(ids) == 0 {
+		return calendars, nil
+	}
+	sorted := make([]uint, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	slices.Sort(sorted)
+	events, err := s.store.Calendars().EventsFor(ctx, sorted)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(events, func(a, b models.CalendarEvent) int {
+		return cmp.Or(cmp.Compare(a.StartDate, b.StartDate), cmp.Compare(a.ID, b.ID))
+	})
+	index := make(map[uint]int, len(sorted))
+	for i, id := range sorted {
+		index[id] = i
+		calendars = append(calendars, Calendar{ID: id, Events: []CalendarEvent{}})
+	}
+	for _, event := range events {
+		calendar := &calendars[index[event.CalendarID]]
+		calendar.Events = append(calendar.Events, CalendarEvent{
+			Summary:   event.Summary,
+			StartDate: event.StartDate,
+			EndDate:   event.EndDate,
+			RRule:     event.RRule,
+		})
+	}
+	return calendars, nil
+}
+
+// contentHash 计算清单内容的 SHA-256
+func contentHash(c *content) (string, error) {
+	encoded, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// difference 返回 a 中有而 b 中没有的素材，URL 相同但大小或哈希变化时视为不同素材
+func difference(a, b []models.ManifestAsset) []models.ManifestAsset {
+	result := []models.ManifestAsset{}
+	for _, asset := range a {
+		if !slices.Contains(b, asset) {
+			result = append(result, asset)
+		}
+	}
+	return result
+}
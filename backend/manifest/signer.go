@@ -0,0 +1,60 @@
+package manifest
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// Signer 使用 Ed25519 对清单响应体签名
+type Signer struct {
+	key   ed25519.PrivateKey
+	keyID string
+}
+
+// NewSigner 从 base64 编码的 32 字节种子或 64 字节私钥创建 Signer。
+// encoded 为空时随机生成密钥，只用于测试；服务启动时配置校验要求必须设置密钥
+func NewSigner(encoded string) (*Signer, error) {
+	var key ed25519.PrivateKey
+	if encoded == "" {
+		_, generated, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		key = generated
+	} else {
+		raw, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("签名密钥不是有效的 base64: %w", err)
+		}
+		switch len(raw) {
+		case ed25519.SeedSize:
+			key = ed25519.NewKeyFromSeed(raw)
+		case ed25519.PrivateKeySize:
+			key = ed25519.PrivateKey(raw)
+		default:
+			return nil, fmt.Errorf("签名密钥长度应为 %d 或 %d 字节，实际为 %d", ed25519.SeedSize, ed25519.PrivateKeySize, len(raw))
+		}
+	}
+
+	sum := sha256.Sum256(key.Public().(ed25519.PublicKey))
+	return &Signer{key: key, keyID: hex.EncodeToString(sum[:8])}, nil
+}
+
+// Sign 返回 body 的 base64 签名
+func (s *Signer) Sign(body []byte) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, body))
+}
+
+// PublicKey 返回 base64 编码的 32 字节公钥，播放端用它校验签名
+func (s *Signer) PublicKey() string {
+	return base64.StdEncoding.EncodeToString(s.key.Public().(ed25519.PublicKey))
+}
+
+// KeyID 返回公钥 SHA-256 的前 8 字节（十六进制），用于在轮换密钥时区分
+func (s *Signer) KeyID() string {
+	return s.keyID
+}
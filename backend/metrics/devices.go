@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+// DeviceTracker 按设备标识记录最近一次活动时间，用于统计活跃设备数
+type DeviceTracker struct {
+	mu       sync.Mutex
+	window   time.Duration
+	lastSeen map[string]time.Time
+	now      func() time.Time
+}
+
+// NewDeviceTracker 创建 DeviceTracker，window 内有活动的设备视为活跃
+func NewDeviceTracker(window time.Duration) *DeviceTracker {
+	return &DeviceTracker{window: window, lastSeen: make(map[string]time.Time), now: time.Now}
+}
+
+// Seen 记录设备的一次活动
+func (t *DeviceTracker) Seen(deviceID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lastSeen[deviceID] = t.now()
+}
+
+// Active 返回窗口内有活动的设备数
+func (t *DeviceTracker) Active() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cutoff := t.now().Add(-t.window)
+	active := 0
+	for _, seen := range t.lastSeen {
+		if !seen.Before(cutoff) {
+			active++
+		}
+	}
+	return active
+}
+
+// Prune 删除超出窗口的设备记录，返回删除的数量，由后台任务定期调用
+func (t *DeviceTracker) Prune() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cutoff := t.now().Add(-t.window)
+	removed := 0
+	for id, seen := range t.lastSeen {
+		if seen.Before(cutoff) {
+			delete(t.lastSeen, id)
+			removed++
+		}
+	}
+	return removed
+}
@@ -0,0 +1,121 @@
+package metrics
+
+// metrics 定义 Prometheus 指标，通过 /metrics 暴露
+
+import (
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "ams"
+
+// Registry 应用使用的指标注册表，包含 Go 运行时与进程指标
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "按路由、方法和状态码统计的 HTTP 请求数",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "按路由、方法和状态码统计的 HTTP 请求耗时",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	playlistFetches = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "playlist_fetches_total",
+		Help:      "设备拉取大厦离线播放清单的次数",
+	})
+
+	uploadPolicies = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "upload_policies_total",
+		Help:      "OSS 上传策略签发次数，result 为 success 或 failure",
+	}, []string{"result"})
+
+	webhookDeliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhook_deliveries_total",
+		Help:      "事件推送投递尝试次数，result 为 success、retry 或 failure（不再重试）",
+	}, []string{"result"})
+)
+
+// Devices 记录最近拉取过播放列表的设备
+var Devices = NewDeviceTracker(5 * time.Minute)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		playlistFetches,
+		uploadPolicies,
+		webhookDeliveries,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "active_devices",
+			Help:      "活跃窗口内拉取过播放列表的设备数",
+		}, func() float64 { return float64(Devices.Active()) }),
+	)
+}
+
+// RegisterDB 注册数据库连接池指标（打开/空闲/使用中的连接数、等待次数等）
+func RegisterDB(db *sql.DB, name string) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// Handler 返回 /metrics 处理函数
+func Handler() gin.HandlerFunc {
+	h := promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+	return gin.WrapH(h)
+}
+
+// Middleware 统计每个请求的状态码与耗时，路由使用注册时的模板（如 /api/ads/:id）避免标签基数过高
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		status := strconv.Itoa(c.Writer.Status())
+		httpRequests.WithLabelValues(c.Request.Method, route, status).Inc()
+		httpDuration.WithLabelValues(c.Request.Method, route, status).Observe(time.Since(start).Seconds())
+	}
+}
+
+// PlaylistFetched 记录一次设备拉取播放清单，deviceID 为空时不计入活跃设备；只在面向播放端的接口调用
+func PlaylistFetched(deviceID string) {
+	playlistFetches.Inc()
+	if deviceID != "" {
+		Devices.Seen(deviceID)
+	}
+}
+
+// UploadPolicyIssued 记录一次上传策略签发结果
+func UploadPolicyIssued(err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	uploadPolicies.WithLabelValues(result).Inc()
+}
+
+// WebhookDelivered 记录一次事件投递尝试的结果
+func WebhookDelivered(result string) {
+	webhookDeliveries.WithLabelValues(result).Inc()
+}
@@ -1,39 +1,207 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/apikey"
 	"github.com/10240418/advertisement-management-system/backend/config"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
 	"github.com/gin-gonic/gin"
 )
 
-func AuthMiddleware() gin.HandlerFunc {
+// 上下文中保存当前管理员与 API 密钥的键
+const (
+	adminKey  = "admin"
+	apiKeyKey = "api_key"
+)
+
+// APIKeyHeader 不使用 Authorization 请求头时携带 API 密钥的请求头
+const APIKeyHeader = "X-API-Key"
+
+// API 密钥的最近使用时间最多每分钟写入一次
+const apiKeyTouchInterval = time.Minute
+
+// AuthMiddleware 校验 Bearer 令牌或 API 密钥，并从 store 加载对应的管理员或密钥
+// 管理员已被删除，或令牌签发于最近一次修改密码之前时，令牌视为无效
+func AuthMiddleware(store repository.Store) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// 从请求头获取 Authorization 字段
+		// 从请求头获取 Authorization 字段，系统集成也可以使用 X-API-Key
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header required"})
+			if key := strings.TrimSpace(c.GetHeader(APIKeyHeader)); key != "" {
+				authenticateAPIKey(c, store, key)
+				return
+			}
+			apierror.Respond(c, apierror.Unauthorized(apierror.CodeAuthRequired))
 			return
 		}
 
 		// 解析 Bearer token
 		parts := strings.SplitN(authHeader, " ", 2)
 		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header format must be Bearer {token}"})
+			apierror.Respond(c, apierror.Unauthorized(apierror.CodeAuthMalformed))
 			return
 		}
 
 		tokenStr := parts[1]
+		if apikey.Looks(tokenStr) {
+			authenticateAPIKey(c, store, tokenStr)
+			return
+		}
 		claims, err := config.ValidateToken(tokenStr)
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
+			apierror.Respond(c, apierror.Unauthorized(apierror.CodeInvalidToken))
+			return
+		}
+
+		admin, err := store.Admins().GetByUsername(c.Request.Context(), claims.Username)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				apierror.Respond(c, apierror.Unauthorized(apierror.CodeInvalidToken))
+				return
+			}
+			apierror.Respond(c, apierror.Internal(err))
+			return
+		}
+		if admin.TokenRevoked(claims.IssuedAt) {
+			apierror.Respond(c, apierror.Unauthorized(apierror.CodeInvalidToken))
+			return
+		}
+
+		// 将当前管理员存入上下文
+		c.Set("username", admin.Username)
+		c.Set(adminKey, admin)
+
+		c.Next()
+	}
+}
+
+// OptionalAuth 请求携带令牌或 API 密钥时按 AuthMiddleware 校验，未携带时以匿名身份继续
+func OptionalAuth(store repository.Store) gin.HandlerFunc {
+	auth := AuthMiddleware(store)
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" && strings.TrimSpace(c.GetHeader(APIKeyHeader)) == "" {
+			c.Next()
 			return
 		}
+		auth(c)
+	}
+}
 
-		// 可选：将用户名等信息存入上下文
-		c.Set("username", claims.Username)
+// authenticateAPIKey 校验 API 密钥，通过后将密钥存入上下文并继续处理请求
+func authenticateAPIKey(c *gin.Context, store repository.Store, raw string) {
+	ctx := c.Request.Context()
+	prefix, ok := apikey.Parse(raw)
+	if !ok {
+		apierror.Respond(c, apierror.Unauthorized(apierror.CodeInvalidAPIKey))
+		return
+	}
+	key, err := store.APIKeys().GetByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Respond(c, apierror.Unauthorized(apierror.CodeInvalidAPIKey))
+			return
+		}
+		apierror.Respond(c, apierror.Internal(err))
+		return
+	}
+	now := time.Now()
+	if !apikey.Verify(raw, key.KeyHash) || !key.Active(now) {
+		apierror.Respond(c, apierror.Unauthorized(apierror.CodeInvalidAPIKey))
+		return
+	}
+
+	// 记录最近使用情况，失败不影响本次请求
+	ip := c.ClientIP()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval || key.LastUsedIP != ip {
+		if err := store.APIKeys().Touch(ctx, key.ID, now, ip); err != nil {
+			logging.FromContext(ctx).WarnContext(ctx, "记录 API 密钥使用时间失败", "prefix", key.Prefix, "error", err)
+		}
+	}
+
+	// 限流按密钥区分
+	c.Set("username", "apikey:"+key.Prefix)
+	c.Set(apiKeyKey, key)
+	c.Next()
+}
 
+// RequireRole 只允许指定角色的管理员访问，需放在 AuthMiddleware 之后
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if admin := CurrentAdmin(c); admin == nil || admin.Role != role {
+			apierror.Respond(c, apierror.Forbidden(apierror.CodePermissionDenied))
+			return
+		}
+		c.Next()
+	}
+}
+
+// CurrentAdmin 返回 AuthMiddleware 加载的当前管理员，未认证时返回 nil
+func CurrentAdmin(c *gin.Context) *models.Administrator {
+	admin, _ := c.Get(adminKey)
+	current, _ := admin.(*models.Administrator)
+	return current
+}
+
+// RequireSuperAdminTOTP 开启时，未启用两步验证的超级管理员只能访问 exemptPrefix 下的两步验证接口
+// 需放在 AuthMiddleware 之后
+func RequireSuperAdminTOTP(enabled bool, exemptPrefix string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		admin := CurrentAdmin(c)
+		if enabled && admin != nil && admin.Role == models.RoleSuperAdmin && !admin.TOTPEnabled &&
+			!strings.HasPrefix(c.FullPath(), exemptPrefix) {
+			apierror.Respond(c, apierror.Forbidden(apierror.CodeTOTPEnrollmentRequired))
+			return
+		}
+		c.Next()
+	}
+}
+
+// CurrentAPIKey 返回通过认证的 API 密钥，使用管理员令牌时返回 nil
+func CurrentAPIKey(c *gin.Context) *models.APIKey {
+	key, _ := c.Get(apiKeyKey)
+	current, _ := key.(*models.APIKey)
+	return current
+}
+
+// RequireScope 要求 API 密钥被授予 scope，管理员令牌不受限制
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if key := CurrentAPIKey(c); key != nil && !key.HasScope(scope) {
+			apierror.Respond(c, apierror.Forbidden(apierror.CodeInsufficientScope).
+				With("scope", scope).
+				WithMeta("required_scope", scope))
+			return
+		}
+		c.Next()
+	}
+}
+
+// ResourceScope 按请求方法要求 resource:read（GET、HEAD）或 resource:write
+func ResourceScope(resource string) gin.HandlerFunc {
+	read, write := RequireScope(resource+":read"), RequireScope(resource+":write")
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			read(c)
+			return
+		}
+		write(c)
+	}
+}
+
+// RequireAdminSession 只允许管理员令牌访问，拒绝 API 密钥
+func RequireAdminSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if CurrentAdmin(c) == nil {
+			apierror.Respond(c, apierror.Forbidden(apierror.CodePermissionDenied))
+			return
+		}
 		c.Next()
 	}
 }
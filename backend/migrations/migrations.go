@@ -0,0 +1,320 @@
+package migrations
+
+// migrations 管理数据库结构的版本化迁移，迁移文件以 SQL 形式嵌入二进制
+// 文件命名格式为 <版本号>_<名称>.up.sql 与 <版本号>_<名称>.down.sql
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+// 迁移期间使用的 Postgres advisory lock 键，防止多个实例同时迁移
+const lockKey = 7284215
+
+// ErrVersionMismatch 表示数据库结构版本与程序期望的版本不一致
+var ErrVersionMismatch = errors.New("数据库结构版本不匹配")
+
+// Migration 表示一个迁移版本
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status 表示迁移的执行状态
+type Status struct {
+	Version   int64      `json:"version"`
+	Name      string     `json:"name"`
+	AppliedAt *time.Time `json:"applied_at"`
+}
+
+// schemaMigration 对应 schema_migrations 表中的记录
+type schemaMigration struct {
+	Version   int64 `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migrator 执行和查询迁移
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// New 加载嵌入的迁移文件并创建 Migrator
+func New(db *gorm.DB) (*Migrator, error) {
+	migrations, err := load(files)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// load 解析迁移文件，要求每个版本同时具有 up 与 down 文件
+func load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, "sql")
+	if err != nil {
+		return nil, fmt.Errorf("读取迁移文件失败: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		filename := entry.Name()
+		var direction string
+		switch {
+		case strings.HasSuffix(filename, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(filename, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+
+		base := strings.TrimSuffix(filename, "."+direction+".sql")
+		versionStr, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("迁移文件名格式错误: %s", filename)
+		}
+		version, err := strconv.ParseInt(versionStr, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("迁移文件版本号无效: %s", filename)
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join("sql", filename))
+		if err != nil {
+			return nil, fmt.Errorf("读取迁移文件 %s 失败: %w", filename, err)
+		}
+
+		m, exists := byVersion[version]
+		if !exists {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		} else if m.Name != name {
+			return nil, fmt.Errorf("迁移版本 %d 的文件名不一致", version)
+		}
+		if direction == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("迁移版本 %d 缺少 up 或 down 文件", m.Version)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Latest 返回程序内置的最新迁移版本
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// ensureTable 创建 schema_migrations 表
+func (m *Migrator) ensureTable(db *gorm.DB) error {
+	return db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    BIGINT PRIMARY KEY,
+		name       TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`).Error
+}
+
+// applied 返回已执行的迁移，键为版本号
+func (m *Migrator) applied(db *gorm.DB) (map[int64]schemaMigration, error) {
+	var rows []schemaMigration
+	if err := db.Order("version ASC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	result := make(map[int64]schemaMigration, len(rows))
+	for _, row := range rows {
+		result[row.Version] = row
+	}
+	return result, nil
+}
+
+// CurrentVersion 返回数据库中已执行的最高迁移版本，未执行过任何迁移时返回 0
+func (m *Migrator) CurrentVersion(ctx context.Context) (int64, error) {
+	db := m.db.WithContext(ctx)
+	if !db.Migrator().HasTable("schema_migrations") {
+		return 0, nil
+	}
+	var version int64
+	if err := db.Model(&schemaMigration{}).Select("COALESCE(MAX(version), 0)").Scan(&version).Error; err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// CheckVersion 检查程序内置的迁移是否全部执行，且数据库中没有程序未知的迁移
+// 只比较最高版本会漏掉合并分支时插入的较低版本，因此逐个版本比较
+func (m *Migrator) CheckVersion(ctx context.Context) error {
+	db := m.db.WithContext(ctx)
+	applied := map[int64]schemaMigration{}
+	if db.Migrator().HasTable("schema_migrations") {
+		var err error
+		if applied, err = m.applied(db); err != nil {
+			return fmt.Errorf("读取数据库结构版本失败: %w", err)
+		}
+	}
+	return compareVersions(m.migrations, applied)
+}
+
+// compareVersions 比较内置迁移与已执行的迁移，列出未执行的迁移与程序未知的版本
+func compareVersions(migrations []Migration, applied map[int64]schemaMigration) error {
+	known := make(map[int64]bool, len(migrations))
+	var missing []string
+	for _, migration := range migrations {
+		known[migration.Version] = true
+		if _, ok := applied[migration.Version]; !ok {
+			missing = append(missing, fmt.Sprintf("%d_%s", migration.Version, migration.Name))
+		}
+	}
+	var versions []int64
+	for version := range applied {
+		if !known[version] {
+			versions = append(versions, version)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	unknown := make([]string, 0, len(versions))
+	for _, version := range versions {
+		unknown = append(unknown, fmt.Sprintf("%d_%s", version, applied[version].Name))
+	}
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("未执行的迁移 %s，请先执行 migrate up", strings.Join(missing, ", ")))
+	}
+	if len(unknown) > 0 {
+		problems = append(problems, fmt.Sprintf("数据库包含程序未知的迁移 %s，请升级程序", strings.Join(unknown, ", ")))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrVersionMismatch, strings.Join(problems, "；"))
+	}
+	return nil
+}
+
+// Status 返回所有迁移及其执行时间
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	db := m.db.WithContext(ctx)
+	if err := m.ensureTable(db); err != nil {
+		return nil, fmt.Errorf("创建 schema_migrations 表失败: %w", err)
+	}
+	applied, err := m.applied(db)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if row, ok := applied[migration.Version]; ok {
+			appliedAt := row.AppliedAt
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Up 按版本顺序执行所有未执行的迁移，每个迁移在独立事务中执行
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	db := m.db.WithContext(ctx)
+	if err := m.ensureTable(db); err != nil {
+		return nil, fmt.Errorf("创建 schema_migrations 表失败: %w", err)
+	}
+
+	var done []Migration
+	for _, migration := range m.migrations {
+		executed := false
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", lockKey).Error; err != nil {
+				return err
+			}
+			// 获取锁后重新检查，其他实例可能已执行该迁移
+			var count int64
+			if err := tx.Model(&schemaMigration{}).Where("version = ?", migration.Version).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return nil
+			}
+			if err := tx.Exec(migration.Up).Error; err != nil {
+				return err
+			}
+			executed = true
+			return tx.Create(&schemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("执行迁移 %d_%s 失败: %w", migration.Version, migration.Name, err)
+		}
+		if executed {
+			done = append(done, migration)
+		}
+	}
+	return done, nil
+}
+
+// Down 回滚最近执行的 steps 个迁移
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	db := m.db.WithContext(ctx)
+	if err := m.ensureTable(db); err != nil {
+		return nil, fmt.Errorf("创建 schema_migrations 表失败: %w", err)
+	}
+
+	var done []Migration
+	for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		migration := m.migrations[i]
+		executed := false
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", lockKey).Error; err != nil {
+				return err
+			}
+			var count int64
+			if err := tx.Model(&schemaMigration{}).Where("version = ?", migration.Version).Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				return nil
+			}
+			if err := tx.Exec(migration.Down).Error; err != nil {
+				return err
+			}
+			executed = true
+			return tx.Where("version = ?", migration.Version).Delete(&schemaMigration{}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("回滚迁移 %d_%s 失败: %w", migration.Version, migration.Name, err)
+		}
+		if executed {
+			done = append(done, migration)
+		}
+	}
+	return done, nil
+}
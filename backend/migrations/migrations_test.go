@@ -0,0 +1,80 @@
+package migrations
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadEmbedded(t *testing.T) {
+	migrations, err := load(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("没有内置的迁移")
+	}
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].Version <= migrations[i-1].Version {
+			t.Fatalf("迁移版本未按升序排列：%d 在 %d 之后", migrations[i].Version, migrations[i-1].Version)
+		}
+	}
+}
+
+func TestLoadRejectsIncompletePair(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sql/1_init.up.sql":   {Data: []byte("CREATE TABLE a ();")},
+		"sql/1_init.down.sql": {Data: []byte("DROP TABLE a;")},
+		"sql/2_extra.up.sql":  {Data: []byte("CREATE TABLE b ();")},
+		"sql/README.md":       {Data: []byte("忽略")},
+	}
+	if _, err := load(fsys); err == nil {
+		t.Fatal("缺少 down 文件时应返回错误")
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Name: "init"},
+		{Version: 2, Name: "ads"},
+		{Version: 3, Name: "billing"},
+	}
+	applied := func(versions ...int64) map[int64]schemaMigration {
+		result := make(map[int64]schemaMigration, len(versions))
+		for _, v := range versions {
+			result[v] = schemaMigration{Version: v, Name: "m"}
+		}
+		return result
+	}
+
+	tests := []struct {
+		name    string
+		applied map[int64]schemaMigration
+		want    string // 为空表示一致
+	}{
+		{"全部已执行", applied(1, 2, 3), ""},
+		{"尚未执行任何迁移", applied(), "数据库结构版本不匹配: 未执行的迁移 1_init, 2_ads, 3_billing，请先执行 migrate up"},
+		{"缺少最新版本", applied(1, 2), "数据库结构版本不匹配: 未执行的迁移 3_billing，请先执行 migrate up"},
+		{"最高版本一致但缺少中间版本", applied(1, 3), "数据库结构版本不匹配: 未执行的迁移 2_ads，请先执行 migrate up"},
+		{"数据库包含程序未知的版本", applied(1, 2, 3, 10, 4), "数据库结构版本不匹配: 数据库包含程序未知的迁移 4_m, 10_m，请升级程序"},
+		{"既缺少又多出", applied(1, 3, 4), "数据库结构版本不匹配: 未执行的迁移 2_ads，请先执行 migrate up；数据库包含程序未知的迁移 4_m，请升级程序"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := compareVersions(migrations, tt.applied)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("期望一致，得到 %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrVersionMismatch) {
+				t.Fatalf("错误 %v 不是 ErrVersionMismatch", err)
+			}
+			if err.Error() != tt.want {
+				t.Fatalf("错误\n%s\n期望\n%s", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS advertisement_buildings;
+DROP TABLE IF EXISTS administrators;
+DROP TABLE IF EXISTS buildings;
+DROP TABLE IF EXISTS advertisements;
@@ -0,0 +1,51 @@
+-- 初始表结构，与此前 AutoMigrate 生成的结构一致，已有数据库执行时不会重复创建
+
+CREATE TABLE IF NOT EXISTS advertisements (
+    id             BIGSERIAL PRIMARY KEY,
+    created_at     TIMESTAMPTZ,
+    updated_at     TIMESTAMPTZ,
+    deleted_at     TIMESTAMPTZ,
+    title          TEXT,
+    description    TEXT,
+    image_url      TEXT,
+    video_url      TEXT,
+    video_duration BIGINT,
+    status         TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_advertisements_deleted_at ON advertisements (deleted_at);
+
+CREATE TABLE IF NOT EXISTS buildings (
+    id          BIGSERIAL PRIMARY KEY,
+    created_at  TIMESTAMPTZ,
+    updated_at  TIMESTAMPTZ,
+    deleted_at  TIMESTAMPTZ,
+    name        TEXT NOT NULL,
+    address     TEXT,
+    building_id TEXT,
+    CONSTRAINT uni_buildings_name UNIQUE (name)
+);
+CREATE INDEX IF NOT EXISTS idx_buildings_deleted_at ON buildings (deleted_at);
+
+CREATE TABLE IF NOT EXISTS administrators (
+    id         BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    username   TEXT NOT NULL,
+    password   VARCHAR(100) NOT NULL,
+    CONSTRAINT uni_administrators_username UNIQUE (username)
+);
+CREATE INDEX IF NOT EXISTS idx_administrators_deleted_at ON administrators (deleted_at);
+
+CREATE TABLE IF NOT EXISTS advertisement_buildings (
+    advertisement_id BIGINT NOT NULL,
+    building_id      BIGINT NOT NULL,
+    play_duration    BIGINT,
+    CONSTRAINT fk_advertisement_buildings_advertisement FOREIGN KEY (advertisement_id)
+        REFERENCES advertisements (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_advertisement_buildings_building FOREIGN KEY (building_id)
+        REFERENCES buildings (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+-- 防止重复关联
+CREATE UNIQUE INDEX IF NOT EXISTS idx_advertisement_building ON advertisement_buildings (advertisement_id, building_id);
@@ -0,0 +1,4 @@
+-- 登录失败计数与账号锁定
+ALTER TABLE administrators
+    ADD COLUMN IF NOT EXISTS failed_login_attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS admin_audit_logs;
+
+ALTER TABLE administrators
+    DROP COLUMN IF EXISTS password_changed_at,
+    DROP COLUMN IF EXISTS last_login_at,
+    DROP COLUMN IF EXISTS role;
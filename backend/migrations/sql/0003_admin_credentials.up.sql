@@ -0,0 +1,24 @@
+-- 管理员角色、登录与改密时间
+ALTER TABLE administrators
+    ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'admin',
+    ADD COLUMN IF NOT EXISTS last_login_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS password_changed_at TIMESTAMPTZ;
+
+-- 已有部署中最早创建的管理员成为超级管理员
+UPDATE administrators SET role = 'super_admin'
+WHERE id = (SELECT MIN(id) FROM administrators)
+  AND NOT EXISTS (SELECT 1 FROM administrators WHERE role = 'super_admin');
+
+-- 管理员敏感操作审计日志
+CREATE TABLE IF NOT EXISTS admin_audit_logs (
+    id              BIGSERIAL PRIMARY KEY,
+    created_at      TIMESTAMPTZ NOT NULL,
+    actor_id        BIGINT NOT NULL,
+    actor_username  TEXT NOT NULL,
+    action          VARCHAR(50) NOT NULL,
+    target_id       BIGINT,
+    target_username TEXT,
+    client_ip       TEXT,
+    request_id      TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_logs_created_at ON admin_audit_logs (created_at);
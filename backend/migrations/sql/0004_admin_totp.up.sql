@@ -0,0 +1,17 @@
+-- 管理员两步验证（TOTP）
+ALTER TABLE administrators
+    ADD COLUMN IF NOT EXISTS totp_secret TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS totp_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS totp_last_step BIGINT NOT NULL DEFAULT 0;
+
+-- 两步验证恢复码，只保存 SHA-256 哈希
+CREATE TABLE IF NOT EXISTS admin_recovery_codes (
+    id         BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL,
+    admin_id   BIGINT NOT NULL,
+    code_hash  CHAR(64) NOT NULL,
+    used_at    TIMESTAMPTZ,
+    CONSTRAINT fk_admin_recovery_codes_admin FOREIGN KEY (admin_id)
+        REFERENCES administrators (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_admin_recovery_codes_admin_id ON admin_recovery_codes (admin_id);
@@ -0,0 +1,16 @@
+-- 供系统集成使用的 API 密钥，只保存 SHA-256 哈希
+CREATE TABLE IF NOT EXISTS api_keys (
+    id            BIGSERIAL PRIMARY KEY,
+    created_at    TIMESTAMPTZ,
+    updated_at    TIMESTAMPTZ,
+    name          TEXT NOT NULL,
+    prefix        VARCHAR(20) NOT NULL,
+    key_hash      CHAR(64) NOT NULL,
+    scopes        TEXT NOT NULL,
+    expires_at    TIMESTAMPTZ,
+    revoked_at    TIMESTAMPTZ,
+    last_used_at  TIMESTAMPTZ,
+    last_used_ip  TEXT,
+    created_by_id BIGINT NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_prefix ON api_keys (prefix);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS playlist_manifests;
+
+ALTER TABLE advertisements
+    DROP COLUMN IF EXISTS image_size,
+    DROP COLUMN IF EXISTS image_sha256,
+    DROP COLUMN IF EXISTS video_size,
+    DROP COLUMN IF EXISTS video_sha256;
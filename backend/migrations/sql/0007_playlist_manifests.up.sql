@@ -0,0 +1,20 @@
+-- 广告素材的大小与 SHA-256，供离线播放清单校验完整性
+ALTER TABLE advertisements
+    ADD COLUMN IF NOT EXISTS image_size BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS image_sha256 VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS video_size BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS video_sha256 VARCHAR(64) NOT NULL DEFAULT '';
+
+-- 大厦播放清单的历史版本，内容变化时生成新版本，用于计算增量
+CREATE TABLE IF NOT EXISTS playlist_manifests (
+    id           BIGSERIAL PRIMARY KEY,
+    created_at   TIMESTAMPTZ NOT NULL,
+    building_id  BIGINT NOT NULL,
+    version      INTEGER NOT NULL,
+    content_hash CHAR(64) NOT NULL,
+    assets       TEXT NOT NULL,
+    body         TEXT NOT NULL,
+    CONSTRAINT fk_playlist_manifests_building FOREIGN KEY (building_id)
+        REFERENCES buildings (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_playlist_manifests_building_version ON playlist_manifests (building_id, version);
@@ -0,0 +1,7 @@
+ALTER TABLE advertisement_buildings
+    DROP COLUMN IF EXISTS zone;
+
+ALTER TABLE buildings
+    DROP COLUMN IF EXISTS layout_id;
+
+DROP TABLE IF EXISTS layouts;
@@ -0,0 +1,22 @@
+-- 屏幕布局模板，区域以 JSON 保存
+CREATE TABLE IF NOT EXISTS layouts (
+    id          BIGSERIAL PRIMARY KEY,
+    created_at  TIMESTAMPTZ,
+    updated_at  TIMESTAMPTZ,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    width       INTEGER NOT NULL,
+    height      INTEGER NOT NULL,
+    zones       TEXT NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_layouts_name ON layouts (name);
+
+-- 大厦使用的布局，布局被使用时不能删除
+ALTER TABLE buildings
+    ADD COLUMN IF NOT EXISTS layout_id BIGINT,
+    ADD CONSTRAINT fk_buildings_layout FOREIGN KEY (layout_id) REFERENCES layouts (id);
+CREATE INDEX IF NOT EXISTS idx_buildings_layout_id ON buildings (layout_id);
+
+-- 投放所在的布局区域，为空表示默认媒体区域
+ALTER TABLE advertisement_buildings
+    ADD COLUMN IF NOT EXISTS zone VARCHAR(50) NOT NULL DEFAULT '';
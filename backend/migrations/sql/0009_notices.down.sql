@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS building_notices;
+DROP TABLE IF EXISTS notices;
@@ -0,0 +1,27 @@
+-- 大厦通知，内容为清理后的 HTML
+CREATE TABLE IF NOT EXISTS notices (
+    id         BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    title      TEXT NOT NULL,
+    content    TEXT NOT NULL,
+    priority   INTEGER NOT NULL DEFAULT 0,
+    starts_at  TIMESTAMPTZ NOT NULL,
+    ends_at    TIMESTAMPTZ,
+    created_by TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_notices_validity ON notices (starts_at, ends_at);
+
+CREATE TABLE IF NOT EXISTS building_notices (
+    notice_id   BIGINT NOT NULL,
+    building_id BIGINT NOT NULL,
+    created_at  TIMESTAMPTZ,
+    CONSTRAINT fk_building_notices_notice FOREIGN KEY (notice_id)
+        REFERENCES notices (id) ON DELETE CASCADE,
+    CONSTRAINT fk_building_notices_building FOREIGN KEY (building_id)
+        REFERENCES buildings (id) ON DELETE CASCADE
+);
+
+-- 防止重复关联，并按大厦查询有效通知
+CREATE UNIQUE INDEX IF NOT EXISTS idx_building_notice ON building_notices (building_id, notice_id);
+CREATE INDEX IF NOT EXISTS idx_building_notices_notice_id ON building_notices (notice_id);
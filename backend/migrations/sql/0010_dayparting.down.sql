@@ -0,0 +1,9 @@
+ALTER TABLE buildings
+    DROP COLUMN IF EXISTS timezone;
+
+ALTER TABLE advertisement_buildings
+    DROP COLUMN IF EXISTS daypart_id;
+
+DROP TABLE IF EXISTS dayparts;
+DROP TABLE IF EXISTS calendar_events;
+DROP TABLE IF EXISTS calendars;
@@ -0,0 +1,46 @@
+-- 命名日历与日历事件，事件为全天事件，日期按大厦当地时间解释
+CREATE TABLE IF NOT EXISTS calendars (
+    id          BIGSERIAL PRIMARY KEY,
+    created_at  TIMESTAMPTZ,
+    updated_at  TIMESTAMPTZ,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_calendars_name ON calendars (name);
+
+CREATE TABLE IF NOT EXISTS calendar_events (
+    id          BIGSERIAL PRIMARY KEY,
+    created_at  TIMESTAMPTZ,
+    calendar_id BIGINT NOT NULL,
+    uid         TEXT NOT NULL DEFAULT '',
+    summary     TEXT NOT NULL DEFAULT '',
+    start_date  VARCHAR(10) NOT NULL,
+    end_date    VARCHAR(10) NOT NULL,
+    rrule       TEXT NOT NULL DEFAULT '',
+    CONSTRAINT fk_calendar_events_calendar FOREIGN KEY (calendar_id)
+        REFERENCES calendars (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_calendar_events_calendar_id ON calendar_events (calendar_id);
+
+-- 分时规则，时段与引用的日历以 JSON 保存
+CREATE TABLE IF NOT EXISTS dayparts (
+    id                   BIGSERIAL PRIMARY KEY,
+    created_at           TIMESTAMPTZ,
+    updated_at           TIMESTAMPTZ,
+    name                 TEXT NOT NULL,
+    description          TEXT NOT NULL DEFAULT '',
+    windows              TEXT NOT NULL,
+    include_calendar_ids TEXT NOT NULL DEFAULT '[]',
+    exclude_calendar_ids TEXT NOT NULL DEFAULT '[]'
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_dayparts_name ON dayparts (name);
+
+-- 投放使用的分时规则，规则被使用时不能删除
+ALTER TABLE advertisement_buildings
+    ADD COLUMN IF NOT EXISTS daypart_id BIGINT,
+    ADD CONSTRAINT fk_advertisement_buildings_daypart FOREIGN KEY (daypart_id) REFERENCES dayparts (id);
+CREATE INDEX IF NOT EXISTS idx_advertisement_buildings_daypart_id ON advertisement_buildings (daypart_id);
+
+-- 大厦所在时区，为空时使用 DEFAULT_TIMEZONE
+ALTER TABLE buildings
+    ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+ALTER TABLE buildings
+    DROP COLUMN IF EXISTS locale;
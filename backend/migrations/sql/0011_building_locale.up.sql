@@ -0,0 +1,3 @@
+-- 大厦的语言（BCP 47），为空时使用 DEFAULT_LOCALE
+ALTER TABLE buildings
+    ADD COLUMN IF NOT EXISTS locale VARCHAR(35) NOT NULL DEFAULT '';
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS exclusivity_rules;
+
+DROP INDEX IF EXISTS idx_advertisements_category;
+ALTER TABLE advertisements
+    DROP COLUMN IF EXISTS category;
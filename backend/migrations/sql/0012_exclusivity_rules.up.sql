@@ -0,0 +1,22 @@
+-- 广告主类别，为空表示不受排他规则限制
+ALTER TABLE advertisements
+    ADD COLUMN IF NOT EXISTS category VARCHAR(50) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_advertisements_category ON advertisements (category);
+
+-- 大厦的广告类别排他规则，每个大厦的每个类别最多一条；赞助广告删除时规则随之删除
+CREATE TABLE IF NOT EXISTS exclusivity_rules (
+    id            BIGSERIAL PRIMARY KEY,
+    created_at    TIMESTAMPTZ,
+    updated_at    TIMESTAMPTZ,
+    building_id   BIGINT NOT NULL,
+    category      VARCHAR(50) NOT NULL,
+    kind          VARCHAR(20) NOT NULL,
+    max_ads       INTEGER NOT NULL,
+    sponsor_ad_id BIGINT,
+    CONSTRAINT fk_exclusivity_rules_building FOREIGN KEY (building_id)
+        REFERENCES buildings (id) ON DELETE CASCADE,
+    CONSTRAINT fk_exclusivity_rules_sponsor FOREIGN KEY (sponsor_ad_id)
+        REFERENCES advertisements (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_exclusivity_rules_building_category ON exclusivity_rules (building_id, category);
+CREATE INDEX IF NOT EXISTS idx_exclusivity_rules_sponsor_ad_id ON exclusivity_rules (sponsor_ad_id);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_advertisements_pending_expiry;
+DROP INDEX IF EXISTS idx_advertisements_pending_go_live;
+ALTER TABLE advertisements
+    DROP COLUMN IF EXISTS expired_at,
+    DROP COLUMN IF EXISTS activated_at,
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS go_live_at;
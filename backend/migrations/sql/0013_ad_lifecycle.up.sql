@@ -0,0 +1,10 @@
+-- 广告的上线与到期时间，activated_at、expired_at 记录后台任务执行切换的时间，避免重复切换
+ALTER TABLE advertisements
+    ADD COLUMN IF NOT EXISTS go_live_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS activated_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS expired_at TIMESTAMPTZ;
+
+-- 后台任务按时间扫描待切换的广告
+CREATE INDEX IF NOT EXISTS idx_advertisements_pending_go_live ON advertisements (go_live_at) WHERE activated_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_advertisements_pending_expiry ON advertisements (expires_at) WHERE expired_at IS NULL;
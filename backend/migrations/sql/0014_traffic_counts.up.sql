@@ -0,0 +1,12 @@
+-- 大厦每小时的人流量，每个大厦的每个小时最多一条，重复上传时覆盖
+CREATE TABLE IF NOT EXISTS traffic_counts (
+    id          BIGSERIAL PRIMARY KEY,
+    created_at  TIMESTAMPTZ,
+    updated_at  TIMESTAMPTZ,
+    building_id BIGINT NOT NULL,
+    hour        TIMESTAMPTZ NOT NULL,
+    visitors    BIGINT NOT NULL,
+    CONSTRAINT fk_traffic_counts_building FOREIGN KEY (building_id)
+        REFERENCES buildings (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_traffic_counts_building_hour ON traffic_counts (building_id, hour);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS invoice_lines;
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS rate_cards;
+
+ALTER TABLE buildings
+    DROP COLUMN IF EXISTS tier;
@@ -0,0 +1,53 @@
+-- 大厦的计费等级，对应费率卡中的等级，为空时使用默认费率
+ALTER TABLE buildings
+    ADD COLUMN IF NOT EXISTS tier VARCHAR(50) NOT NULL DEFAULT '';
+
+-- 费率卡，rates 为按等级与当地时段的每秒价格（JSON）
+CREATE TABLE IF NOT EXISTS rate_cards (
+    id          BIGSERIAL PRIMARY KEY,
+    created_at  TIMESTAMPTZ,
+    updated_at  TIMESTAMPTZ,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    currency    VARCHAR(3) NOT NULL,
+    rates       TEXT NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rate_cards_name ON rate_cards (name);
+
+-- 发票保存开票时的快照，广告删除后仍然保留；费率卡删除时置空 rate_card_id
+CREATE TABLE IF NOT EXISTS invoices (
+    id                  BIGSERIAL PRIMARY KEY,
+    created_at          TIMESTAMPTZ,
+    updated_at          TIMESTAMPTZ,
+    advertisement_id    BIGINT NOT NULL,
+    advertisement_title TEXT NOT NULL,
+    rate_card_id        BIGINT,
+    rate_card_name      TEXT NOT NULL,
+    currency            VARCHAR(3) NOT NULL,
+    period_start        VARCHAR(10) NOT NULL,
+    period_end          VARCHAR(10) NOT NULL,
+    status              TEXT NOT NULL,
+    seconds             BIGINT NOT NULL,
+    total               BIGINT NOT NULL,
+    voided_at           TIMESTAMPTZ,
+    CONSTRAINT fk_invoices_rate_card FOREIGN KEY (rate_card_id)
+        REFERENCES rate_cards (id) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS idx_invoices_advertisement_id ON invoices (advertisement_id);
+
+-- 发票中每栋大厦的明细
+CREATE TABLE IF NOT EXISTS invoice_lines (
+    id            BIGSERIAL PRIMARY KEY,
+    invoice_id    BIGINT NOT NULL,
+    building_id   BIGINT NOT NULL,
+    building_name TEXT NOT NULL,
+    tier          TEXT NOT NULL,
+    timezone      TEXT NOT NULL,
+    play_duration BIGINT NOT NULL,
+    hours         INTEGER NOT NULL,
+    seconds       BIGINT NOT NULL,
+    amount        BIGINT NOT NULL,
+    CONSTRAINT fk_invoice_lines_invoice FOREIGN KEY (invoice_id)
+        REFERENCES invoices (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_invoice_lines_invoice_id ON invoice_lines (invoice_id);
@@ -3,6 +3,8 @@ package models
 // Advertisement 是一个数据库模型，用于存储广告信息
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -11,8 +13,17 @@ type Advertisement struct {
 	Title                  string                  `json:"title"`
 	Description            string                  `json:"description"`
 	ImageURL               string                  `json:"image_url"`
+	ImageSize              int64                   `json:"image_size" binding:"gte=0"`                                                     // 字节数，0 表示未登记
+	ImageSHA256            string                  `json:"image_sha256" gorm:"column:image_sha256" binding:"omitempty,len=64,hexadecimal"` // 小写十六进制，为空表示未登记
 	VideoURL               string                  `json:"video_url"`
-	VideoDuration          int64                   `json:"video_duration"` // 以秒为单位
-	Status                 string                  `json:"status"`         // active, inactive
+	VideoSize              int64                   `json:"video_size" binding:"gte=0"`
+	VideoSHA256            string                  `json:"video_sha256" gorm:"column:video_sha256" binding:"omitempty,len=64,hexadecimal"`
+	VideoDuration          int64                   `json:"video_duration"`            // 以秒为单位
+	Status                 string                  `json:"status"`                    // active, inactive
+	Category               string                  `json:"category" binding:"max=50"` // 广告主类别，例如 banking，用于大厦的排他规则，为空表示不限制
+	GoLiveAt               *time.Time              `json:"go_live_at"`                // 上线时间，到达时后台任务将状态改为 active，为空表示不自动上线
+	ExpiresAt              *time.Time              `json:"expires_at"`                // 到期时间，到达时后台任务将状态改为 inactive，为空表示不过期
+	ActivatedAt            *time.Time              `json:"activated_at"`              // 后台任务执行上线的时间，修改 GoLiveAt 时清空
+	ExpiredAt              *time.Time              `json:"expired_at"`                // 后台任务执行到期的时间，修改 ExpiresAt 时清空
 	AdvertisementBuildings []AdvertisementBuilding `gorm:"foreignKey:AdvertisementID;constraint:OnDelete:CASCADE;" json:"advertisements_buildings"`
 }
@@ -1,11 +1,40 @@
 package models
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
+// 管理员角色
+const (
+	RoleAdmin      = "admin"
+	RoleSuperAdmin = "super_admin" // 可以重置其他管理员的密码并查看审计日志
+)
+
 type Administrator struct {
 	gorm.Model
 	Username string `gorm:"unique;not null" json:"username"`
 	Password string `gorm:"type:varchar(100);not null" json:"-"`
+	Role     string `gorm:"type:varchar(20);not null;default:admin" json:"role"`
+	// 连续登录失败次数达到阈值后锁定账号到 LockedUntil
+	FailedLoginAttempts int        `gorm:"not null;default:0" json:"failed_login_attempts"`
+	LockedUntil         *time.Time `json:"locked_until"`
+	LastLoginAt         *time.Time `json:"last_login_at"`
+	// 早于该时间签发的令牌全部失效
+	PasswordChangedAt *time.Time `json:"password_changed_at"`
+	// 两步验证：TOTPSecret 非空但未启用表示正在绑定，TOTPLastStep 用于拒绝重放的验证码
+	TOTPSecret   string `gorm:"column:totp_secret;not null;default:''" json:"-"`
+	TOTPEnabled  bool   `gorm:"column:totp_enabled;not null;default:false" json:"totp_enabled"`
+	TOTPLastStep int64  `gorm:"column:totp_last_step;not null;default:0" json:"-"`
+}
+
+// Locked 判断账号在 now 时是否处于锁定状态
+func (a *Administrator) Locked(now time.Time) bool {
+	return a.LockedUntil != nil && now.Before(*a.LockedUntil)
+}
+
+// TokenRevoked 判断在 issuedAt（Unix 秒）签发的令牌是否因修改密码而失效
+func (a *Administrator) TokenRevoked(issuedAt int64) bool {
+	return a.PasswordChangedAt != nil && issuedAt < a.PasswordChangedAt.Unix()
 }
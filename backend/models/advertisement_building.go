@@ -5,6 +5,8 @@ type AdvertisementBuilding struct {
 	AdvertisementID uint          `json:"advertisement_id" gorm:"not null"`
 	BuildingID      uint          `json:"building_id" gorm:"not null"`
 	PlayDuration    int64         `json:"play_duration"` // 以秒为单位
+	Zone            string        `json:"zone"`          // 布局中的区域名称，为空表示默认媒体区域
+	DaypartID       *uint         `json:"daypart_id"`    // 分时规则，为空表示全天播放
 	Advertisement   Advertisement `gorm:"foreignKey:AdvertisementID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	Building        Building      `gorm:"foreignKey:BuildingID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// API 密钥可授予的权限范围，read 对应 GET 请求，write 对应其他请求
+const (
+	ScopeAdsRead         = "ads:read"
+	ScopeAdsWrite        = "ads:write"
+	ScopeBuildingsRead   = "buildings:read"
+	ScopeBuildingsWrite  = "buildings:write"
+	ScopePlacementsRead  = "placements:read"
+	ScopePlacementsWrite = "placements:write"
+	ScopeLayoutsRead     = "layouts:read"
+	ScopeLayoutsWrite    = "layouts:write"
+	ScopeNoticesRead     = "notices:read"
+	ScopeNoticesWrite    = "notices:write"
+	ScopeSchedulesRead   = "schedules:read"
+	ScopeSchedulesWrite  = "schedules:write"
+	ScopeBillingRead     = "billing:read"
+	ScopeBillingWrite    = "billing:write"
+	ScopeExportsRead     = "exports:read"
+	ScopeUploadsWrite    = "uploads:write"
+)
+
+// APIKeyScopes 全部可授予的权限范围，管理员相关接口不对 API 密钥开放
+var APIKeyScopes = []string{
+	ScopeAdsRead, ScopeAdsWrite,
+	ScopeBuildingsRead, ScopeBuildingsWrite,
+	ScopePlacementsRead, ScopePlacementsWrite,
+	ScopeLayoutsRead, ScopeLayoutsWrite,
+	ScopeNoticesRead, ScopeNoticesWrite,
+	ScopeSchedulesRead, ScopeSchedulesWrite,
+	ScopeBillingRead, ScopeBillingWrite,
+	ScopeExportsRead,
+	ScopeUploadsWrite,
+}
+
+// APIKey 供系统集成使用的 API 密钥，只保存哈希
+type APIKey struct {
+	ID          uint       `gorm:"primarykey" json:"id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	Name        string     `gorm:"not null" json:"name"`
+	Prefix      string     `gorm:"type:varchar(20);not null;uniqueIndex" json:"prefix"` // 密钥的前 12 个字符，用于识别
+	KeyHash     string     `gorm:"type:char(64);not null" json:"-"`
+	Scopes      []string   `gorm:"serializer:json;type:text;not null" json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at"` // 为空表示永不过期
+	RevokedAt   *time.Time `json:"revoked_at"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	LastUsedIP  string     `gorm:"column:last_used_ip" json:"last_used_ip"`
+	CreatedByID uint       `gorm:"not null" json:"created_by_id"`
+}
+
+// TableName 设置表名
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// Active 判断密钥在 now 时是否可用
+func (k *APIKey) Active(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// HasScope 判断密钥是否被授予 scope
+func (k *APIKey) HasScope(scope string) bool {
+	return slices.Contains(k.Scopes, scope)
+}
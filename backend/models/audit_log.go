@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// 审计操作类型
+const (
+	AuditPasswordReset = "admin.password_reset"
+	AuditTOTPReset     = "admin.totp_reset"
+	AuditAdminDeleted  = "admin.deleted"
+)
+
+// AdminAuditLog 管理员敏感操作的审计记录，只增不改
+type AdminAuditLog struct {
+	ID             uint      `gorm:"primarykey" json:"id"`
+	CreatedAt      time.Time `json:"created_at"`
+	ActorID        uint      `gorm:"not null" json:"actor_id"`
+	ActorUsername  string    `gorm:"not null" json:"actor_username"`
+	Action         string    `gorm:"type:varchar(50);not null" json:"action"`
+	TargetID       uint      `json:"target_id"`
+	TargetUsername string    `json:"target_username"`
+	ClientIP       string    `json:"client_ip"`
+	RequestID      string    `json:"request_id"`
+}
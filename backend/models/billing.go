@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+)
+
+// 发票状态
+const (
+	InvoiceIssued = "issued" // 已开具
+	InvoiceVoid   = "void"   // 已作废，不再计入重复开票检查
+)
+
+// RateCardRate 费率卡中一个大厦等级在一个当地时段的价格
+type RateCardRate struct {
+	Tier           string `json:"tier"`             // 大厦等级，为空表示默认费率，用于没有单独费率的等级
+	Start          string `json:"start"`            // HH:00
+	End            string `json:"end"`              // HH:00，24:00 表示当天结束
+	PricePerSecond int64  `json:"price_per_second"` // 播放时长每秒每小时的价格，以货币的最小单位（例如分）计
+}
+
+// RateCard 费率卡，按大厦等级与当地时段规定价格；每个等级的时段互不重叠且覆盖全天
+type RateCard struct {
+	ID          uint           `gorm:"primarykey" json:"id"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	Name        string         `gorm:"not null;uniqueIndex" json:"name"`
+	Description string         `json:"description"`
+	Currency    string         `gorm:"type:varchar(3);not null" json:"currency"` // ISO 4217 货币代码，例如 CNY
+	Rates       []RateCardRate `gorm:"serializer:json;type:text;not null" json:"rates"`
+}
+
+// TableName 设置表名
+func (RateCard) TableName() string {
+	return "rate_cards"
+}
+
+// Invoice 广告在一个计费期的发票，开票时保存广告、费率卡与各大厦明细的快照
+type Invoice struct {
+	ID                 uint          `gorm:"primarykey" json:"id"`
+	CreatedAt          time.Time     `json:"created_at"`
+	UpdatedAt          time.Time     `json:"updated_at"`
+	AdvertisementID    uint          `gorm:"not null;index" json:"advertisement_id"`
+	AdvertisementTitle string        `gorm:"not null" json:"advertisement_title"`
+	RateCardID         *uint         `json:"rate_card_id"` // 费率卡删除后为空
+	RateCardName       string        `gorm:"not null" json:"rate_card_name"`
+	Currency           string        `gorm:"type:varchar(3);not null" json:"currency"`
+	PeriodStart        string        `gorm:"type:varchar(10);not null" json:"period_start"` // YYYY-MM-DD，按各大厦的当地日期
+	PeriodEnd          string        `gorm:"type:varchar(10);not null" json:"period_end"`   // YYYY-MM-DD，包含当天
+	Status             string        `gorm:"not null" json:"status"`
+	Seconds            int64         `gorm:"not null" json:"seconds"` // 各明细计费秒数之和
+	Total              int64         `gorm:"not null" json:"total"`   // 以货币的最小单位计
+	VoidedAt           *time.Time    `json:"voided_at"`
+	Lines              []InvoiceLine `gorm:"foreignKey:InvoiceID;constraint:OnDelete:CASCADE;" json:"lines,omitempty"`
+}
+
+// TableName 设置表名
+func (Invoice) TableName() string {
+	return "invoices"
+}
+
+// InvoiceLine 发票中一栋大厦的明细
+type InvoiceLine struct {
+	ID           uint   `gorm:"primarykey" json:"id"`
+	InvoiceID    uint   `gorm:"not null;index" json:"invoice_id"`
+	BuildingID   uint   `gorm:"not null" json:"building_id"`
+	BuildingName string `gorm:"not null" json:"building_name"`
+	Tier         string `gorm:"not null" json:"tier"`
+	Timezone     string `gorm:"not null" json:"timezone"`
+	PlayDuration int64  `gorm:"not null" json:"play_duration"` // 预订的播放时长（秒）
+	Hours        int    `gorm:"not null" json:"hours"`         // 计费的播放小时数
+	Seconds      int64  `gorm:"not null" json:"seconds"`       // 各播放小时的播放时长之和
+	Amount       int64  `gorm:"not null" json:"amount"`
+}
+
+// TableName 设置表名
+func (InvoiceLine) TableName() string {
+	return "invoice_lines"
+}
@@ -9,5 +9,9 @@ type Building struct {
 	Name                   string                  `json:"name" gorm:"unique;not null"`
 	Address                string                  `json:"address"`
 	BuildingID             string                  `json:"blg_id"`
+	LayoutID               *uint                   `json:"layout_id"` // 屏幕布局，为空表示全屏播放
+	Timezone               string                  `json:"timezone"`  // IANA 时区，例如 Asia/Shanghai，为空时使用默认时区
+	Locale                 string                  `json:"locale"`    // BCP 47 语言标签，例如 zh-CN，为空时使用默认语言
+	Tier                   string                  `json:"tier"`      // 计费等级，对应费率卡中的等级，为空时使用默认费率
 	AdvertisementBuildings []AdvertisementBuilding `gorm:"foreignKey:BuildingID;constraint:OnDelete:CASCADE;" json:"advertisements_buildings"`
 }
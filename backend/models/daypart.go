@@ -0,0 +1,80 @@
+package models
+
+import (
+	"time"
+)
+
+// DateLayout 日历与分时规则中日期的格式
+const DateLayout = "2006-01-02"
+
+// Calendar 命名日历，例如法定节假日、商场促销日，由分时规则引用
+type Calendar struct {
+	ID          uint      `gorm:"primarykey" json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Name        string    `gorm:"not null;uniqueIndex" json:"name"`
+	Description string    `json:"description"`
+}
+
+// TableName 设置表名
+func (Calendar) TableName() string {
+	return "calendars"
+}
+
+// CalendarEvent 日历中的一个全天事件，可以跨多天并按 RRULE 重复
+type CalendarEvent struct {
+	ID         uint      `gorm:"primarykey" json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	CalendarID uint      `gorm:"not null;index" json:"calendar_id"`
+	UID        string    `gorm:"column:uid;not null" json:"uid"` // iCalendar 的 UID，手工添加时为空
+	Summary    string    `gorm:"not null" json:"summary"`
+	StartDate  string    `gorm:"type:varchar(10);not null" json:"start_date"` // YYYY-MM-DD
+	EndDate    string    `gorm:"type:varchar(10);not null" json:"end_date"`   // YYYY-MM-DD，不包含当天
+	RRule      string    `gorm:"column:rrule;not null" json:"rrule"`          // 为空表示不重复
+}
+
+// TableName 设置表名
+func (CalendarEvent) TableName() string {
+	return "calendar_events"
+}
+
+// DaypartWindow 分时规则中的一个播放时段：在 RRULE 命中的日期，于当地时间 [Start, End) 内播放
+type DaypartWindow struct {
+	RRule     string `json:"rrule"`                // 例如 FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR
+	StartDate string `json:"start_date,omitempty"` // RRULE 的起始日期（DTSTART），INTERVAL、COUNT 需要
+	Start     string `json:"start"`                // HH:MM
+	End       string `json:"end"`                  // HH:MM，24:00 表示当天结束；跨零点的时段需拆成两个
+}
+
+// Daypart 分时规则，投放引用后只在规则允许的时间播放
+// 任一时段命中即可播放；日期在排除日历中时不播放，设置了包含日历时日期必须在其中之一
+type Daypart struct {
+	ID                 uint            `gorm:"primarykey" json:"id"`
+	CreatedAt          time.Time       `json:"created_at"`
+	UpdatedAt          time.Time       `json:"updated_at"`
+	Name               string          `gorm:"not null;uniqueIndex" json:"name"`
+	Description        string          `json:"description"`
+	Windows            []DaypartWindow `gorm:"serializer:json;type:text;not null" json:"windows"`
+	IncludeCalendarIDs []uint          `gorm:"serializer:json;type:text;not null" json:"include_calendar_ids"`
+	ExcludeCalendarIDs []uint          `gorm:"serializer:json;type:text;not null" json:"exclude_calendar_ids"`
+}
+
+// TableName 设置表名
+func (Daypart) TableName() string {
+	return "dayparts"
+}
+
+// UsesCalendar 判断规则是否引用了日历
+func (d *Daypart) UsesCalendar(calendarID uint) bool {
+	for _, id := range d.IncludeCalendarIDs {
+		if id == calendarID {
+			return true
+		}
+	}
+	for _, id := range d.ExcludeCalendarIDs {
+		if id == calendarID {
+			return true
+		}
+	}
+	return false
+}
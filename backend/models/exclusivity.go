@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+)
+
+// 排他规则类型
+const (
+	ExclusivityLimit   = "limit"   // 同一类别最多投放 MaxAds 条广告
+	ExclusivitySponsor = "sponsor" // 独家赞助：同一类别只允许投放赞助广告
+)
+
+// ExclusivityKinds 全部排他规则类型
+var ExclusivityKinds = []string{ExclusivityLimit, ExclusivitySponsor}
+
+// ExclusivityRule 大厦的广告类别排他规则，每个大厦的每个类别最多一条
+type ExclusivityRule struct {
+	ID          uint      `gorm:"primarykey" json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	BuildingID  uint      `gorm:"not null;uniqueIndex:idx_exclusivity_rules_building_category" json:"building_id"`
+	Category    string    `gorm:"not null;uniqueIndex:idx_exclusivity_rules_building_category" json:"category"`
+	Kind        string    `gorm:"not null" json:"kind"`
+	MaxAds      int       `gorm:"not null" json:"max_ads"` // limit 规则允许的广告数，sponsor 规则为 1
+	SponsorAdID *uint     `json:"sponsor_ad_id"`           // sponsor 规则的赞助广告
+}
+
+// TableName 设置表名
+func (ExclusivityRule) TableName() string {
+	return "exclusivity_rules"
+}
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"time"
+)
+
+// 屏幕区域类型
+const (
+	ZoneMedia  = "media"  // 播放广告的图片或视频
+	ZoneTicker = "ticker" // 滚动播放广告的标题与描述
+	ZoneWidget = "widget" // 天气、时钟等由播放端渲染的组件，不投放广告
+)
+
+// ZoneKinds 全部区域类型
+var ZoneKinds = []string{ZoneMedia, ZoneTicker, ZoneWidget}
+
+// LayoutWidgets 组件区域可用的组件
+var LayoutWidgets = []string{"clock", "weather", "date"}
+
+// DefaultZone 大厦未设置布局时使用的全屏区域名称
+const DefaultZone = "main"
+
+// LayoutZone 布局中的一个命名区域，坐标与尺寸以布局画布的像素为单位
+type LayoutZone struct {
+	Name   string `json:"name"`
+	Kind   string `json:"kind"`
+	X      int    `json:"x"`
+	Y      int    `json:"y"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Widget string `json:"widget,omitempty"` // 仅组件区域使用
+}
+
+// Layout 屏幕布局模板，多个大厦可以共用同一模板
+type Layout struct {
+	ID          uint         `gorm:"primarykey" json:"id"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+	Name        string       `gorm:"not null;uniqueIndex" json:"name"`
+	Description string       `json:"description"`
+	Width       int          `gorm:"not null" json:"width"`
+	Height      int          `gorm:"not null" json:"height"`
+	Zones       []LayoutZone `gorm:"serializer:json;type:text;not null" json:"zones"`
+}
+
+// TableName 设置表名
+func (Layout) TableName() string {
+	return "layouts"
+}
+
+// Zone 按名称查找区域
+func (l *Layout) Zone(name string) (LayoutZone, bool) {
+	for _, zone := range l.Zones {
+		if zone.Name == name {
+			return zone, true
+		}
+	}
+	return LayoutZone{}, false
+}
+
+// DefaultZone 返回未指定区域的投放所使用的区域：第一个媒体区域
+func (l *Layout) DefaultZone() (LayoutZone, bool) {
+	for _, zone := range l.Zones {
+		if zone.Kind == ZoneMedia {
+			return zone, true
+		}
+	}
+	return LayoutZone{}, false
+}
+
+// PlayZone 返回投放实际播放的区域：未指定区域、区域已不存在或是组件区域时使用默认区域
+func (l *Layout) PlayZone(name string) (LayoutZone, bool) {
+	if zone, ok := l.Zone(name); ok && zone.Kind != ZoneWidget {
+		return zone, true
+	}
+	return l.DefaultZone()
+}
+
+// FullScreenLayout 大厦未设置布局时使用的隐式布局：一个全屏媒体区域
+func FullScreenLayout() Layout {
+	return Layout{
+		Name:   "fullscreen",
+		Width:  1920,
+		Height: 1080,
+		Zones:  []LayoutZone{{Name: DefaultZone, Kind: ZoneMedia, Width: 1920, Height: 1080}},
+	}
+}
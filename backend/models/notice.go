@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+)
+
+// Notice 物业发布到大厦屏幕的通知，例如停水、电梯维保
+type Notice struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	Title     string     `gorm:"not null" json:"title"`
+	Content   string     `gorm:"not null" json:"content"`    // 富文本（HTML），保存前已按白名单清理
+	Priority  int        `gorm:"not null" json:"priority"`   // 0-100，越大越靠前
+	StartsAt  time.Time  `gorm:"not null" json:"starts_at"`  // 生效时间
+	EndsAt    *time.Time `json:"ends_at"`                    // 失效时间，为空表示长期有效
+	CreatedBy string     `gorm:"not null" json:"created_by"` // 发布人的用户名，API 密钥为 apikey:<前缀>
+}
+
+// TableName 设置表名
+func (Notice) TableName() string {
+	return "notices"
+}
+
+// ActiveAt 判断通知在 t 时刻是否有效
+func (n *Notice) ActiveAt(t time.Time) bool {
+	return !n.StartsAt.After(t) && (n.EndsAt == nil || n.EndsAt.After(t))
+}
+
+// BuildingNotice 通知与大厦的关联
+type BuildingNotice struct {
+	NoticeID   uint      `gorm:"not null" json:"notice_id"`
+	BuildingID uint      `gorm:"not null" json:"building_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// TableName 设置表名
+func (BuildingNotice) TableName() string {
+	return "building_notices"
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// ManifestAsset 播放清单中的素材，按 URL 去重
+type ManifestAsset struct {
+	URL    string `json:"url"`
+	Size   int64  `json:"size"`   // 字节数，0 表示未登记
+	SHA256 string `json:"sha256"` // 为空表示未登记，播放端无法校验
+}
+
+// PlaylistManifest 大厦播放清单的一个版本，Body 为签名时使用的原始 JSON，同一版本的内容与签名保持不变
+type PlaylistManifest struct {
+	ID          uint            `gorm:"primarykey" json:"id"`
+	CreatedAt   time.Time       `json:"created_at"`
+	BuildingID  uint            `gorm:"not null" json:"building_id"`
+	Version     int             `gorm:"not null" json:"version"`
+	ContentHash string          `gorm:"type:char(64);not null" json:"content_hash"`
+	Assets      []ManifestAsset `gorm:"serializer:json;type:text;not null" json:"assets"`
+	Body        string          `gorm:"type:text;not null" json:"-"`
+}
+
+// TableName 设置表名
+func (PlaylistManifest) TableName() string {
+	return "playlist_manifests"
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// AdminRecoveryCode 两步验证的恢复码，只保存哈希，每个恢复码只能使用一次
+type AdminRecoveryCode struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	AdminID   uint       `gorm:"not null;index" json:"admin_id"`
+	CodeHash  string     `gorm:"type:char(64);not null" json:"-"`
+	UsedAt    *time.Time `json:"used_at"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// TrafficCount 大厦某一小时的人流量，用于估算广告曝光数
+type TrafficCount struct {
+	ID         uint      `gorm:"primarykey" json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	BuildingID uint      `gorm:"not null;uniqueIndex:idx_traffic_counts_building_hour" json:"building_id"`
+	Hour       time.Time `gorm:"not null;uniqueIndex:idx_traffic_counts_building_hour" json:"hour"` // 大厦当地整点的开始时刻，以 UTC 保存
+	Visitors   int64     `gorm:"not null" json:"visitors"`
+}
+
+// TableName 设置表名
+func (TrafficCount) TableName() string {
+	return "traffic_counts"
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// Webhook 事件类型
+const (
+	EventAdCreated        = "ad.created"
+	EventAdUpdated        = "ad.updated"
+	EventAdDeleted        = "ad.deleted"
+	EventAdActivated      = "ad.activated" // 到达上线时间，后台任务将广告改为 active
+	EventAdExpired        = "ad.expired"   // 到达到期时间，后台任务将广告改为 inactive
+	EventPlacementCreated = "placement.created"
+	EventPlacementUpdated = "placement.updated"
+	EventPlacementDeleted = "placement.deleted"
+)
+
+// WebhookEvents 全部可订阅的事件
+var WebhookEvents = []string{
+	EventAdCreated, EventAdUpdated, EventAdDeleted, EventAdActivated, EventAdExpired,
+	EventPlacementCreated, EventPlacementUpdated, EventPlacementDeleted,
+}
+
+// 投递状态
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryFailed    = "failed" // 重试次数用尽
+)
+
+// WebhookSubscription 事件推送订阅，Secret 用于对请求体做 HMAC-SHA256 签名
+type WebhookSubscription struct {
+	ID          uint      `gorm:"primarykey" json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Name        string    `gorm:"not null" json:"name"`
+	URL         string    `gorm:"not null" json:"url"`
+	Secret      string    `gorm:"not null" json:"-"`
+	Events      []string  `gorm:"serializer:json;type:text;not null" json:"events"`
+	Active      bool      `gorm:"not null;default:true" json:"active"`
+	CreatedByID uint      `gorm:"not null" json:"created_by_id"`
+}
+
+// TableName 设置表名
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// Subscribed 判断订阅是否接收 event
+func (s *WebhookSubscription) Subscribed(event string) bool {
+	return s.Active && slices.Contains(s.Events, event)
+}
+
+// WebhookDelivery 一次事件投递，既是待发送的发件箱记录，也是投递日志
+type WebhookDelivery struct {
+	ID             uint       `gorm:"primarykey" json:"id"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	SubscriptionID uint       `gorm:"not null;index" json:"subscription_id"`
+	EventID        string     `gorm:"type:varchar(32);not null" json:"event_id"` // 同一事件投递到不同订阅时相同，供接收方去重
+	Event          string     `gorm:"type:varchar(50);not null" json:"event"`
+	Payload        string     `gorm:"type:text;not null" json:"payload"`
+	Status         string     `gorm:"type:varchar(20);not null" json:"status"`
+	Attempts       int        `gorm:"not null" json:"attempts"`
+	NextAttemptAt  time.Time  `gorm:"not null" json:"next_attempt_at"`
+	LastAttemptAt  *time.Time `json:"last_attempt_at"`
+	ResponseStatus int        `json:"response_status"` // 最近一次尝试的 HTTP 状态码，请求未发出时为 0
+	LastError      string     `json:"last_error"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+}
+
+// TableName 设置表名
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
@@ -0,0 +1,171 @@
+package openapi
+
+// openapi 内嵌接口文档 openapi.yaml，提供 JSON 格式的文档以及与已注册路由的比对
+// 修改路由时需同步更新 openapi.yaml，并执行 go generate ./client 重新生成客户端
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed openapi.yaml
+var specYAML []byte
+
+var (
+	loadOnce sync.Once
+	specJSON []byte
+	spec     document
+	loadErr  error
+)
+
+// document 只解析比对路由所需的部分
+type document struct {
+	Paths map[string]map[string]yaml.Node `yaml:"paths"`
+}
+
+// 路径项中表示操作的键
+var methods = map[string]bool{
+	"get": true, "put": true, "post": true, "delete": true,
+	"options": true, "head": true, "patch": true, "trace": true,
+}
+
+func load() {
+	loadOnce.Do(func() {
+		var raw interface{}
+		if loadErr = yaml.Unmarshal(specYAML, &raw); loadErr != nil {
+			loadErr = fmt.Errorf("解析 openapi.yaml 失败: %w", loadErr)
+			return
+		}
+		if specJSON, loadErr = json.Marshal(raw); loadErr != nil {
+			loadErr = fmt.Errorf("转换 openapi.yaml 为 JSON 失败: %w", loadErr)
+			return
+		}
+		if loadErr = yaml.Unmarshal(specYAML, &spec); loadErr != nil {
+			loadErr = fmt.Errorf("解析 openapi.yaml 路径失败: %w", loadErr)
+		}
+	})
+}
+
+// JSON 返回 JSON 格式的接口文档
+func JSON() ([]byte, error) {
+	load()
+	return specJSON, loadErr
+}
+
+// Handler 返回 /api/openapi.json 处理函数
+func Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		content, err := JSON()
+		if err != nil {
+			apierror.Respond(c, apierror.Internal(err))
+			return
+		}
+		c.Data(http.StatusOK, "application/json; charset=utf-8", content)
+	}
+}
+
+// Route 表示一个接口，Path 使用 Gin 的路径格式（例如 /api/ads/:id）
+type Route struct {
+	Method string
+	Path   string
+}
+
+func (r Route) String() string {
+	return r.Method + " " + r.Path
+}
+
+// Routes 返回文档中定义的所有接口
+func Routes() ([]Route, error) {
+	load()
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	var routes []Route
+	for path, item := range spec.Paths {
+		for method := range item {
+			if methods[method] {
+				routes = append(routes, Route{Method: strings.ToUpper(method), Path: ginPath(path)})
+			}
+		}
+	}
+	sortRoutes(routes)
+	return routes, nil
+}
+
+// Diff 比对已注册的 Gin 路由与文档，返回缺少文档的路由与文档中多余的接口
+func Diff(registered gin.RoutesInfo) (undocumented, unregistered []Route, err error) {
+	documented, err := Routes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inSpec := make(map[Route]bool, len(documented))
+	for _, r := range documented {
+		inSpec[r] = true
+	}
+	inRouter := make(map[Route]bool, len(registered))
+	for _, info := range registered {
+		r := Route{Method: info.Method, Path: info.Path}
+		inRouter[r] = true
+		if !inSpec[r] {
+			undocumented = append(undocumented, r)
+		}
+	}
+	for _, r := range documented {
+		if !inRouter[r] {
+			unregistered = append(unregistered, r)
+		}
+	}
+	sortRoutes(undocumented)
+	sortRoutes(unregistered)
+	return undocumented, unregistered, nil
+}
+
+// Check 比对路由与文档，不一致时返回列出所有差异的错误
+func Check(registered gin.RoutesInfo) error {
+	undocumented, unregistered, err := Diff(registered)
+	if err != nil {
+		return err
+	}
+	if len(undocumented) == 0 && len(unregistered) == 0 {
+		return nil
+	}
+	var b strings.Builder
+	b.WriteString("路由与 openapi.yaml 不一致")
+	for _, r := range undocumented {
+		fmt.Fprintf(&b, "\n  未写入文档: %s", r)
+	}
+	for _, r := range unregistered {
+		fmt.Fprintf(&b, "\n  未注册路由: %s", r)
+	}
+	return fmt.Errorf("%s", b.String())
+}
+
+// ginPath 将 /api/ads/{id} 转换为 /api/ads/:id
+func ginPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+			segments[i] = ":" + strings.TrimSuffix(strings.TrimPrefix(s, "{"), "}")
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func sortRoutes(routes []Route) {
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+}
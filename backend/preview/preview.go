@@ -0,0 +1,218 @@
+package preview
+
+// preview 模拟大厦在一段时间内的播放时间线，供运营在修改投放前检查
+//
+// 每个播放区域独立轮播：一轮开始时按广告 ID 顺序选出此刻可以播放的投放，依次播放各自的 PlayDuration，
+// 累计时长超过一轮的容量时，剩余的广告本轮不播放；一轮结束后立即开始下一轮。
+// 某一轮没有可播放的广告时，等到下一分钟（分时规则的最小粒度）再判断。
+// 不能播放的广告按原因合并为连续的时间段返回
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/dayparting"
+	"github.com/10240418/advertisement-management-system/backend/lifecycle"
+	"github.com/10240418/advertisement-management-system/backend/models"
+)
+
+// 分时规则之外的排除原因，分时规则的原因见 dayparting 包
+const (
+	ReasonNotLive    = lifecycle.ReasonNotLive  // 未到上线时间
+	ReasonExpired    = lifecycle.ReasonExpired  // 已到期
+	ReasonInactive   = lifecycle.ReasonInactive // 广告已停用
+	ReasonCapacity   = "capacity"               // 本轮剩余容量不足
+	ReasonNoDuration = "no_duration"            // 播放时长为 0
+)
+
+// Entry 参与模拟的一条投放
+type Entry struct {
+	Placement models.AdvertisementBuilding
+	Ad        models.Advertisement
+}
+
+// Zone 一个播放区域及其中的投放
+type Zone struct {
+	Name    string
+	Entries []Entry
+}
+
+// Options 模拟参数
+type Options struct {
+	LoopCapacity time.Duration // 一轮的最长时长，0 表示不限制
+	MaxSlots     int           // 时间线最多的条目数，超过时截断
+}
+
+// Slot 时间线中的一次播放
+type Slot struct {
+	Zone            string    `json:"zone"`
+	Loop            int       `json:"loop"` // 区域内的轮次，从 1 开始
+	AdvertisementID uint      `json:"advertisement_id"`
+	Title           string    `json:"title"`
+	Start           time.Time `json:"start"`
+	Duration        int64     `json:"duration"` // 秒
+}
+
+// Exclusion 广告在 [From, To) 内因同一原因不能播放
+type Exclusion struct {
+	Zone            string    `json:"zone"`
+	AdvertisementID uint      `json:"advertisement_id"`
+	Title           string    `json:"title"`
+	Reason          string    `json:"reason"`
+	CalendarID      *uint     `json:"calendar_id,omitempty"` // 因排除日历不能播放时命中的日历
+	From            time.Time `json:"from"`
+	To              time.Time `json:"to"`
+}
+
+// Total 广告在模拟时间段内的播放次数与总时长
+type Total struct {
+	Zone            string `json:"zone"`
+	AdvertisementID uint   `json:"advertisement_id"`
+	Title           string `json:"title"`
+	PlayDuration    int64  `json:"play_duration"` // 秒
+	Plays           int    `json:"plays"`
+	Seconds         int64  `json:"seconds"`
+}
+
+// Result 模拟结果
+type Result struct {
+	Slots      []Slot      `json:"slots"`
+	Exclusions []Exclusion `json:"exclusions"`
+	Totals     []Total     `json:"totals"`
+	Truncated  bool        `json:"truncated"` // 条目数达到上限，时间线在此之后没有继续模拟
+}
+
+// Simulate 模拟 [from, to) 内各区域的播放，from、to 必须已经转换到大厦所在时区
+func Simulate(plan *dayparting.Plan, zones []Zone, from, to time.Time, opts Options) Result {
+	result := Result{Slots: []Slot{}, Exclusions: []Exclusion{}, Totals: []Total{}}
+	for _, zone := range zones {
+		if !simulateZone(&result, plan, zone, from, to, opts) {
+			result.Truncated = true
+			break
+		}
+	}
+	return result
+}
+
+// simulateZone 模拟一个区域的轮播，条目数达到上限时返回 false
+func simulateZone(result *Result, plan *dayparting.Plan, zone Zone, from, to time.Time, opts Options) bool {
+	if len(zone.Entries) == 0 {
+		return true
+	}
+	entries := slices.Clone(zone.Entries)
+	slices.SortFunc(entries, func(a, b Entry) int { return cmp.Compare(a.Ad.ID, b.Ad.ID) })
+
+	totals := make([]Total, len(entries))
+	for i, entry := range entries {
+		totals[i] = Total{Zone: zone.Name, AdvertisementID: entry.Ad.ID, Title: entry.Ad.Title, PlayDuration: entry.Placement.PlayDuration}
+	}
+	defer func() { result.Totals = append(result.Totals, totals...) }()
+
+	// open 记录每条投放当前未结束的排除时间段在 result.Exclusions 中的位置，-1 表示没有
+	open := make([]int, len(entries))
+	for i := range open {
+		open[i] = -1
+	}
+	exclude := func(i int, reason string, calendarID *uint, start, end time.Time) {
+		if j := open[i]; j >= 0 {
+			last := &result.Exclusions[j]
+			if last.Reason == reason && sameCalendar(last.CalendarID, calendarID) {
+				last.To = end
+				return
+			}
+		}
+		open[i] = len(result.Exclusions)
+		result.Exclusions = append(result.Exclusions, Exclusion{
+			Zone:            zone.Name,
+			AdvertisementID: entries[i].Ad.ID,
+			Title:           entries[i].Ad.Title,
+			Reason:          reason,
+			CalendarID:      calendarID,
+			From:            start,
+			To:              end,
+		})
+	}
+
+	capacity := int64(opts.LoopCapacity / time.Second)
+	loop := 0
+	for t := from; t.Before(to); {
+		var planned []int
+		var used int64
+		reasons := make([]dayparting.Decision, len(entries))
+		for i, entry := range entries {
+			duration := entry.Placement.PlayDuration
+			decision := Check(plan, entry, t)
+			switch {
+			case !decision.Eligible:
+				reasons[i] = decision
+			case capacity > 0 && used+duration > capacity:
+				reasons[i] = dayparting.Decision{Reason: ReasonCapacity}
+			default:
+				reasons[i] = decision
+				planned = append(planned, i)
+				used += duration
+			}
+		}
+		if opts.MaxSlots > 0 && len(result.Slots)+len(planned) > opts.MaxSlots {
+			return false
+		}
+
+		next := t.Add(time.Duration(used) * time.Second)
+		if len(planned) == 0 {
+			next = t.Truncate(time.Minute).Add(time.Minute)
+		} else {
+			loop++
+			start := t
+			for _, i := range planned {
+				duration := entries[i].Placement.PlayDuration
+				result.Slots = append(result.Slots, Slot{
+					Zone:            zone.Name,
+					Loop:            loop,
+					AdvertisementID: entries[i].Ad.ID,
+					Title:           entries[i].Ad.Title,
+					Start:           start,
+					Duration:        duration,
+				})
+				totals[i].Plays++
+				totals[i].Seconds += duration
+				start = start.Add(time.Duration(duration) * time.Second)
+			}
+		}
+
+		// 排除时间段不超出模拟范围
+		end := next
+		if end.After(to) {
+			end = to
+		}
+		for i, decision := range reasons {
+			if decision.Eligible {
+				open[i] = -1
+				continue
+			}
+			exclude(i, decision.Reason, decision.CalendarID, t, end)
+		}
+		t = next
+	}
+	return true
+}
+
+// Check 判断投放在 t 时能否放入一轮：广告处于播放期（上线与到期时间之间且未被停用）、
+// 播放时长大于 0 且满足分时规则。播放预览、曝光估算、计费与可播放判断都使用这一规则
+func Check(plan *dayparting.Plan, entry Entry, t time.Time) dayparting.Decision {
+	if reason := lifecycle.Reason(entry.Ad, t); reason != "" {
+		return dayparting.Decision{Reason: reason}
+	}
+	if entry.Placement.PlayDuration <= 0 {
+		return dayparting.Decision{Reason: ReasonNoDuration}
+	}
+	return plan.Check(entry.Placement, t)
+}
+
+// sameCalendar 判断两个可选的日历 ID 是否相同
+func sameCalendar(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
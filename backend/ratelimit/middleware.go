@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// KeyFunc 从请求中提取限流键，返回空字符串时不限流
+type KeyFunc func(c *gin.Context) string
+
+// ByIP 按客户端 IP 限流
+func ByIP(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+// ByUser 按认证中间件写入的用户名限流，需放在 AuthMiddleware 之后
+func ByUser(c *gin.Context) string {
+	if username := c.GetString("username"); username != "" {
+		return "user:" + username
+	}
+	return ""
+}
+
+// Middleware 创建限流中间件，scope 用于区分不同接口的令牌桶
+func Middleware(store Store, scope string, limit Limit, key KeyFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !limit.Enabled() {
+			c.Next()
+			return
+		}
+		k := key(c)
+		if k == "" {
+			c.Next()
+			return
+		}
+		if !Enforce(c, store, scope+":"+k, limit) {
+			return
+		}
+		c.Next()
+	}
+}
+
+// Enforce 为 key 消耗一个令牌并写入 X-RateLimit-* 响应头
+// 超出限制时返回 429 并中止请求，返回 false；存储出错时放行并记录日志
+func Enforce(c *gin.Context, store Store, key string, limit Limit) bool {
+	if !limit.Enabled() {
+		return true
+	}
+	ctx := c.Request.Context()
+	result, err := store.Allow(ctx, key, limit)
+	if err != nil {
+		logging.FromContext(ctx).WarnContext(ctx, "限流存储不可用，放行请求", "key", key, "error", err)
+		return true
+	}
+
+	c.Header("X-RateLimit-Limit", strconv.Itoa(limit.Rate))
+	c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	if result.Allowed {
+		return true
+	}
+
+	logging.FromContext(ctx).WarnContext(ctx, "请求被限流", "key", key)
+	apierror.Respond(c, apierror.New(http.StatusTooManyRequests, apierror.CodeRateLimited).
+		WithRetryAfter(RetryAfterSeconds(result.RetryAfter)))
+	return false
+}
+
+// RetryAfterSeconds 将等待时长向上取整为 Retry-After 使用的秒数，至少为 1
+func RetryAfterSeconds(d time.Duration) int {
+	return max(1, int(math.Ceil(d.Seconds())))
+}
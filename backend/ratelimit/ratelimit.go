@@ -0,0 +1,107 @@
+package ratelimit
+
+// ratelimit 提供基于令牌桶的限流，存储可替换（默认进程内存，多实例部署时可实现共享的 Store）
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit 令牌桶参数：每 Period 补充 Rate 个令牌，桶容量为 Burst
+type Limit struct {
+	Rate   int
+	Period time.Duration
+	Burst  int
+}
+
+// PerMinute 返回每分钟 n 次、突发 n 次的限制，n <= 0 表示不限流
+func PerMinute(n int) Limit {
+	return Limit{Rate: n, Period: time.Minute, Burst: n}
+}
+
+// Enabled 判断是否启用限流
+func (l Limit) Enabled() bool {
+	return l.Rate > 0 && l.Period > 0 && l.Burst > 0
+}
+
+// Result 一次请求的限流结果
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration // 被拒绝时到下一个令牌可用的时间
+}
+
+// Store 限流状态的存储，Allow 为 key 消耗一个令牌
+type Store interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	period time.Duration // 桶所属限制的周期，空闲超过该时长即视为已补满
+}
+
+// MemoryStore 进程内的令牌桶存储，空闲超过一个周期（已补满）的桶会被定期清理
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	now       func() time.Time
+	lastSweep time.Time
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// 清理空闲令牌桶的间隔
+const sweepInterval = time.Minute
+
+// NewMemoryStore 创建内存存储
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*bucket), now: time.Now}
+}
+
+// Allow 按令牌桶算法判断请求是否允许
+func (s *MemoryStore) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	if !limit.Enabled() {
+		return Result{Allowed: true}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	perToken := limit.Period / time.Duration(limit.Rate)
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now, period: limit.Period}
+		s.buckets[key] = b
+	} else {
+		elapsed := now.Sub(b.last)
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+float64(elapsed)/float64(perToken))
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) * float64(perToken))
+		return Result{Allowed: false, Remaining: 0, RetryAfter: wait}, nil
+	}
+	b.tokens--
+	return Result{Allowed: true, Remaining: int(b.tokens)}, nil
+}
+
+// sweep 删除已补满的令牌桶，调用方需持有锁
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if now.Sub(b.last) >= b.period {
+			delete(s.buckets, key)
+		}
+	}
+}
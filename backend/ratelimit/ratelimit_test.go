@@ -0,0 +1,188 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// clock 测试用的可调时钟
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time          { return c.t }
+func (c *clock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestStore() (*MemoryStore, *clock) {
+	clk := &clock{t: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	s := NewMemoryStore()
+	s.now = clk.now
+	return s, clk
+}
+
+// step 推进时钟后消耗一个令牌
+type step struct {
+	advance time.Duration
+	key     string
+	want    Result
+}
+
+func TestMemoryStoreAllow(t *testing.T) {
+	limit := Limit{Rate: 2, Period: time.Minute, Burst: 3} // 每 30 秒补充一个令牌
+
+	tests := []struct {
+		name  string
+		limit Limit
+		steps []step
+	}{
+		{
+			name:  "突发用尽后拒绝并给出等待时间",
+			limit: limit,
+			steps: []step{
+				{key: "a", want: Result{Allowed: true, Remaining: 2}},
+				{key: "a", want: Result{Allowed: true, Remaining: 1}},
+				{key: "a", want: Result{Allowed: true, Remaining: 0}},
+				{key: "a", want: Result{RetryAfter: 30 * time.Second}},
+				{advance: 10 * time.Second, key: "a", want: Result{RetryAfter: 20 * time.Second}},
+			},
+		},
+		{
+			name:  "按速率补充令牌",
+			limit: limit,
+			steps: []step{
+				{key: "a", want: Result{Allowed: true, Remaining: 2}},
+				{key: "a", want: Result{Allowed: true, Remaining: 1}},
+				{key: "a", want: Result{Allowed: true, Remaining: 0}},
+				{advance: 30 * time.Second, key: "a", want: Result{Allowed: true, Remaining: 0}},
+				{key: "a", want: Result{RetryAfter: 30 * time.Second}},
+			},
+		},
+		{
+			name:  "补充不超过突发容量",
+			limit: limit,
+			steps: []step{
+				{key: "a", want: Result{Allowed: true, Remaining: 2}},
+				{advance: time.Hour, key: "a", want: Result{Allowed: true, Remaining: 2}},
+			},
+		},
+		{
+			name:  "不同的键互不影响",
+			limit: Limit{Rate: 1, Period: time.Minute, Burst: 1},
+			steps: []step{
+				{key: "ip:192.0.2.1", want: Result{Allowed: true}},
+				{key: "ip:192.0.2.1", want: Result{RetryAfter: time.Minute}},
+				{key: "ip:192.0.2.2", want: Result{Allowed: true}},
+				{key: "user:root", want: Result{Allowed: true}},
+				{key: "user:root", want: Result{RetryAfter: time.Minute}},
+			},
+		},
+		{
+			name:  "未启用时总是放行",
+			limit: Limit{},
+			steps: []step{
+				{key: "a", want: Result{Allowed: true}},
+				{key: "a", want: Result{Allowed: true}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, clk := newTestStore()
+			for i, st := range tt.steps {
+				clk.advance(st.advance)
+				got, err := s.Allow(context.Background(), st.key, tt.limit)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != st.want {
+					t.Fatalf("第 %d 次 %s：%+v，期望 %+v", i+1, st.key, got, st.want)
+				}
+			}
+		})
+	}
+}
+
+// TestMemoryStoreSweep 空闲超过一个周期的令牌桶被清理，清理后重新计为满桶
+func TestMemoryStoreSweep(t *testing.T) {
+	s, clk := newTestStore()
+	limit := Limit{Rate: 1, Period: time.Minute, Burst: 1}
+	ctx := context.Background()
+
+	s.Allow(ctx, "a", limit)
+	clk.advance(2 * time.Minute)
+	s.Allow(ctx, "b", limit)
+	if _, ok := s.buckets["a"]; ok {
+		t.Fatal("空闲的令牌桶未被清理")
+	}
+	if got, _ := s.Allow(ctx, "a", limit); !got.Allowed {
+		t.Fatalf("清理后的键被拒绝：%+v", got)
+	}
+}
+
+func TestEnforce(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s, clk := newTestStore()
+	limit := Limit{Rate: 2, Period: time.Minute, Burst: 2}
+
+	r := gin.New()
+	r.GET("/", Middleware(s, "test", limit, ByIP), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	tests := []struct {
+		name       string
+		advance    time.Duration
+		ip         string
+		status     int
+		remaining  string
+		retryAfter string
+	}{
+		{name: "第一次", ip: "192.0.2.1", status: http.StatusOK, remaining: "1"},
+		{name: "第二次", ip: "192.0.2.1", status: http.StatusOK, remaining: "0"},
+		{name: "超出限制", ip: "192.0.2.1", status: http.StatusTooManyRequests, remaining: "0", retryAfter: "30"},
+		{name: "Retry-After 向上取整", advance: 500 * time.Millisecond, ip: "192.0.2.1", status: http.StatusTooManyRequests, remaining: "0", retryAfter: "30"},
+		{name: "其他 IP 不受影响", ip: "192.0.2.2", status: http.StatusOK, remaining: "1"},
+		{name: "补充后放行", advance: 30 * time.Second, ip: "192.0.2.1", status: http.StatusOK, remaining: "0"},
+	}
+
+	for _, tt := range tests {
+		clk.advance(tt.advance)
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tt.ip + ":1234"
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != tt.status {
+			t.Fatalf("%s：状态码 %d，期望 %d", tt.name, w.Code, tt.status)
+		}
+		if got := w.Header().Get("X-RateLimit-Limit"); got != "2" {
+			t.Fatalf("%s：X-RateLimit-Limit %q", tt.name, got)
+		}
+		if got := w.Header().Get("X-RateLimit-Remaining"); got != tt.remaining {
+			t.Fatalf("%s：X-RateLimit-Remaining %q，期望 %q", tt.name, got, tt.remaining)
+		}
+		if got := w.Header().Get("Retry-After"); got != tt.retryAfter {
+			t.Fatalf("%s：Retry-After %q，期望 %q", tt.name, got, tt.retryAfter)
+		}
+	}
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want int
+	}{
+		{0, 1},
+		{time.Millisecond, 1},
+		{time.Second, 1},
+		{1500 * time.Millisecond, 2},
+		{30 * time.Second, 30},
+	}
+	for _, tt := range tests {
+		if got := RetryAfterSeconds(tt.d); got != tt.want {
+			t.Errorf("RetryAfterSeconds(%v) = %d，期望 %d", tt.d, got, tt.want)
+		}
+	}
+}
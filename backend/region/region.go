@@ -0,0 +1,99 @@
+package region
+
+// region 解析大厦所在的时区与语言，并提供按大厦当地日期划分时间的工具
+//
+// 大厦未设置时区或语言时使用 DEFAULT_TIMEZONE、DEFAULT_LOCALE；
+// 排期、按天汇总等与日期相关的计算都应使用大厦当地的日期，而不是服务器或数据库的时区
+
+import (
+	"errors"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"golang.org/x/text/language"
+)
+
+// Settings 大厦的时区与语言
+type Settings struct {
+	Timezone string         `json:"timezone"`
+	Locale   string         `json:"locale"`
+	Location *time.Location `json:"-"`
+}
+
+// Resolver 按大厦的设置返回时区与语言，未设置时使用默认值
+type Resolver struct {
+	location *time.Location
+	locale   string
+}
+
+// NewResolver 创建 Resolver
+func NewResolver(location *time.Location, locale string) *Resolver {
+	return &Resolver{location: location, locale: locale}
+}
+
+// For 返回大厦的时区与语言，building 为 nil 时返回默认值
+func (r *Resolver) For(building *models.Building) Settings {
+	settings := Settings{Timezone: r.location.String(), Locale: r.locale, Location: r.location}
+	if building == nil {
+		return settings
+	}
+	if building.Timezone != "" {
+		if loc, err := LoadLocation(building.Timezone); err == nil {
+			settings.Timezone, settings.Location = building.Timezone, loc
+		}
+	}
+	if building.Locale != "" {
+		settings.Locale = building.Locale
+	}
+	return settings
+}
+
+// Location 返回大厦所在时区
+func (r *Resolver) Location(building *models.Building) *time.Location {
+	return r.For(building).Location
+}
+
+// LoadLocation 加载 IANA 时区，不接受依赖服务器设置的 Local
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, errors.New("时区必须是 IANA 时区名称")
+	}
+	return time.LoadLocation(name)
+}
+
+// ParseLocale 解析 BCP 47 语言标签并返回规范写法，例如 zh-cn 返回 zh-CN
+func ParseLocale(value string) (string, error) {
+	tag, err := language.Parse(value)
+	if err != nil {
+		return "", err
+	}
+	return tag.String(), nil
+}
+
+// LocalDate 返回 t 在 loc 中的日期
+func LocalDate(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(models.DateLayout)
+}
+
+// Day 当地的一天，End 为下一天的零点；夏令时切换的日子不是 24 小时
+type Day struct {
+	Date  string    `json:"date"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// DayOf 返回 t 所在的当地日期及其起止时刻
+func DayOf(t time.Time, loc *time.Location) Day {
+	local := t.In(loc)
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	return Day{Date: start.Format(models.DateLayout), Start: start, End: start.AddDate(0, 0, 1)}
+}
+
+// Days 返回覆盖 [from, to) 的全部当地日期，用于按天汇总
+func Days(from, to time.Time, loc *time.Location) []Day {
+	var days []Day
+	for day := DayOf(from, loc); day.Start.Before(to); day = DayOf(day.End, loc) {
+		days = append(days, day)
+	}
+	return days
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+)
+
+type gormAdminRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormAdminRepository) List(ctx context.Context, opts ListOptions) ([]models.Administrator, int64, error) {
+	var admins []models.Administrator
+	query := r.db.WithContext(ctx).Model(&models.Administrator{}).Order(orderBy("username", opts.Desc))
+	count, err := paginate(query, opts, &admins)
+	return admins, count, err
+}
+
+func (r *gormAdminRepository) GetByID(ctx context.Context, id uint) (*models.Administrator, error) {
+	var admin models.Administrator
+	if err := r.db.WithContext(ctx).First(&admin, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &admin, nil
+}
+
+func (r *gormAdminRepository) GetByUsername(ctx context.Context, username string) (*models.Administrator, error) {
+	var admin models.Administrator
+	if err := r.db.WithContext(ctx).Where("username = ?", username).First(&admin).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &admin, nil
+}
+
+func (r *gormAdminRepository) Count(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Administrator{}).Count(&count).Error
+	return count, err
+}
+
+func (r *gormAdminRepository) CountByRole(ctx context.Context, role string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Administrator{}).Where("role = ?", role).Count(&count).Error
+	return count, err
+}
+
+func (r *gormAdminRepository) Create(ctx context.Context, admin *models.Administrator) error {
+	return translateError(r.db.WithContext(ctx).Create(admin).Error)
+}
+
+func (r *gormAdminRepository) Save(ctx context.Context, admin *models.Administrator) error {
+	return translateError(r.db.WithContext(ctx).Save(admin).Error)
+}
+
+func (r *gormAdminRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Unscoped().Delete(&models.Administrator{}, id).Error
+}
+
+func (r *gormAdminRepository) RecordLoginFailure(ctx context.Context, id uint, threshold int, lockedUntil time.Time) (*models.Administrator, error) {
+	// SET 中的表达式都基于更新前的值计算，计数与锁定在同一条语句中完成，避免并发请求丢失计数
+	result := r.db.WithContext(ctx).Model(&models.Administrator{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"failed_login_attempts": gorm.Expr("CASE WHEN failed_login_attempts + 1 >= ? THEN 0 ELSE failed_login_attempts + 1 END", threshold),
+		"locked_until":          gorm.Expr("CASE WHEN failed_login_attempts + 1 >= ? THEN ?::timestamptz ELSE locked_until END", threshold, lockedUntil),
+	})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrNotFound
+	}
+
+	var admin models.Administrator
+	if err := r.db.WithContext(ctx).First(&admin, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &admin, nil
+}
+
+func (r *gormAdminRepository) RecordLoginSuccess(ctx context.Context, id uint, at time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.Administrator{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"failed_login_attempts": 0,
+		"locked_until":          nil,
+		"last_login_at":         at,
+	}).Error
+}
+
+func (r *gormAdminRepository) UpdatePassword(ctx context.Context, id uint, hash string, changedAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&models.Administrator{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"password":              hash,
+		"password_changed_at":   changedAt,
+		"failed_login_attempts": 0,
+		"locked_until":          nil,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *gormAdminRepository) SetTOTP(ctx context.Context, id uint, secret string, enabled bool) error {
+	result := r.db.WithContext(ctx).Model(&models.Administrator{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"totp_secret":    secret,
+		"totp_enabled":   enabled,
+		"totp_last_step": 0,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *gormAdminRepository) ConsumeTOTPStep(ctx context.Context, id uint, step int64) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.Administrator{}).
+		Where("id = ? AND totp_last_step < ?", id, step).
+		Update("totp_last_step", step)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *gormAdminRepository) ReplaceRecoveryCodes(ctx context.Context, id uint, hashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("admin_id = ?", id).Delete(&models.AdminRecoveryCode{}).Error; err != nil {
+			return err
+		}
+		if len(hashes) == 0 {
+			return nil
+		}
+		codes := make([]models.AdminRecoveryCode, len(hashes))
+		for i, hash := range hashes {
+			codes[i] = models.AdminRecoveryCode{AdminID: id, CodeHash: hash}
+		}
+		return tx.Create(&codes).Error
+	})
+}
+
+func (r *gormAdminRepository) UseRecoveryCode(ctx context.Context, id uint, hash string, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.AdminRecoveryCode{}).
+		Where("admin_id = ? AND code_hash = ? AND used_at IS NULL", id, hash).
+		Update("used_at", at)
+	return result.RowsAffected > 0, result.Error
+}
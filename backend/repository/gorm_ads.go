@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormAdRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormAdRepository) List(ctx context.Context, opts ListOptions) ([]models.Advertisement, int64, error) {
+	var ads []models.Advertisement
+	query := r.db.WithContext(ctx).Model(&models.Advertisement{}).Order(orderBy("created_at", opts.Desc))
+	count, err := paginate(query, opts, &ads)
+	return ads, count, err
+}
+
+func (r *gormAdRepository) Get(ctx context.Context, id uint) (*models.Advertisement, error) {
+	var ad models.Advertisement
+	if err := r.db.WithContext(ctx).First(&ad, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &ad, nil
+}
+
+func (r *gormAdRepository) GetWithPlacements(ctx context.Context, id uint) (*models.Advertisement, error) {
+	var ad models.Advertisement
+	if err := r.db.WithContext(ctx).
+		Preload("AdvertisementBuildings", func(db *gorm.DB) *gorm.DB { return db.Order("building_id ASC") }).
+		Preload("AdvertisementBuildings.Building").
+		First(&ad, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &ad, nil
+}
+
+func (r *gormAdRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Advertisement, error) {
+	var ads []models.Advertisement
+	if len(ids) == 0 {
+		return ads, nil
+	}
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Order("id ASC").Find(&ads).Error; err != nil {
+		return nil, err
+	}
+	return ads, nil
+}
+
+func (r *gormAdRepository) Create(ctx context.Context, ad *models.Advertisement) error {
+	return translateError(r.db.WithContext(ctx).Create(ad).Error)
+}
+
+func (r *gormAdRepository) Save(ctx context.Context, ad *models.Advertisement) error {
+	return translateError(r.db.WithContext(ctx).Save(ad).Error)
+}
+
+func (r *gormAdRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 删除关联的 AdvertisementBuilding 记录
+		if err := tx.Where("advertisement_id = ?", id).Delete(&models.AdvertisementBuilding{}).Error; err != nil {
+			return err
+		}
+		// 删除广告记录
+		return tx.Unscoped().Delete(&models.Advertisement{}, "id = ?", id).Error
+	})
+}
+
+func (r *gormAdRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]models.Advertisement, error) {
+	var ads []models.Advertisement
+	err := r.db.WithContext(ctx).
+		Where("expires_at <= ? AND expired_at IS NULL", now).
+		Or("go_live_at <= ? AND activated_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", now, now).
+		Order("id ASC").Limit(limit).Find(&ads).Error
+	return ads, err
+}
+
+func (r *gormAdRepository) Transition(ctx context.Context, id uint, event string, now time.Time) (bool, error) {
+	query := r.db.WithContext(ctx).Model(&models.Advertisement{}).Where("id = ?", id)
+	var updates map[string]any
+	switch event {
+	case models.EventAdExpired:
+		query = query.Where("expires_at <= ? AND expired_at IS NULL", now)
+		updates = map[string]any{"status": "inactive", "expired_at": now}
+	case models.EventAdActivated:
+		query = query.Where("go_live_at <= ? AND activated_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", now, now)
+		updates = map[string]any{"status": "active", "activated_at": now}
+	default:
+		return false, fmt.Errorf("未知的状态切换事件 %q", event)
+	}
+	result := query.Updates(updates)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *gormAdRepository) Each(ctx context.Context, fn func(models.Advertisement) error) error {
+	db := r.db.WithContext(ctx)
+	return eachRow(db, db.Model(&models.Advertisement{}).Order("id ASC"), fn)
+}
+
+func (r *gormAdRepository) Lock(ctx context.Context, id uint) error {
+	var locked []uint
+	return r.db.WithContext(ctx).Model(&models.Advertisement{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).Pluck("id", &locked).Error
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+)
+
+type gormAPIKeyRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormAPIKeyRepository) List(ctx context.Context, opts ListOptions) ([]models.APIKey, int64, error) {
+	var keys []models.APIKey
+	query := r.db.WithContext(ctx).Model(&models.APIKey{}).Order("created_at DESC, id DESC")
+	count, err := paginate(query, opts, &keys)
+	return keys, count, err
+}
+
+func (r *gormAPIKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*models.APIKey, error) {
+	var key models.APIKey
+	if err := r.db.WithContext(ctx).Where("prefix = ?", prefix).First(&key).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &key, nil
+}
+
+func (r *gormAPIKeyRepository) Create(ctx context.Context, key *models.APIKey) error {
+	return translateError(r.db.WithContext(ctx).Create(key).Error)
+}
+
+func (r *gormAPIKeyRepository) Revoke(ctx context.Context, id uint, at time.Time) error {
+	var key models.APIKey
+	if err := r.db.WithContext(ctx).First(&key, id).Error; err != nil {
+		return translateError(err)
+	}
+	return r.db.WithContext(ctx).Model(&models.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at).Error
+}
+
+func (r *gormAPIKeyRepository) Touch(ctx context.Context, id uint, at time.Time, ip string) error {
+	// 不更新 updated_at，使用记录不算作对密钥的修改
+	return r.db.WithContext(ctx).Model(&models.APIKey{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+		"last_used_at": at,
+		"last_used_ip": ip,
+	}).Error
+}
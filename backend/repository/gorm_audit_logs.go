@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+)
+
+type gormAuditLogRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormAuditLogRepository) List(ctx context.Context, opts ListOptions) ([]models.AdminAuditLog, int64, error) {
+	var entries []models.AdminAuditLog
+	query := r.db.WithContext(ctx).Model(&models.AdminAuditLog{}).Order("created_at DESC, id DESC")
+	count, err := paginate(query, opts, &entries)
+	return entries, count, err
+}
+
+func (r *gormAuditLogRepository) Create(ctx context.Context, entry *models.AdminAuditLog) error {
+	return r.db.WithContext(ctx).Create(entry).Error
+}
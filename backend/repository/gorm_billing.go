@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+)
+
+type gormRateCardRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormRateCardRepository) List(ctx context.Context, opts ListOptions) ([]models.RateCard, int64, error) {
+	var cards []models.RateCard
+	query := r.db.WithContext(ctx).Model(&models.RateCard{}).Order("name ASC")
+	count, err := paginate(query, opts, &cards)
+	return cards, count, err
+}
+
+func (r *gormRateCardRepository) Get(ctx context.Context, id uint) (*models.RateCard, error) {
+	var card models.RateCard
+	if err := r.db.WithContext(ctx).First(&card, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &card, nil
+}
+
+func (r *gormRateCardRepository) Create(ctx context.Context, card *models.RateCard) error {
+	return translateError(r.db.WithContext(ctx).Create(card).Error)
+}
+
+func (r *gormRateCardRepository) Save(ctx context.Context, card *models.RateCard) error {
+	return translateError(r.db.WithContext(ctx).Save(card).Error)
+}
+
+// Delete 发票的 rate_card_id 由外键 ON DELETE SET NULL 置空
+func (r *gormRateCardRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.RateCard{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+type gormInvoiceRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormInvoiceRepository) List(ctx context.Context, advertisementID uint, opts ListOptions) ([]models.Invoice, int64, error) {
+	var invoices []models.Invoice
+	query := r.db.WithContext(ctx).Model(&models.Invoice{}).Order("created_at DESC, id DESC")
+	if advertisementID != 0 {
+		query = query.Where("advertisement_id = ?", advertisementID)
+	}
+	count, err := paginate(query, opts, &invoices)
+	return invoices, count, err
+}
+
+func (r *gormInvoiceRepository) Get(ctx context.Context, id uint) (*models.Invoice, error) {
+	var invoice models.Invoice
+	err := r.db.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB { return db.Order("building_id ASC") }).
+		First(&invoice, id).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &invoice, nil
+}
+
+func (r *gormInvoiceRepository) Create(ctx context.Context, invoice *models.Invoice) error {
+	return r.db.WithContext(ctx).Create(invoice).Error
+}
+
+func (r *gormInvoiceRepository) Save(ctx context.Context, invoice *models.Invoice) error {
+	return r.db.WithContext(ctx).Omit("Lines").Save(invoice).Error
+}
+
+func (r *gormInvoiceRepository) Overlapping(ctx context.Context, advertisementID uint, from, to string) ([]models.Invoice, error) {
+	invoices := []models.Invoice{}
+	err := r.db.WithContext(ctx).
+		Where("advertisement_id = ? AND status <> ? AND period_start <= ? AND period_end >= ?", advertisementID, models.InvoiceVoid, to, from).
+		Order("id ASC").Find(&invoices).Error
+	return invoices, err
+}
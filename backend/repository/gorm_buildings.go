@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormBuildingRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormBuildingRepository) List(ctx context.Context, opts ListOptions) ([]models.Building, int64, error) {
+	var buildings []models.Building
+	query := r.db.WithContext(ctx).Model(&models.Building{}).Order(orderBy("created_at", opts.Desc))
+	count, err := paginate(query, opts, &buildings)
+	return buildings, count, err
+}
+
+func (r *gormBuildingRepository) Get(ctx context.Context, id uint) (*models.Building, error) {
+	var building models.Building
+	if err := r.db.WithContext(ctx).First(&building, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &building, nil
+}
+
+func (r *gormBuildingRepository) GetWithPlacements(ctx context.Context, id uint) (*models.Building, error) {
+	var building models.Building
+	if err := r.db.WithContext(ctx).
+		Preload("AdvertisementBuildings", func(db *gorm.DB) *gorm.DB { return db.Order("advertisement_id ASC") }).
+		Preload("AdvertisementBuildings.Advertisement").
+		Preload("AdvertisementBuildings.Building").
+		First(&building, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &building, nil
+}
+
+func (r *gormBuildingRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Building, error) {
+	var buildings []models.Building
+	if len(ids) == 0 {
+		return buildings, nil
+	}
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Order("id ASC").Find(&buildings).Error; err != nil {
+		return nil, err
+	}
+	return buildings, nil
+}
+
+func (r *gormBuildingRepository) ExistingNames(ctx context.Context, names []string) ([]string, error) {
+	var existing []string
+	if len(names) == 0 {
+		return existing, nil
+	}
+	// 唯一索引同样覆盖软删除的记录，因此使用 Unscoped
+	if err := r.db.WithContext(ctx).Unscoped().Model(&models.Building{}).Where("name IN ?", names).Pluck("name", &existing).Error; err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (r *gormBuildingRepository) Create(ctx context.Context, building *models.Building) error {
+	return translateError(r.db.WithContext(ctx).Create(building).Error)
+}
+
+func (r *gormBuildingRepository) Save(ctx context.Context, building *models.Building) error {
+	return translateError(r.db.WithContext(ctx).Save(building).Error)
+}
+
+func (r *gormBuildingRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 删除关联的 AdvertisementBuilding 记录
+		if err := tx.Where("building_id = ?", id).Delete(&models.AdvertisementBuilding{}).Error; err != nil {
+			return err
+		}
+		// 删除大厦记录
+		return tx.Unscoped().Delete(&models.Building{}, "id = ?", id).Error
+	})
+}
+
+func (r *gormBuildingRepository) Lock(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	var locked []uint
+	return r.db.WithContext(ctx).Model(&models.Building{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", ids).Order("id ASC").Pluck("id", &locked).Error
+}
+
+func (r *gormBuildingRepository) Each(ctx context.Context, fn func(models.Building) error) error {
+	db := r.db.WithContext(ctx)
+	return eachRow(db, db.Model(&models.Building{}).Order("id ASC"), fn)
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+)
+
+type gormCalendarRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormCalendarRepository) List(ctx context.Context, opts ListOptions) ([]models.Calendar, int64, error) {
+	var calendars []models.Calendar
+	query := r.db.WithContext(ctx).Model(&models.Calendar{}).Order("name ASC")
+	count, err := paginate(query, opts, &calendars)
+	return calendars, count, err
+}
+
+func (r *gormCalendarRepository) Get(ctx context.Context, id uint) (*models.Calendar, error) {
+	var calendar models.Calendar
+	if err := r.db.WithContext(ctx).First(&calendar, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &calendar, nil
+}
+
+func (r *gormCalendarRepository) Create(ctx context.Context, calendar *models.Calendar) error {
+	return translateError(r.db.WithContext(ctx).Create(calendar).Error)
+}
+
+func (r *gormCalendarRepository) Save(ctx context.Context, calendar *models.Calendar) error {
+	return translateError(r.db.WithContext(ctx).Save(calendar).Error)
+}
+
+func (r *gormCalendarRepository) Delete(ctx context.Context, id uint) error {
+	// 事件由外键级联删除
+	result := r.db.WithContext(ctx).Delete(&models.Calendar{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *gormCalendarRepository) ListEvents(ctx context.Context, calendarID uint) ([]models.CalendarEvent, error) {
+	return r.EventsFor(ctx, []uint{calendarID})
+}
+
+func (r *gormCalendarRepository) EventsFor(ctx context.Context, calendarIDs []uint) ([]models.CalendarEvent, error) {
+	var events []models.CalendarEvent
+	if len(calendarIDs) == 0 {
+		return events, nil
+	}
+	err := r.db.WithContext(ctx).Where("calendar_id IN ?", calendarIDs).
+		Order("start_date ASC").Order("id ASC").Find(&events).Error
+	return events, err
+}
+
+func (r *gormCalendarRepository) CreateEvent(ctx context.Context, event *models.CalendarEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+func (r *gormCalendarRepository) DeleteEvent(ctx context.Context, calendarID, eventID uint) error {
+	result := r.db.WithContext(ctx).Where("calendar_id = ?", calendarID).Delete(&models.CalendarEvent{}, eventID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *gormCalendarRepository) ReplaceEvents(ctx context.Context, calendarID uint, events []models.CalendarEvent) error {
+	db := r.db.WithContext(ctx)
+	if err := db.Where("calendar_id = ?", calendarID).Delete(&models.CalendarEvent{}).Error; err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	for i := range events {
+		events[i].ID = 0
+		events[i].CalendarID = calendarID
+	}
+	return db.CreateInBatches(events, 200).Error
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+)
+
+type gormDaypartRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormDaypartRepository) List(ctx context.Context, opts ListOptions) ([]models.Daypart, int64, error) {
+	var dayparts []models.Daypart
+	query := r.db.WithContext(ctx).Model(&models.Daypart{}).Order("name ASC")
+	count, err := paginate(query, opts, &dayparts)
+	return dayparts, count, err
+}
+
+func (r *gormDaypartRepository) Get(ctx context.Context, id uint) (*models.Daypart, error) {
+	var daypart models.Daypart
+	if err := r.db.WithContext(ctx).First(&daypart, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &daypart, nil
+}
+
+func (r *gormDaypartRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Daypart, error) {
+	var dayparts []models.Daypart
+	if len(ids) == 0 {
+		return dayparts, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Order("id ASC").Find(&dayparts).Error
+	return dayparts, err
+}
+
+func (r *gormDaypartRepository) Create(ctx context.Context, daypart *models.Daypart) error {
+	return translateError(r.db.WithContext(ctx).Create(daypart).Error)
+}
+
+func (r *gormDaypartRepository) Save(ctx context.Context, daypart *models.Daypart) error {
+	return translateError(r.db.WithContext(ctx).Save(daypart).Error)
+}
+
+func (r *gormDaypartRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.Daypart{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *gormDaypartRepository) UsingCalendar(ctx context.Context, calendarID uint) ([]uint, error) {
+	// 日历 ID 以 JSON 保存，分时规则数量很少，直接在内存中过滤
+	var dayparts []models.Daypart
+	if err := r.db.WithContext(ctx).Order("id ASC").Find(&dayparts).Error; err != nil {
+		return nil, err
+	}
+	ids := []uint{}
+	for _, daypart := range dayparts {
+		if daypart.UsesCalendar(calendarID) {
+			ids = append(ids, daypart.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *gormDaypartRepository) Placements(ctx context.Context, id uint) ([]models.AdvertisementBuilding, error) {
+	var placements []models.AdvertisementBuilding
+	err := r.db.WithContext(ctx).Where("daypart_id = ?", id).
+		Order("building_id ASC").Order("advertisement_id ASC").Find(&placements).Error
+	return placements, err
+}
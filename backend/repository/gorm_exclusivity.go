@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+)
+
+type gormExclusivityRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormExclusivityRepository) ListByBuildings(ctx context.Context, buildingIDs []uint) ([]models.ExclusivityRule, error) {
+	rules := []models.ExclusivityRule{}
+	if len(buildingIDs) == 0 {
+		return rules, nil
+	}
+	err := r.db.WithContext(ctx).Where("building_id IN ?", buildingIDs).Order("building_id ASC, category ASC").Find(&rules).Error
+	return rules, err
+}
+
+func (r *gormExclusivityRepository) Get(ctx context.Context, buildingID, id uint) (*models.ExclusivityRule, error) {
+	var rule models.ExclusivityRule
+	if err := r.db.WithContext(ctx).Where("building_id = ?", buildingID).First(&rule, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &rule, nil
+}
+
+func (r *gormExclusivityRepository) Create(ctx context.Context, rule *models.ExclusivityRule) error {
+	return translateError(r.db.WithContext(ctx).Create(rule).Error)
+}
+
+func (r *gormExclusivityRepository) Save(ctx context.Context, rule *models.ExclusivityRule) error {
+	return translateError(r.db.WithContext(ctx).Save(rule).Error)
+}
+
+func (r *gormExclusivityRepository) Delete(ctx context.Context, buildingID, id uint) error {
+	result := r.db.WithContext(ctx).Where("building_id = ?", buildingID).Delete(&models.ExclusivityRule{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
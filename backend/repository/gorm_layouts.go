@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+)
+
+type gormLayoutRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormLayoutRepository) List(ctx context.Context, opts ListOptions) ([]models.Layout, int64, error) {
+	var layouts []models.Layout
+	query := r.db.WithContext(ctx).Model(&models.Layout{}).Order("name ASC")
+	count, err := paginate(query, opts, &layouts)
+	return layouts, count, err
+}
+
+func (r *gormLayoutRepository) Get(ctx context.Context, id uint) (*models.Layout, error) {
+	var layout models.Layout
+	if err := r.db.WithContext(ctx).First(&layout, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &layout, nil
+}
+
+func (r *gormLayoutRepository) Create(ctx context.Context, layout *models.Layout) error {
+	return translateError(r.db.WithContext(ctx).Create(layout).Error)
+}
+
+func (r *gormLayoutRepository) Save(ctx context.Context, layout *models.Layout) error {
+	return translateError(r.db.WithContext(ctx).Save(layout).Error)
+}
+
+func (r *gormLayoutRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.Layout{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *gormLayoutRepository) BuildingIDs(ctx context.Context, id uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Model(&models.Building{}).Where("layout_id = ?", id).Order("id ASC").Pluck("id", &ids).Error
+	return ids, err
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+)
+
+type gormManifestRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormManifestRepository) Latest(ctx context.Context, buildingID uint) (*models.PlaylistManifest, error) {
+	var manifest models.PlaylistManifest
+	err := r.db.WithContext(ctx).Where("building_id = ?", buildingID).Order("version DESC").First(&manifest).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &manifest, nil
+}
+
+func (r *gormManifestRepository) Get(ctx context.Context, buildingID uint, version int) (*models.PlaylistManifest, error) {
+	var manifest models.PlaylistManifest
+	err := r.db.WithContext(ctx).Where("building_id = ? AND version = ?", buildingID, version).First(&manifest).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &manifest, nil
+}
+
+func (r *gormManifestRepository) Create(ctx context.Context, manifest *models.PlaylistManifest) error {
+	return translateError(r.db.WithContext(ctx).Create(manifest).Error)
+}
+
+func (r *gormManifestRepository) Prune(ctx context.Context, buildingID uint, keep int) error {
+	return r.db.WithContext(ctx).
+		Where("building_id = ? AND version <= (SELECT MAX(version) FROM playlist_manifests WHERE building_id = ?) - ?", buildingID, buildingID, keep).
+		Delete(&models.PlaylistManifest{}).Error
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+)
+
+type gormNoticeRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormNoticeRepository) List(ctx context.Context, opts ListOptions) ([]models.Notice, int64, error) {
+	var notices []models.Notice
+	query := r.db.WithContext(ctx).Model(&models.Notice{}).Order(orderBy("created_at", opts.Desc)).Order("id ASC")
+	count, err := paginate(query, opts, &notices)
+	return notices, count, err
+}
+
+func (r *gormNoticeRepository) Get(ctx context.Context, id uint) (*models.Notice, error) {
+	var notice models.Notice
+	if err := r.db.WithContext(ctx).First(&notice, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &notice, nil
+}
+
+func (r *gormNoticeRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Notice, error) {
+	var notices []models.Notice
+	if len(ids) == 0 {
+		return notices, nil
+	}
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Order("id ASC").Find(&notices).Error; err != nil {
+		return nil, err
+	}
+	return notices, nil
+}
+
+func (r *gormNoticeRepository) Create(ctx context.Context, notice *models.Notice) error {
+	return translateError(r.db.WithContext(ctx).Create(notice).Error)
+}
+
+func (r *gormNoticeRepository) Save(ctx context.Context, notice *models.Notice) error {
+	return translateError(r.db.WithContext(ctx).Save(notice).Error)
+}
+
+func (r *gormNoticeRepository) Delete(ctx context.Context, id uint) error {
+	// building_notices 通过外键级联删除
+	result := r.db.WithContext(ctx).Delete(&models.Notice{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *gormNoticeRepository) Link(ctx context.Context, link *models.BuildingNotice) error {
+	return translateError(r.db.WithContext(ctx).Create(link).Error)
+}
+
+func (r *gormNoticeRepository) UnlinkFromBuilding(ctx context.Context, buildingID uint, noticeIDs []uint) error {
+	if len(noticeIDs) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Where("building_id = ? AND notice_id IN ?", buildingID, noticeIDs).
+		Delete(&models.BuildingNotice{}).Error
+}
+
+func (r *gormNoticeRepository) UnlinkFromNotice(ctx context.Context, noticeID uint, buildingIDs []uint) error {
+	if len(buildingIDs) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Where("notice_id = ? AND building_id IN ?", noticeID, buildingIDs).
+		Delete(&models.BuildingNotice{}).Error
+}
+
+func (r *gormNoticeRepository) ListByBuilding(ctx context.Context, buildingID uint) ([]models.BuildingNotice, error) {
+	var links []models.BuildingNotice
+	if err := r.db.WithContext(ctx).Where("building_id = ?", buildingID).Order("notice_id ASC").Find(&links).Error; err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+func (r *gormNoticeRepository) ListByNotice(ctx context.Context, noticeID uint) ([]models.BuildingNotice, error) {
+	var links []models.BuildingNotice
+	if err := r.db.WithContext(ctx).Where("notice_id = ?", noticeID).Order("building_id ASC").Find(&links).Error; err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+func (r *gormNoticeRepository) ActiveForBuilding(ctx context.Context, buildingID uint, now time.Time) ([]models.Notice, error) {
+	var notices []models.Notice
+	err := r.db.WithContext(ctx).
+		Joins("JOIN building_notices ON building_notices.notice_id = notices.id").
+		Where("building_notices.building_id = ?", buildingID).
+		Where("notices.starts_at <= ? AND (notices.ends_at IS NULL OR notices.ends_at > ?)", now, now).
+		Order("notices.priority DESC, notices.starts_at DESC, notices.id ASC").
+		Find(&notices).Error
+	return notices, err
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+)
+
+type gormPlacementRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormPlacementRepository) Create(ctx context.Context, placement *models.AdvertisementBuilding) error {
+	return translateError(r.db.WithContext(ctx).Omit("Advertisement", "Building").Create(placement).Error)
+}
+
+func (r *gormPlacementRepository) Get(ctx context.Context, advertisementID, buildingID uint) (*models.AdvertisementBuilding, error) {
+	var placement models.AdvertisementBuilding
+	if err := r.db.WithContext(ctx).
+		Where("advertisement_id = ? AND building_id = ?", advertisementID, buildingID).
+		First(&placement).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &placement, nil
+}
+
+func (r *gormPlacementRepository) Save(ctx context.Context, placement *models.AdvertisementBuilding) error {
+	// 关联表没有主键，按联合键更新
+	return r.db.WithContext(ctx).Model(&models.AdvertisementBuilding{}).
+		Where("advertisement_id = ? AND building_id = ?", placement.AdvertisementID, placement.BuildingID).
+		Updates(map[string]interface{}{"play_duration": placement.PlayDuration, "zone": placement.Zone, "daypart_id": placement.DaypartID}).Error
+}
+
+func (r *gormPlacementRepository) ListByBuilding(ctx context.Context, buildingID uint) ([]models.AdvertisementBuilding, error) {
+	var placements []models.AdvertisementBuilding
+	if err := r.db.WithContext(ctx).Where("building_id = ?", buildingID).Order("advertisement_id ASC").Find(&placements).Error; err != nil {
+		return nil, err
+	}
+	return placements, nil
+}
+
+func (r *gormPlacementRepository) ListByAdvertisement(ctx context.Context, advertisementID uint) ([]models.AdvertisementBuilding, error) {
+	var placements []models.AdvertisementBuilding
+	if err := r.db.WithContext(ctx).Where("advertisement_id = ?", advertisementID).Order("building_id ASC").Find(&placements).Error; err != nil {
+		return nil, err
+	}
+	return placements, nil
+}
+
+func (r *gormPlacementRepository) DeleteFromBuilding(ctx context.Context, buildingID uint, advertisementIDs []uint) error {
+	return r.db.WithContext(ctx).
+		Where("building_id = ? AND advertisement_id IN ?", buildingID, advertisementIDs).
+		Delete(&models.AdvertisementBuilding{}).Error
+}
+
+func (r *gormPlacementRepository) DeleteFromAdvertisement(ctx context.Context, advertisementID uint, buildingIDs []uint) error {
+	return r.db.WithContext(ctx).
+		Where("advertisement_id = ? AND building_id IN ?", advertisementID, buildingIDs).
+		Delete(&models.AdvertisementBuilding{}).Error
+}
+
+func (r *gormPlacementRepository) EachDetail(ctx context.Context, fn func(PlacementDetail) error) error {
+	db := r.db.WithContext(ctx)
+	query := db.Table("advertisement_buildings AS ab").
+		Select(`ab.advertisement_id, a.title AS advertisement_title, a.status AS advertisement_status, a.video_duration,
+			ab.building_id, b.name AS building_name, b.building_id AS blg_id, b.address, ab.play_duration`).
+		Joins("JOIN advertisements a ON a.id = ab.advertisement_id AND a.deleted_at IS NULL").
+		Joins("JOIN buildings b ON b.id = ab.building_id AND b.deleted_at IS NULL").
+		Order("ab.advertisement_id ASC, ab.building_id ASC")
+	return eachRow(db, query, fn)
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// gormStore 基于 GORM 的 Store 实现
+type gormStore struct {
+	db *gorm.DB
+}
+
+// NewGormStore 使用给定的数据库连接创建 Store
+func NewGormStore(db *gorm.DB) Store {
+	return &gormStore{db: db}
+}
+
+func (s *gormStore) Ads() AdRepository {
+	return &gormAdRepository{db: s.db}
+}
+
+func (s *gormStore) Buildings() BuildingRepository {
+	return &gormBuildingRepository{db: s.db}
+}
+
+func (s *gormStore) Placements() PlacementRepository {
+	return &gormPlacementRepository{db: s.db}
+}
+
+func (s *gormStore) Admins() AdminRepository {
+	return &gormAdminRepository{db: s.db}
+}
+
+func (s *gormStore) AuditLogs() AuditLogRepository {
+	return &gormAuditLogRepository{db: s.db}
+}
+
+func (s *gormStore) APIKeys() APIKeyRepository {
+	return &gormAPIKeyRepository{db: s.db}
+}
+
+func (s *gormStore) Webhooks() WebhookRepository {
+	return &gormWebhookRepository{db: s.db}
+}
+
+func (s *gormStore) Manifests() ManifestRepository {
+	return &gormManifestRepository{db: s.db}
+}
+
+func (s *gormStore) Layouts() LayoutRepository {
+	return &gormLayoutRepository{db: s.db}
+}
+
+func (s *gormStore) Notices() NoticeRepository {
+	return &gormNoticeRepository{db: s.db}
+}
+
+func (s *gormStore) Calendars() CalendarRepository {
+	return &gormCalendarRepository{db: s.db}
+}
+
+func (s *gormStore) Dayparts() DaypartRepository {
+	return &gormDaypartRepository{db: s.db}
+}
+
+func (s *gormStore) Exclusivity() ExclusivityRepository {
+	return &gormExclusivityRepository{db: s.db}
+}
+
+func (s *gormStore) Traffic() TrafficRepository {
+	return &gormTrafficRepository{db: s.db}
+}
+
+func (s *gormStore) RateCards() RateCardRepository {
+	return &gormRateCardRepository{db: s.db}
+}
+
+func (s *gormStore) Invoices() InvoiceRepository {
+	return &gormInvoiceRepository{db: s.db}
+}
+
+func (s *gormStore) Transaction(ctx context.Context, fn func(tx Store) error) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&gormStore{db: tx})
+	})
+}
+
+// TryLock 使用 Postgres 事务级 advisory lock，多个实例中只有一个能获取
+func (s *gormStore) TryLock(ctx context.Context, key int64) (bool, error) {
+	var locked bool
+	if err := s.db.WithContext(ctx).Raw("SELECT pg_try_advisory_xact_lock(?)", key).Scan(&locked).Error; err != nil {
+		return false, err
+	}
+	return locked, nil
+}
+
+// Lock 使用 Postgres 事务级 advisory lock，等待其他事务释放
+func (s *gormStore) Lock(ctx context.Context, key int64) error {
+	return s.db.WithContext(ctx).Exec("SELECT pg_advisory_xact_lock(?)", key).Error
+}
+
+// Ping 通过 GORM 底层连接池检查数据库连接
+func (s *gormStore) Ping(ctx context.Context) error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+// translateError 将 GORM 错误转换为仓库层错误
+func translateError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrDuplicate
+	default:
+		return err
+	}
+}
+
+// orderBy 根据排序方向拼接排序子句
+func orderBy(column string, desc bool) string {
+	if desc {
+		return column + " DESC"
+	}
+	return column + " ASC"
+}
+
+// paginate 统计总数并查询一页数据
+func paginate(query *gorm.DB, opts ListOptions, dest interface{}) (int64, error) {
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	if err := query.Offset(opts.Offset).Limit(opts.Limit).Find(dest).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// eachRow 逐行扫描查询结果并回调 fn，避免一次加载全部数据
+func eachRow[T any](db *gorm.DB, query *gorm.DB, fn func(T) error) error {
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item T
+		if err := db.ScanRows(rows, &item); err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
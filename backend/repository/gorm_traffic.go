@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormTrafficRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormTrafficRepository) List(ctx context.Context, buildingID uint, from, to time.Time) ([]models.TrafficCount, error) {
+	counts := []models.TrafficCount{}
+	err := r.db.WithContext(ctx).
+		Where("building_id = ? AND hour >= ? AND hour < ?", buildingID, from, to).
+		Order("hour ASC").Find(&counts).Error
+	return counts, err
+}
+
+func (r *gormTrafficRepository) Upsert(ctx context.Context, counts []models.TrafficCount) error {
+	if len(counts) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "building_id"}, {Name: "hour"}},
+		DoUpdates: clause.AssignmentColumns([]string{"visitors", "updated_at"}),
+	}).CreateInBatches(counts, 500).Error
+}
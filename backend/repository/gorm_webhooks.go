@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"gorm.io/gorm"
+)
+
+type gormWebhookRepository struct {
+	db *gorm.DB
+}
+
+func (r *gormWebhookRepository) ListSubscriptions(ctx context.Context, opts ListOptions) ([]models.WebhookSubscription, int64, error) {
+	var subs []models.WebhookSubscription
+	query := r.db.WithContext(ctx).Model(&models.WebhookSubscription{}).Order("created_at DESC, id DESC")
+	count, err := paginate(query, opts, &subs)
+	return subs, count, err
+}
+
+func (r *gormWebhookRepository) GetSubscription(ctx context.Context, id uint) (*models.WebhookSubscription, error) {
+	var sub models.WebhookSubscription
+	if err := r.db.WithContext(ctx).First(&sub, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &sub, nil
+}
+
+func (r *gormWebhookRepository) ActiveSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error) {
+	var subs []models.WebhookSubscription
+	err := r.db.WithContext(ctx).Where("active = ?", true).Order("id").Find(&subs).Error
+	return subs, err
+}
+
+func (r *gormWebhookRepository) CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	return translateError(r.db.WithContext(ctx).Create(sub).Error)
+}
+
+func (r *gormWebhookRepository) SaveSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	return translateError(r.db.WithContext(ctx).Save(sub).Error)
+}
+
+func (r *gormWebhookRepository) DeleteSubscription(ctx context.Context, id uint) error {
+	// 投递记录通过外键级联删除
+	result := r.db.WithContext(ctx).Delete(&models.WebhookSubscription{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *gormWebhookRepository) EnqueueDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).CreateInBatches(deliveries, 100).Error
+}
+
+func (r *gormWebhookRepository) ListDeliveries(ctx context.Context, subscriptionID uint, opts ListOptions) ([]models.WebhookDelivery, int64, error) {
+	var deliveries []models.WebhookDelivery
+	query := r.db.WithContext(ctx).Model(&models.WebhookDelivery{}).
+		Where("subscription_id = ?", subscriptionID).
+		Order("id DESC")
+	count, err := paginate(query, opts, &deliveries)
+	return deliveries, count, err
+}
+
+func (r *gormWebhookRepository) GetDelivery(ctx context.Context, id uint) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	if err := r.db.WithContext(ctx).First(&delivery, id).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &delivery, nil
+}
+
+func (r *gormWebhookRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]models.WebhookDelivery, error) {
+	// SKIP LOCKED 让多个实例各自领取不同的记录，领取与推迟在同一条语句中完成
+	var deliveries []models.WebhookDelivery
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE webhook_deliveries SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at, id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, leaseUntil, models.DeliveryPending, now, limit).Scan(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].ID < deliveries[j].ID
+	})
+	return deliveries, nil
+}
+
+func (r *gormWebhookRepository) UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	// 只更新状态字段，避免记录已被删除时 Save 重新插入
+	return r.db.WithContext(ctx).Model(delivery).
+		Select("updated_at", "status", "attempts", "next_attempt_at", "last_attempt_at", "response_status", "last_error", "delivered_at").
+		Updates(delivery).Error
+}
@@ -0,0 +1,234 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+type adminRepository struct {
+	s *Store
+}
+
+func (r *adminRepository) List(ctx context.Context, opts repository.ListOptions) ([]models.Administrator, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	admins := make([]models.Administrator, 0, len(r.s.data.admins))
+	for _, admin := range r.s.data.admins {
+		admins = append(admins, admin)
+	}
+	sort.Slice(admins, func(i, j int) bool {
+		if opts.Desc {
+			return admins[i].Username > admins[j].Username
+		}
+		return admins[i].Username < admins[j].Username
+	})
+	return page(admins, opts), int64(len(admins)), nil
+}
+
+func (r *adminRepository) GetByID(ctx context.Context, id uint) (*models.Administrator, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	admin, ok := r.s.data.admins[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &admin, nil
+}
+
+func (r *adminRepository) GetByUsername(ctx context.Context, username string) (*models.Administrator, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	for _, admin := range r.s.data.admins {
+		if admin.Username == username {
+			return &admin, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *adminRepository) Count(ctx context.Context) (int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return int64(len(r.s.data.admins)), nil
+}
+
+func (r *adminRepository) CountByRole(ctx context.Context, role string) (int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var count int64
+	for _, admin := range r.s.data.admins {
+		if admin.Role == role {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *adminRepository) Create(ctx context.Context, admin *models.Administrator) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.usernameTaken(admin.Username, 0) {
+		return repository.ErrDuplicate
+	}
+	r.s.touch("administrators", &admin.Model)
+	r.s.data.admins[admin.ID] = *admin
+	return nil
+}
+
+func (r *adminRepository) Save(ctx context.Context, admin *models.Administrator) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.usernameTaken(admin.Username, admin.ID) {
+		return repository.ErrDuplicate
+	}
+	r.s.touch("administrators", &admin.Model)
+	r.s.data.admins[admin.ID] = *admin
+	return nil
+}
+
+func (r *adminRepository) Delete(ctx context.Context, id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	delete(r.s.data.admins, id)
+	for codeID, code := range r.s.data.recoveryCodes {
+		if code.AdminID == id {
+			delete(r.s.data.recoveryCodes, codeID)
+		}
+	}
+	return nil
+}
+
+func (r *adminRepository) RecordLoginFailure(ctx context.Context, id uint, threshold int, lockedUntil time.Time) (*models.Administrator, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	admin, ok := r.s.data.admins[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	admin.FailedLoginAttempts++
+	if admin.FailedLoginAttempts >= threshold {
+		admin.FailedLoginAttempts = 0
+		admin.LockedUntil = &lockedUntil
+	}
+	admin.UpdatedAt = r.s.now()
+	r.s.data.admins[id] = admin
+	return &admin, nil
+}
+
+func (r *adminRepository) RecordLoginSuccess(ctx context.Context, id uint, at time.Time) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if admin, ok := r.s.data.admins[id]; ok {
+		admin.FailedLoginAttempts = 0
+		admin.LockedUntil = nil
+		admin.LastLoginAt = &at
+		admin.UpdatedAt = r.s.now()
+		r.s.data.admins[id] = admin
+	}
+	return nil
+}
+
+func (r *adminRepository) UpdatePassword(ctx context.Context, id uint, hash string, changedAt time.Time) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	admin, ok := r.s.data.admins[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	admin.Password = hash
+	admin.PasswordChangedAt = &changedAt
+	admin.FailedLoginAttempts = 0
+	admin.LockedUntil = nil
+	admin.UpdatedAt = r.s.now()
+	r.s.data.admins[id] = admin
+	return nil
+}
+
+func (r *adminRepository) SetTOTP(ctx context.Context, id uint, secret string, enabled bool) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	admin, ok := r.s.data.admins[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	admin.TOTPSecret = secret
+	admin.TOTPEnabled = enabled
+	admin.TOTPLastStep = 0
+	admin.UpdatedAt = r.s.now()
+	r.s.data.admins[id] = admin
+	return nil
+}
+
+func (r *adminRepository) ConsumeTOTPStep(ctx context.Context, id uint, step int64) (bool, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	admin, ok := r.s.data.admins[id]
+	if !ok || admin.TOTPLastStep >= step {
+		return false, nil
+	}
+	admin.TOTPLastStep = step
+	r.s.data.admins[id] = admin
+	return true, nil
+}
+
+func (r *adminRepository) ReplaceRecoveryCodes(ctx context.Context, id uint, hashes []string) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for codeID, code := range r.s.data.recoveryCodes {
+		if code.AdminID == id {
+			delete(r.s.data.recoveryCodes, codeID)
+		}
+	}
+	for _, hash := range hashes {
+		code := models.AdminRecoveryCode{
+			ID:        r.s.nextID("admin_recovery_codes"),
+			CreatedAt: r.s.now(),
+			AdminID:   id,
+			CodeHash:  hash,
+		}
+		r.s.data.recoveryCodes[code.ID] = code
+	}
+	return nil
+}
+
+func (r *adminRepository) UseRecoveryCode(ctx context.Context, id uint, hash string, at time.Time) (bool, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for codeID, code := range r.s.data.recoveryCodes {
+		if code.AdminID == id && code.CodeHash == hash && code.UsedAt == nil {
+			code.UsedAt = &at
+			r.s.data.recoveryCodes[codeID] = code
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// usernameTaken 检查用户名是否已被其他管理员使用，调用方需持有锁
+func (r *adminRepository) usernameTaken(username string, exceptID uint) bool {
+	for id, admin := range r.s.data.admins {
+		if id != exceptID && admin.Username == username {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"gorm.io/gorm"
+)
+
+type adRepository struct {
+	s *Store
+}
+
+func (r *adRepository) List(ctx context.Context, opts repository.ListOptions) ([]models.Advertisement, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	ads := make([]models.Advertisement, 0, len(r.s.data.ads))
+	for _, ad := range r.s.data.ads {
+		ads = append(ads, ad)
+	}
+	sortByCreatedAt(ads, func(ad models.Advertisement) gorm.Model { return ad.Model }, opts.Desc)
+	return page(ads, opts), int64(len(ads)), nil
+}
+
+func (r *adRepository) Get(ctx context.Context, id uint) (*models.Advertisement, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	ad, ok := r.s.data.ads[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &ad, nil
+}
+
+func (r *adRepository) GetWithPlacements(ctx context.Context, id uint) (*models.Advertisement, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	ad, ok := r.s.data.ads[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	ad.AdvertisementBuildings = []models.AdvertisementBuilding{}
+	for key, placement := range r.s.data.placements {
+		if key.advertisementID != id {
+			continue
+		}
+		placement.Building = r.s.data.buildings[key.buildingID]
+		ad.AdvertisementBuildings = append(ad.AdvertisementBuildings, placement)
+	}
+	sort.Slice(ad.AdvertisementBuildings, func(i, j int) bool {
+		return ad.AdvertisementBuildings[i].BuildingID < ad.AdvertisementBuildings[j].BuildingID
+	})
+	return &ad, nil
+}
+
+func (r *adRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Advertisement, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	ads := []models.Advertisement{}
+	seen := make(map[uint]struct{}, len(ids))
+	for _, id := range ids {
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		if ad, ok := r.s.data.ads[id]; ok {
+			ads = append(ads, ad)
+		}
+	}
+	sort.Slice(ads, func(i, j int) bool { return ads[i].ID < ads[j].ID })
+	return ads, nil
+}
+
+func (r *adRepository) Create(ctx context.Context, ad *models.Advertisement) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	r.s.touch("advertisements", &ad.Model)
+	stored := *ad
+	stored.AdvertisementBuildings = nil
+	r.s.data.ads[ad.ID] = stored
+	return nil
+}
+
+func (r *adRepository) Save(ctx context.Context, ad *models.Advertisement) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	r.s.touch("advertisements", &ad.Model)
+	stored := *ad
+	stored.AdvertisementBuildings = nil
+	r.s.data.ads[ad.ID] = stored
+	return nil
+}
+
+func (r *adRepository) Delete(ctx context.Context, id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for key := range r.s.data.placements {
+		if key.advertisementID == id {
+			delete(r.s.data.placements, key)
+		}
+	}
+	// 赞助广告删除时，其独家赞助规则随之删除
+	for ruleID, rule := range r.s.data.exclusivity {
+		if rule.SponsorAdID != nil && *rule.SponsorAdID == id {
+			delete(r.s.data.exclusivity, ruleID)
+		}
+	}
+	delete(r.s.data.ads, id)
+	return nil
+}
+
+func (r *adRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]models.Advertisement, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	reached := func(t *time.Time) bool { return t != nil && !t.After(now) }
+	ads := []models.Advertisement{}
+	for _, ad := range r.s.data.ads {
+		expiring := reached(ad.ExpiresAt) && ad.ExpiredAt == nil
+		activating := reached(ad.GoLiveAt) && ad.ActivatedAt == nil && !reached(ad.ExpiresAt)
+		if expiring || activating {
+			ads = append(ads, ad)
+		}
+	}
+	sort.Slice(ads, func(i, j int) bool { return ads[i].ID < ads[j].ID })
+	if len(ads) > limit {
+		ads = ads[:limit]
+	}
+	return ads, nil
+}
+
+func (r *adRepository) Transition(ctx context.Context, id uint, event string, now time.Time) (bool, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	ad, ok := r.s.data.ads[id]
+	if !ok {
+		return false, nil
+	}
+	reached := func(t *time.Time) bool { return t != nil && !t.After(now) }
+	switch event {
+	case models.EventAdExpired:
+		if !reached(ad.ExpiresAt) || ad.ExpiredAt != nil {
+			return false, nil
+		}
+		ad.Status, ad.ExpiredAt = "inactive", &now
+	case models.EventAdActivated:
+		if !reached(ad.GoLiveAt) || ad.ActivatedAt != nil || reached(ad.ExpiresAt) {
+			return false, nil
+		}
+		ad.Status, ad.ActivatedAt = "active", &now
+	default:
+		return false, fmt.Errorf("未知的状态切换事件 %q", event)
+	}
+	r.s.touch("advertisements", &ad.Model)
+	r.s.data.ads[id] = ad
+	return true, nil
+}
+
+func (r *adRepository) Each(ctx context.Context, fn func(models.Advertisement) error) error {
+	r.s.mu.RLock()
+	ads := make([]models.Advertisement, 0, len(r.s.data.ads))
+	for _, ad := range r.s.data.ads {
+		ads = append(ads, ad)
+	}
+	r.s.mu.RUnlock()
+
+	sort.Slice(ads, func(i, j int) bool { return ads[i].ID < ads[j].ID })
+	for _, ad := range ads {
+		if err := fn(ad); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *adRepository) Lock(ctx context.Context, id uint) error {
+	return nil
+}
@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+type apiKeyRepository struct {
+	s *Store
+}
+
+func (r *apiKeyRepository) List(ctx context.Context, opts repository.ListOptions) ([]models.APIKey, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	keys := make([]models.APIKey, 0, len(r.s.data.apiKeys))
+	for _, key := range r.s.data.apiKeys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ID > keys[j].ID
+	})
+	return page(keys, opts), int64(len(keys)), nil
+}
+
+func (r *apiKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*models.APIKey, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	for _, key := range r.s.data.apiKeys {
+		if key.Prefix == prefix {
+			return &key, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *models.APIKey) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for _, existing := range r.s.data.apiKeys {
+		if existing.Prefix == key.Prefix {
+			return repository.ErrDuplicate
+		}
+	}
+	now := r.s.now()
+	key.ID = r.s.nextID("api_keys")
+	key.CreatedAt = now
+	key.UpdatedAt = now
+	r.s.data.apiKeys[key.ID] = *key
+	return nil
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, id uint, at time.Time) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	key, ok := r.s.data.apiKeys[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	if key.RevokedAt == nil {
+		key.RevokedAt = &at
+		key.UpdatedAt = r.s.now()
+		r.s.data.apiKeys[id] = key
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) Touch(ctx context.Context, id uint, at time.Time, ip string) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if key, ok := r.s.data.apiKeys[id]; ok {
+		key.LastUsedAt = &at
+		key.LastUsedIP = ip
+		r.s.data.apiKeys[id] = key
+	}
+	return nil
+}
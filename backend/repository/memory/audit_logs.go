@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+type auditLogRepository struct {
+	s *Store
+}
+
+func (r *auditLogRepository) List(ctx context.Context, opts repository.ListOptions) ([]models.AdminAuditLog, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	entries := make([]models.AdminAuditLog, 0, len(r.s.data.auditLogs))
+	for _, entry := range r.s.data.auditLogs {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID > entries[j].ID
+	})
+	return page(entries, opts), int64(len(entries)), nil
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, entry *models.AdminAuditLog) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	entry.ID = r.s.nextID("admin_audit_logs")
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = r.s.now()
+	}
+	r.s.data.auditLogs[entry.ID] = *entry
+	return nil
+}
@@ -0,0 +1,179 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+type rateCardRepository struct {
+	s *Store
+}
+
+func (r *rateCardRepository) List(ctx context.Context, opts repository.ListOptions) ([]models.RateCard, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	cards := make([]models.RateCard, 0, len(r.s.data.rateCards))
+	for _, card := range r.s.data.rateCards {
+		cards = append(cards, card)
+	}
+	sort.Slice(cards, func(i, j int) bool {
+		return cards[i].Name < cards[j].Name
+	})
+	return page(cards, opts), int64(len(cards)), nil
+}
+
+func (r *rateCardRepository) Get(ctx context.Context, id uint) (*models.RateCard, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	card, ok := r.s.data.rateCards[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &card, nil
+}
+
+func (r *rateCardRepository) Create(ctx context.Context, card *models.RateCard) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.nameTaken(card.Name, 0) {
+		return repository.ErrDuplicate
+	}
+	now := r.s.now()
+	card.ID = r.s.nextID("rate_cards")
+	card.CreatedAt = now
+	card.UpdatedAt = now
+	r.s.data.rateCards[card.ID] = *card
+	return nil
+}
+
+func (r *rateCardRepository) Save(ctx context.Context, card *models.RateCard) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.nameTaken(card.Name, card.ID) {
+		return repository.ErrDuplicate
+	}
+	card.UpdatedAt = r.s.now()
+	r.s.data.rateCards[card.ID] = *card
+	return nil
+}
+
+func (r *rateCardRepository) Delete(ctx context.Context, id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.data.rateCards[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(r.s.data.rateCards, id)
+	for invoiceID, invoice := range r.s.data.invoices {
+		if invoice.RateCardID != nil && *invoice.RateCardID == id {
+			invoice.RateCardID = nil
+			r.s.data.invoices[invoiceID] = invoice
+		}
+	}
+	return nil
+}
+
+// nameTaken 判断名称是否已被 exceptID 以外的费率卡使用，调用方需持有锁
+func (r *rateCardRepository) nameTaken(name string, exceptID uint) bool {
+	for _, existing := range r.s.data.rateCards {
+		if existing.Name == name && existing.ID != exceptID {
+			return true
+		}
+	}
+	return false
+}
+
+type invoiceRepository struct {
+	s *Store
+}
+
+func (r *invoiceRepository) List(ctx context.Context, advertisementID uint, opts repository.ListOptions) ([]models.Invoice, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	invoices := make([]models.Invoice, 0, len(r.s.data.invoices))
+	for _, invoice := range r.s.data.invoices {
+		if advertisementID == 0 || invoice.AdvertisementID == advertisementID {
+			invoices = append(invoices, invoice)
+		}
+	}
+	sort.Slice(invoices, func(i, j int) bool {
+		if invoices[i].CreatedAt.Equal(invoices[j].CreatedAt) {
+			return invoices[i].ID > invoices[j].ID
+		}
+		return invoices[i].CreatedAt.After(invoices[j].CreatedAt)
+	})
+	return page(invoices, opts), int64(len(invoices)), nil
+}
+
+func (r *invoiceRepository) Get(ctx context.Context, id uint) (*models.Invoice, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	invoice, ok := r.s.data.invoices[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	invoice.Lines = []models.InvoiceLine{}
+	for _, line := range r.s.data.invoiceLines {
+		if line.InvoiceID == id {
+			invoice.Lines = append(invoice.Lines, line)
+		}
+	}
+	sort.Slice(invoice.Lines, func(i, j int) bool { return invoice.Lines[i].BuildingID < invoice.Lines[j].BuildingID })
+	return &invoice, nil
+}
+
+func (r *invoiceRepository) Create(ctx context.Context, invoice *models.Invoice) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	invoice.ID = r.s.nextID("invoices")
+	invoice.CreatedAt = now
+	invoice.UpdatedAt = now
+	for i := range invoice.Lines {
+		line := &invoice.Lines[i]
+		line.ID = r.s.nextID("invoice_lines")
+		line.InvoiceID = invoice.ID
+		r.s.data.invoiceLines[line.ID] = *line
+	}
+	stored := *invoice
+	stored.Lines = nil
+	r.s.data.invoices[invoice.ID] = stored
+	return nil
+}
+
+func (r *invoiceRepository) Save(ctx context.Context, invoice *models.Invoice) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	invoice.UpdatedAt = r.s.now()
+	stored := *invoice
+	stored.Lines = nil
+	r.s.data.invoices[invoice.ID] = stored
+	return nil
+}
+
+func (r *invoiceRepository) Overlapping(ctx context.Context, advertisementID uint, from, to string) ([]models.Invoice, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	invoices := []models.Invoice{}
+	for _, invoice := range r.s.data.invoices {
+		if invoice.AdvertisementID == advertisementID && invoice.Status != models.InvoiceVoid &&
+			invoice.PeriodStart <= to && invoice.PeriodEnd >= from {
+			invoices = append(invoices, invoice)
+		}
+	}
+	sort.Slice(invoices, func(i, j int) bool { return invoices[i].ID < invoices[j].ID })
+	return invoices, nil
+}
@@ -0,0 +1,189 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"gorm.io/gorm"
+)
+
+type buildingRepository struct {
+	s *Store
+}
+
+func (r *buildingRepository) List(ctx context.Context, opts repository.ListOptions) ([]models.Building, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	buildings := make([]models.Building, 0, len(r.s.data.buildings))
+	for _, building := range r.s.data.buildings {
+		buildings = append(buildings, building)
+	}
+	sortByCreatedAt(buildings, func(b models.Building) gorm.Model { return b.Model }, opts.Desc)
+	return page(buildings, opts), int64(len(buildings)), nil
+}
+
+func (r *buildingRepository) Get(ctx context.Context, id uint) (*models.Building, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	building, ok := r.s.data.buildings[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &building, nil
+}
+
+func (r *buildingRepository) GetWithPlacements(ctx context.Context, id uint) (*models.Building, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	building, ok := r.s.data.buildings[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	building.AdvertisementBuildings = []models.AdvertisementBuilding{}
+	for key, placement := range r.s.data.placements {
+		if key.buildingID != id {
+			continue
+		}
+		placement.Advertisement = r.s.data.ads[key.advertisementID]
+		placement.Building = r.s.data.buildings[key.buildingID]
+		building.AdvertisementBuildings = append(building.AdvertisementBuildings, placement)
+	}
+	sort.Slice(building.AdvertisementBuildings, func(i, j int) bool {
+		return building.AdvertisementBuildings[i].AdvertisementID < building.AdvertisementBuildings[j].AdvertisementID
+	})
+	return &building, nil
+}
+
+func (r *buildingRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Building, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	buildings := []models.Building{}
+	seen := make(map[uint]struct{}, len(ids))
+	for _, id := range ids {
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		if building, ok := r.s.data.buildings[id]; ok {
+			buildings = append(buildings, building)
+		}
+	}
+	sort.Slice(buildings, func(i, j int) bool { return buildings[i].ID < buildings[j].ID })
+	return buildings, nil
+}
+
+func (r *buildingRepository) ExistingNames(ctx context.Context, names []string) ([]string, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	wanted := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		wanted[name] = struct{}{}
+	}
+	existing := []string{}
+	for _, building := range r.s.data.buildings {
+		if _, ok := wanted[building.Name]; ok {
+			existing = append(existing, building.Name)
+		}
+	}
+	return existing, nil
+}
+
+func (r *buildingRepository) Create(ctx context.Context, building *models.Building) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.nameTaken(building.Name, 0) {
+		return repository.ErrDuplicate
+	}
+	r.s.touch("buildings", &building.Model)
+	stored := *building
+	stored.AdvertisementBuildings = nil
+	r.s.data.buildings[building.ID] = stored
+	return nil
+}
+
+func (r *buildingRepository) Save(ctx context.Context, building *models.Building) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.nameTaken(building.Name, building.ID) {
+		return repository.ErrDuplicate
+	}
+	r.s.touch("buildings", &building.Model)
+	stored := *building
+	stored.AdvertisementBuildings = nil
+	r.s.data.buildings[building.ID] = stored
+	return nil
+}
+
+// nameTaken 检查名称是否已被其他大厦使用，调用方需持有锁
+func (r *buildingRepository) nameTaken(name string, exceptID uint) bool {
+	for id, building := range r.s.data.buildings {
+		if id != exceptID && building.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *buildingRepository) Delete(ctx context.Context, id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for key := range r.s.data.placements {
+		if key.buildingID == id {
+			delete(r.s.data.placements, key)
+		}
+	}
+	for manifestID, manifest := range r.s.data.manifests {
+		if manifest.BuildingID == id {
+			delete(r.s.data.manifests, manifestID)
+		}
+	}
+	for key := range r.s.data.noticeLinks {
+		if key.buildingID == id {
+			delete(r.s.data.noticeLinks, key)
+		}
+	}
+	for ruleID, rule := range r.s.data.exclusivity {
+		if rule.BuildingID == id {
+			delete(r.s.data.exclusivity, ruleID)
+		}
+	}
+	for key := range r.s.data.traffic {
+		if key.buildingID == id {
+			delete(r.s.data.traffic, key)
+		}
+	}
+	delete(r.s.data.buildings, id)
+	return nil
+}
+
+// Lock 内存版的事务串行执行，无需加锁
+func (r *buildingRepository) Lock(ctx context.Context, ids []uint) error {
+	return nil
+}
+
+func (r *buildingRepository) Each(ctx context.Context, fn func(models.Building) error) error {
+	r.s.mu.RLock()
+	buildings := make([]models.Building, 0, len(r.s.data.buildings))
+	for _, building := range r.s.data.buildings {
+		buildings = append(buildings, building)
+	}
+	r.s.mu.RUnlock()
+
+	sort.Slice(buildings, func(i, j int) bool { return buildings[i].ID < buildings[j].ID })
+	for _, building := range buildings {
+		if err := fn(building); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+type calendarRepository struct {
+	s *Store
+}
+
+func (r *calendarRepository) List(ctx context.Context, opts repository.ListOptions) ([]models.Calendar, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	calendars := make([]models.Calendar, 0, len(r.s.data.calendars))
+	for _, calendar := range r.s.data.calendars {
+		calendars = append(calendars, calendar)
+	}
+	sort.Slice(calendars, func(i, j int) bool {
+		return calendars[i].Name < calendars[j].Name
+	})
+	return page(calendars, opts), int64(len(calendars)), nil
+}
+
+func (r *calendarRepository) Get(ctx context.Context, id uint) (*models.Calendar, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	calendar, ok := r.s.data.calendars[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &calendar, nil
+}
+
+func (r *calendarRepository) Create(ctx context.Context, calendar *models.Calendar) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.nameTaken(calendar.Name, 0) {
+		return repository.ErrDuplicate
+	}
+	now := r.s.now()
+	calendar.ID = r.s.nextID("calendars")
+	calendar.CreatedAt = now
+	calendar.UpdatedAt = now
+	r.s.data.calendars[calendar.ID] = *calendar
+	return nil
+}
+
+func (r *calendarRepository) Save(ctx context.Context, calendar *models.Calendar) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.nameTaken(calendar.Name, calendar.ID) {
+		return repository.ErrDuplicate
+	}
+	calendar.UpdatedAt = r.s.now()
+	r.s.data.calendars[calendar.ID] = *calendar
+	return nil
+}
+
+func (r *calendarRepository) Delete(ctx context.Context, id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.data.calendars[id]; !ok {
+		return repository.ErrNotFound
+	}
+	for eventID, event := range r.s.data.events {
+		if event.CalendarID == id {
+			delete(r.s.data.events, eventID)
+		}
+	}
+	delete(r.s.data.calendars, id)
+	return nil
+}
+
+func (r *calendarRepository) ListEvents(ctx context.Context, calendarID uint) ([]models.CalendarEvent, error) {
+	return r.EventsFor(ctx, []uint{calendarID})
+}
+
+func (r *calendarRepository) EventsFor(ctx context.Context, calendarIDs []uint) ([]models.CalendarEvent, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	events := []models.CalendarEvent{}
+	for _, event := range r.s.data.events {
+		if slices.Contains(calendarIDs, event.CalendarID) {
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].StartDate != events[j].StartDate {
+			return events[i].StartDate < events[j].StartDate
+		}
+		return events[i].ID < events[j].ID
+	})
+	return events, nil
+}
+
+func (r *calendarRepository) CreateEvent(ctx context.Context, event *models.CalendarEvent) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	event.ID = r.s.nextID("calendar_events")
+	event.CreatedAt = r.s.now()
+	r.s.data.events[event.ID] = *event
+	return nil
+}
+
+func (r *calendarRepository) DeleteEvent(ctx context.Context, calendarID, eventID uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	event, ok := r.s.data.events[eventID]
+	if !ok || event.CalendarID != calendarID {
+		return repository.ErrNotFound
+	}
+	delete(r.s.data.events, eventID)
+	return nil
+}
+
+func (r *calendarRepository) ReplaceEvents(ctx context.Context, calendarID uint, events []models.CalendarEvent) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for eventID, event := range r.s.data.events {
+		if event.CalendarID == calendarID {
+			delete(r.s.data.events, eventID)
+		}
+	}
+	now := r.s.now()
+	for i := range events {
+		events[i].ID = r.s.nextID("calendar_events")
+		events[i].CalendarID = calendarID
+		events[i].CreatedAt = now
+		r.s.data.events[events[i].ID] = events[i]
+	}
+	return nil
+}
+
+// nameTaken 判断名称是否已被 exceptID 以外的日历使用，调用方需持有锁
+func (r *calendarRepository) nameTaken(name string, exceptID uint) bool {
+	for _, existing := range r.s.data.calendars {
+		if existing.Name == name && existing.ID != exceptID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+type daypartRepository struct {
+	s *Store
+}
+
+func (r *daypartRepository) List(ctx context.Context, opts repository.ListOptions) ([]models.Daypart, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	dayparts := make([]models.Daypart, 0, len(r.s.data.dayparts))
+	for _, daypart := range r.s.data.dayparts {
+		dayparts = append(dayparts, daypart)
+	}
+	sort.Slice(dayparts, func(i, j int) bool {
+		return dayparts[i].Name < dayparts[j].Name
+	})
+	return page(dayparts, opts), int64(len(dayparts)), nil
+}
+
+func (r *daypartRepository) Get(ctx context.Context, id uint) (*models.Daypart, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	daypart, ok := r.s.data.dayparts[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &daypart, nil
+}
+
+func (r *daypartRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Daypart, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	dayparts := []models.Daypart{}
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if daypart, ok := r.s.data.dayparts[id]; ok && !seen[id] {
+			seen[id] = true
+			dayparts = append(dayparts, daypart)
+		}
+	}
+	sort.Slice(dayparts, func(i, j int) bool { return dayparts[i].ID < dayparts[j].ID })
+	return dayparts, nil
+}
+
+func (r *daypartRepository) Create(ctx context.Context, daypart *models.Daypart) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.nameTaken(daypart.Name, 0) {
+		return repository.ErrDuplicate
+	}
+	now := r.s.now()
+	daypart.ID = r.s.nextID("dayparts")
+	daypart.CreatedAt = now
+	daypart.UpdatedAt = now
+	r.s.data.dayparts[daypart.ID] = *daypart
+	return nil
+}
+
+func (r *daypartRepository) Save(ctx context.Context, daypart *models.Daypart) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.nameTaken(daypart.Name, daypart.ID) {
+		return repository.ErrDuplicate
+	}
+	daypart.UpdatedAt = r.s.now()
+	r.s.data.dayparts[daypart.ID] = *daypart
+	return nil
+}
+
+func (r *daypartRepository) Delete(ctx context.Context, id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.data.dayparts[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(r.s.data.dayparts, id)
+	return nil
+}
+
+func (r *daypartRepository) UsingCalendar(ctx context.Context, calendarID uint) ([]uint, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	ids := []uint{}
+	for _, daypart := range r.s.data.dayparts {
+		if daypart.UsesCalendar(calendarID) {
+			ids = append(ids, daypart.ID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+func (r *daypartRepository) Placements(ctx context.Context, id uint) ([]models.AdvertisementBuilding, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	placements := []models.AdvertisementBuilding{}
+	for _, placement := range r.s.data.placements {
+		if placement.DaypartID != nil && *placement.DaypartID == id {
+			placements = append(placements, placement)
+		}
+	}
+	sort.Slice(placements, func(i, j int) bool {
+		if placements[i].BuildingID != placements[j].BuildingID {
+			return placements[i].BuildingID < placements[j].BuildingID
+		}
+		return placements[i].AdvertisementID < placements[j].AdvertisementID
+	})
+	return placements, nil
+}
+
+// nameTaken 判断名称是否已被 exceptID 以外的分时规则使用，调用方需持有锁
+func (r *daypartRepository) nameTaken(name string, exceptID uint) bool {
+	for _, existing := range r.s.data.dayparts {
+		if existing.Name == name && existing.ID != exceptID {
+			return true
+		}
+	}
+	return false
+}
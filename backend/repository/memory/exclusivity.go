@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+type exclusivityRepository struct {
+	s *Store
+}
+
+func (r *exclusivityRepository) ListByBuildings(ctx context.Context, buildingIDs []uint) ([]models.ExclusivityRule, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	rules := []models.ExclusivityRule{}
+	for _, rule := range r.s.data.exclusivity {
+		if slices.Contains(buildingIDs, rule.BuildingID) {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].BuildingID != rules[j].BuildingID {
+			return rules[i].BuildingID < rules[j].BuildingID
+		}
+		return rules[i].Category < rules[j].Category
+	})
+	return rules, nil
+}
+
+func (r *exclusivityRepository) Get(ctx context.Context, buildingID, id uint) (*models.ExclusivityRule, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	rule, ok := r.s.data.exclusivity[id]
+	if !ok || rule.BuildingID != buildingID {
+		return nil, repository.ErrNotFound
+	}
+	return &rule, nil
+}
+
+func (r *exclusivityRepository) Create(ctx context.Context, rule *models.ExclusivityRule) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.categoryTaken(rule.BuildingID, rule.Category, 0) {
+		return repository.ErrDuplicate
+	}
+	now := r.s.now()
+	rule.ID = r.s.nextID("exclusivity_rules")
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+	r.s.data.exclusivity[rule.ID] = *rule
+	return nil
+}
+
+func (r *exclusivityRepository) Save(ctx context.Context, rule *models.ExclusivityRule) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.categoryTaken(rule.BuildingID, rule.Category, rule.ID) {
+		return repository.ErrDuplicate
+	}
+	rule.UpdatedAt = r.s.now()
+	r.s.data.exclusivity[rule.ID] = *rule
+	return nil
+}
+
+func (r *exclusivityRepository) Delete(ctx context.Context, buildingID, id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if rule, ok := r.s.data.exclusivity[id]; !ok || rule.BuildingID != buildingID {
+		return repository.ErrNotFound
+	}
+	delete(r.s.data.exclusivity, id)
+	return nil
+}
+
+// categoryTaken 判断大厦是否已有 exceptID 以外的同类别规则，调用方需持有锁
+func (r *exclusivityRepository) categoryTaken(buildingID uint, category string, exceptID uint) bool {
+	for _, existing := range r.s.data.exclusivity {
+		if existing.BuildingID == buildingID && existing.Category == category && existing.ID != exceptID {
+			return true
+		}
+	}
+	return false
+}
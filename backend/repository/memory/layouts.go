@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+type layoutRepository struct {
+	s *Store
+}
+
+func (r *layoutRepository) List(ctx context.Context, opts repository.ListOptions) ([]models.Layout, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	layouts := make([]models.Layout, 0, len(r.s.data.layouts))
+	for _, layout := range r.s.data.layouts {
+		layouts = append(layouts, layout)
+	}
+	sort.Slice(layouts, func(i, j int) bool {
+		return layouts[i].Name < layouts[j].Name
+	})
+	return page(layouts, opts), int64(len(layouts)), nil
+}
+
+func (r *layoutRepository) Get(ctx context.Context, id uint) (*models.Layout, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	layout, ok := r.s.data.layouts[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &layout, nil
+}
+
+func (r *layoutRepository) Create(ctx context.Context, layout *models.Layout) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.nameTaken(layout.Name, 0) {
+		return repository.ErrDuplicate
+	}
+	now := r.s.now()
+	layout.ID = r.s.nextID("layouts")
+	layout.CreatedAt = now
+	layout.UpdatedAt = now
+	r.s.data.layouts[layout.ID] = *layout
+	return nil
+}
+
+func (r *layoutRepository) Save(ctx context.Context, layout *models.Layout) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if r.nameTaken(layout.Name, layout.ID) {
+		return repository.ErrDuplicate
+	}
+	layout.UpdatedAt = r.s.now()
+	r.s.data.layouts[layout.ID] = *layout
+	return nil
+}
+
+func (r *layoutRepository) Delete(ctx context.Context, id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.data.layouts[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(r.s.data.layouts, id)
+	return nil
+}
+
+func (r *layoutRepository) BuildingIDs(ctx context.Context, id uint) ([]uint, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	ids := []uint{}
+	for _, building := range r.s.data.buildings {
+		if building.LayoutID != nil && *building.LayoutID == id {
+			ids = append(ids, building.ID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// nameTaken 判断名称是否已被 exceptID 以外的布局使用，调用方需持有锁
+func (r *layoutRepository) nameTaken(name string, exceptID uint) bool {
+	for _, existing := range r.s.data.layouts {
+		if existing.Name == name && existing.ID != exceptID {
+			return true
+		}
+	}
+	return false
+}
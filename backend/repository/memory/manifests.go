@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+type manifestRepository struct {
+	s *Store
+}
+
+func (r *manifestRepository) Latest(ctx context.Context, buildingID uint) (*models.PlaylistManifest, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var latest *models.PlaylistManifest
+	for _, manifest := range r.s.data.manifests {
+		if manifest.BuildingID == buildingID && (latest == nil || manifest.Version > latest.Version) {
+			latest = &manifest
+		}
+	}
+	if latest == nil {
+		return nil, repository.ErrNotFound
+	}
+	return latest, nil
+}
+
+func (r *manifestRepository) Get(ctx context.Context, buildingID uint, version int) (*models.PlaylistManifest, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	for _, manifest := range r.s.data.manifests {
+		if manifest.BuildingID == buildingID && manifest.Version == version {
+			return &manifest, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *manifestRepository) Create(ctx context.Context, manifest *models.PlaylistManifest) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for _, existing := range r.s.data.manifests {
+		if existing.BuildingID == manifest.BuildingID && existing.Version == manifest.Version {
+			return repository.ErrDuplicate
+		}
+	}
+	manifest.ID = r.s.nextID("playlist_manifests")
+	if manifest.CreatedAt.IsZero() {
+		manifest.CreatedAt = r.s.now()
+	}
+	r.s.data.manifests[manifest.ID] = *manifest
+	return nil
+}
+
+func (r *manifestRepository) Prune(ctx context.Context, buildingID uint, keep int) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	latest := 0
+	for _, manifest := range r.s.data.manifests {
+		if manifest.BuildingID == buildingID && manifest.Version > latest {
+			latest = manifest.Version
+		}
+	}
+	for id, manifest := range r.s.data.manifests {
+		if manifest.BuildingID == buildingID && manifest.Version <= latest-keep {
+			delete(r.s.data.manifests, id)
+		}
+	}
+	return nil
+}
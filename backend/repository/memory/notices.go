@@ -0,0 +1,179 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"gorm.io/gorm"
+)
+
+type noticeRepository struct {
+	s *Store
+}
+
+func (r *noticeRepository) List(ctx context.Context, opts repository.ListOptions) ([]models.Notice, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	notices := make([]models.Notice, 0, len(r.s.data.notices))
+	for _, notice := range r.s.data.notices {
+		notices = append(notices, notice)
+	}
+	sortByCreatedAt(notices, func(n models.Notice) gorm.Model { return gorm.Model{ID: n.ID, CreatedAt: n.CreatedAt} }, opts.Desc)
+	return page(notices, opts), int64(len(notices)), nil
+}
+
+func (r *noticeRepository) Get(ctx context.Context, id uint) (*models.Notice, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	notice, ok := r.s.data.notices[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &notice, nil
+}
+
+func (r *noticeRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Notice, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	notices := []models.Notice{}
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if notice, ok := r.s.data.notices[id]; ok && !seen[id] {
+			seen[id] = true
+			notices = append(notices, notice)
+		}
+	}
+	sort.Slice(notices, func(i, j int) bool { return notices[i].ID < notices[j].ID })
+	return notices, nil
+}
+
+func (r *noticeRepository) Create(ctx context.Context, notice *models.Notice) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	notice.ID = r.s.nextID("notices")
+	notice.CreatedAt = now
+	notice.UpdatedAt = now
+	r.s.data.notices[notice.ID] = *notice
+	return nil
+}
+
+func (r *noticeRepository) Save(ctx context.Context, notice *models.Notice) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.data.notices[notice.ID]; !ok {
+		return repository.ErrNotFound
+	}
+	notice.UpdatedAt = r.s.now()
+	r.s.data.notices[notice.ID] = *notice
+	return nil
+}
+
+func (r *noticeRepository) Delete(ctx context.Context, id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.data.notices[id]; !ok {
+		return repository.ErrNotFound
+	}
+	for key := range r.s.data.noticeLinks {
+		if key.noticeID == id {
+			delete(r.s.data.noticeLinks, key)
+		}
+	}
+	delete(r.s.data.notices, id)
+	return nil
+}
+
+func (r *noticeRepository) Link(ctx context.Context, link *models.BuildingNotice) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	key := buildingNoticeKey{noticeID: link.NoticeID, buildingID: link.BuildingID}
+	if _, exists := r.s.data.noticeLinks[key]; exists {
+		return repository.ErrDuplicate
+	}
+	link.CreatedAt = r.s.now()
+	r.s.data.noticeLinks[key] = *link
+	return nil
+}
+
+func (r *noticeRepository) UnlinkFromBuilding(ctx context.Context, buildingID uint, noticeIDs []uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for _, noticeID := range noticeIDs {
+		delete(r.s.data.noticeLinks, buildingNoticeKey{noticeID: noticeID, buildingID: buildingID})
+	}
+	return nil
+}
+
+func (r *noticeRepository) UnlinkFromNotice(ctx context.Context, noticeID uint, buildingIDs []uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for _, buildingID := range buildingIDs {
+		delete(r.s.data.noticeLinks, buildingNoticeKey{noticeID: noticeID, buildingID: buildingID})
+	}
+	return nil
+}
+
+func (r *noticeRepository) ListByBuilding(ctx context.Context, buildingID uint) ([]models.BuildingNotice, error) {
+	links := r.links(func(key buildingNoticeKey) bool { return key.buildingID == buildingID })
+	sort.Slice(links, func(i, j int) bool { return links[i].NoticeID < links[j].NoticeID })
+	return links, nil
+}
+
+func (r *noticeRepository) ListByNotice(ctx context.Context, noticeID uint) ([]models.BuildingNotice, error) {
+	links := r.links(func(key buildingNoticeKey) bool { return key.noticeID == noticeID })
+	sort.Slice(links, func(i, j int) bool { return links[i].BuildingID < links[j].BuildingID })
+	return links, nil
+}
+
+func (r *noticeRepository) ActiveForBuilding(ctx context.Context, buildingID uint, now time.Time) ([]models.Notice, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	notices := []models.Notice{}
+	for key := range r.s.data.noticeLinks {
+		if key.buildingID != buildingID {
+			continue
+		}
+		if notice, ok := r.s.data.notices[key.noticeID]; ok && notice.ActiveAt(now) {
+			notices = append(notices, notice)
+		}
+	}
+	sort.Slice(notices, func(i, j int) bool {
+		a, b := notices[i], notices[j]
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		if !a.StartsAt.Equal(b.StartsAt) {
+			return a.StartsAt.After(b.StartsAt)
+		}
+		return a.ID < b.ID
+	})
+	return notices, nil
+}
+
+// links 返回满足条件的关联记录
+func (r *noticeRepository) links(match func(buildingNoticeKey) bool) []models.BuildingNotice {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	links := []models.BuildingNotice{}
+	for key, link := range r.s.data.noticeLinks {
+		if match(key) {
+			links = append(links, link)
+		}
+	}
+	return links
+}
@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+type placementRepository struct {
+	s *Store
+}
+
+func (r *placementRepository) Create(ctx context.Context, placement *models.AdvertisementBuilding) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	key := placementKey{advertisementID: placement.AdvertisementID, buildingID: placement.BuildingID}
+	if _, exists := r.s.data.placements[key]; exists {
+		return repository.ErrDuplicate
+	}
+	r.s.data.placements[key] = stripPlacement(*placement)
+	return nil
+}
+
+func (r *placementRepository) Get(ctx context.Context, advertisementID, buildingID uint) (*models.AdvertisementBuilding, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	placement, ok := r.s.data.placements[placementKey{advertisementID: advertisementID, buildingID: buildingID}]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &placement, nil
+}
+
+func (r *placementRepository) Save(ctx context.Context, placement *models.AdvertisementBuilding) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	key := placementKey{advertisementID: placement.AdvertisementID, buildingID: placement.BuildingID}
+	if _, exists := r.s.data.placements[key]; !exists {
+		return nil
+	}
+	r.s.data.placements[key] = stripPlacement(*placement)
+	return nil
+}
+
+func (r *placementRepository) ListByBuilding(ctx context.Context, buildingID uint) ([]models.AdvertisementBuilding, error) {
+	return r.list(func(key placementKey) bool { return key.buildingID == buildingID }), nil
+}
+
+func (r *placementRepository) ListByAdvertisement(ctx context.Context, advertisementID uint) ([]models.AdvertisementBuilding, error) {
+	return r.list(func(key placementKey) bool { return key.advertisementID == advertisementID }), nil
+}
+
+func (r *placementRepository) DeleteFromBuilding(ctx context.Context, buildingID uint, advertisementIDs []uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for _, id := range advertisementIDs {
+		delete(r.s.data.placements, placementKey{advertisementID: id, buildingID: buildingID})
+	}
+	return nil
+}
+
+func (r *placementRepository) DeleteFromAdvertisement(ctx context.Context, advertisementID uint, buildingIDs []uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for _, id := range buildingIDs {
+		delete(r.s.data.placements, placementKey{advertisementID: advertisementID, buildingID: id})
+	}
+	return nil
+}
+
+func (r *placementRepository) EachDetail(ctx context.Context, fn func(repository.PlacementDetail) error) error {
+	r.s.mu.RLock()
+	details := make([]repository.PlacementDetail, 0, len(r.s.data.placements))
+	for key, placement := range r.s.data.placements {
+		ad, adOK := r.s.data.ads[key.advertisementID]
+		building, buildingOK := r.s.data.buildings[key.buildingID]
+		if !adOK || !buildingOK {
+			continue
+		}
+		details = append(details, repository.PlacementDetail{
+			AdvertisementID:     ad.ID,
+			AdvertisementTitle:  ad.Title,
+			AdvertisementStatus: ad.Status,
+			VideoDuration:       ad.VideoDuration,
+			BuildingID:          building.ID,
+			BuildingName:        building.Name,
+			BlgID:               building.BuildingID,
+			Address:             building.Address,
+			PlayDuration:        placement.PlayDuration,
+		})
+	}
+	r.s.mu.RUnlock()
+
+	sort.Slice(details, func(i, j int) bool {
+		if details[i].AdvertisementID != details[j].AdvertisementID {
+			return details[i].AdvertisementID < details[j].AdvertisementID
+		}
+		return details[i].BuildingID < details[j].BuildingID
+	})
+	for _, detail := range details {
+		if err := fn(detail); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// list 返回满足条件的投放记录，按广告、大厦 ID 排序
+func (r *placementRepository) list(match func(placementKey) bool) []models.AdvertisementBuilding {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	placements := []models.AdvertisementBuilding{}
+	for key, placement := range r.s.data.placements {
+		if match(key) {
+			placements = append(placements, placement)
+		}
+	}
+	sort.Slice(placements, func(i, j int) bool {
+		if placements[i].AdvertisementID != placements[j].AdvertisementID {
+			return placements[i].AdvertisementID < placements[j].AdvertisementID
+		}
+		return placements[i].BuildingID < placements[j].BuildingID
+	})
+	return placements
+}
+
+// stripPlacement 去掉预加载的关联对象，只保存关联表本身的字段
+func stripPlacement(placement models.AdvertisementBuilding) models.AdvertisementBuilding {
+	placement.Advertisement = models.Advertisement{}
+	placement.Building = models.Building{}
+	return placement
+}
@@ -0,0 +1,340 @@
+package memory
+
+// memory 提供 repository.Store 的内存实现，用于在没有 Postgres 的情况下测试 HTTP 层
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+	"gorm.io/gorm"
+)
+
+// placementKey 投放记录的联合键
+type placementKey struct {
+	advertisementID uint
+	buildingID      uint
+}
+
+// buildingNoticeKey 通知关联的联合键
+type buildingNoticeKey struct {
+	noticeID   uint
+	buildingID uint
+}
+
+// trafficKey 人流量的联合键，小时以 Unix 秒表示
+type trafficKey struct {
+	buildingID uint
+	hour       int64
+}
+
+// data 保存所有表的数据，事务回滚时整体恢复
+type data struct {
+	sequences     map[string]uint
+	ads           map[uint]models.Advertisement
+	buildings     map[uint]models.Building
+	placements    map[placementKey]models.AdvertisementBuilding
+	admins        map[uint]models.Administrator
+	auditLogs     map[uint]models.AdminAuditLog
+	recoveryCodes map[uint]models.AdminRecoveryCode
+	apiKeys       map[uint]models.APIKey
+	webhooks      map[uint]models.WebhookSubscription
+	deliveries    map[uint]models.WebhookDelivery
+	manifests     map[uint]models.PlaylistManifest
+	layouts       map[uint]models.Layout
+	notices       map[uint]models.Notice
+	noticeLinks   map[buildingNoticeKey]models.BuildingNotice
+	calendars     map[uint]models.Calendar
+	events        map[uint]models.CalendarEvent
+	dayparts      map[uint]models.Daypart
+	exclusivity   map[uint]models.ExclusivityRule
+	traffic       map[trafficKey]models.TrafficCount
+	rateCards     map[uint]models.RateCard
+	invoices      map[uint]models.Invoice // 不包含明细
+	invoiceLines  map[uint]models.InvoiceLine
+}
+
+func newData() *data {
+	return &data{
+		sequences:     make(map[string]uint),
+		ads:           make(map[uint]models.Advertisement),
+		buildings:     make(map[uint]models.Building),
+		placements:    make(map[placementKey]models.AdvertisementBuilding),
+		admins:        make(map[uint]models.Administrator),
+		auditLogs:     make(map[uint]models.AdminAuditLog),
+		recoveryCodes: make(map[uint]models.AdminRecoveryCode),
+		apiKeys:       make(map[uint]models.APIKey),
+		webhooks:      make(map[uint]models.WebhookSubscription),
+		deliveries:    make(map[uint]models.WebhookDelivery),
+		manifests:     make(map[uint]models.PlaylistManifest),
+		layouts:       make(map[uint]models.Layout),
+		notices:       make(map[uint]models.Notice),
+		noticeLinks:   make(map[buildingNoticeKey]models.BuildingNotice),
+		calendars:     make(map[uint]models.Calendar),
+		events:        make(map[uint]models.CalendarEvent),
+		dayparts:      make(map[uint]models.Daypart),
+		exclusivity:   make(map[uint]models.ExclusivityRule),
+		traffic:       make(map[trafficKey]models.TrafficCount),
+		rateCards:     make(map[uint]models.RateCard),
+		invoices:      make(map[uint]models.Invoice),
+		invoiceLines:  make(map[uint]models.InvoiceLine),
+	}
+}
+
+// clone 复制所有表，用于事务快照
+func (d *data) clone() *data {
+	c := &data{
+		sequences:     make(map[string]uint, len(d.sequences)),
+		ads:           make(map[uint]models.Advertisement, len(d.ads)),
+		buildings:     make(map[uint]models.Building, len(d.buildings)),
+		placements:    make(map[placementKey]models.AdvertisementBuilding, len(d.placements)),
+		admins:        make(map[uint]models.Administrator, len(d.admins)),
+		auditLogs:     make(map[uint]models.AdminAuditLog, len(d.auditLogs)),
+		recoveryCodes: make(map[uint]models.AdminRecoveryCode, len(d.recoveryCodes)),
+		apiKeys:       make(map[uint]models.APIKey, len(d.apiKeys)),
+		webhooks:      make(map[uint]models.WebhookSubscription, len(d.webhooks)),
+		deliveries:    make(map[uint]models.WebhookDelivery, len(d.deliveries)),
+		manifests:     make(map[uint]models.PlaylistManifest, len(d.manifests)),
+		layouts:       make(map[uint]models.Layout, len(d.layouts)),
+		notices:       make(map[uint]models.Notice, len(d.notices)),
+		noticeLinks:   make(map[buildingNoticeKey]models.BuildingNotice, len(d.noticeLinks)),
+		calendars:     make(map[uint]models.Calendar, len(d.calendars)),
+		events:        make(map[uint]models.CalendarEvent, len(d.events)),
+		dayparts:      make(map[uint]models.Daypart, len(d.dayparts)),
+		exclusivity:   make(map[uint]models.ExclusivityRule, len(d.exclusivity)),
+		traffic:       make(map[trafficKey]models.TrafficCount, len(d.traffic)),
+		rateCards:     make(map[uint]models.RateCard, len(d.rateCards)),
+		invoices:      make(map[uint]models.Invoice, len(d.invoices)),
+		invoiceLines:  make(map[uint]models.InvoiceLine, len(d.invoiceLines)),
+	}
+	for k, v := range d.sequences {
+		c.sequences[k] = v
+	}
+	for k, v := range d.ads {
+		c.ads[k] = v
+	}
+	for k, v := range d.buildings {
+		c.buildings[k] = v
+	}
+	for k, v := range d.placements {
+		c.placements[k] = v
+	}
+	for k, v := range d.admins {
+		c.admins[k] = v
+	}
+	for k, v := range d.auditLogs {
+		c.auditLogs[k] = v
+	}
+	for k, v := range d.recoveryCodes {
+		c.recoveryCodes[k] = v
+	}
+	for k, v := range d.apiKeys {
+		c.apiKeys[k] = v
+	}
+	for k, v := range d.webhooks {
+		c.webhooks[k] = v
+	}
+	for k, v := range d.deliveries {
+		c.deliveries[k] = v
+	}
+	for k, v := range d.manifests {
+		c.manifests[k] = v
+	}
+	for k, v := range d.layouts {
+		c.layouts[k] = v
+	}
+	for k, v := range d.notices {
+		c.notices[k] = v
+	}
+	for k, v := range d.noticeLinks {
+		c.noticeLinks[k] = v
+	}
+	for k, v := range d.calendars {
+		c.calendars[k] = v
+	}
+	for k, v := range d.events {
+		c.events[k] = v
+	}
+	for k, v := range d.dayparts {
+		c.dayparts[k] = v
+	}
+	for k, v := range d.exclusivity {
+		c.exclusivity[k] = v
+	}
+	for k, v := range d.traffic {
+		c.traffic[k] = v
+	}
+	for k, v := range d.rateCards {
+		c.rateCards[k] = v
+	}
+	for k, v := range d.invoices {
+		c.invoices[k] = v
+	}
+	for k, v := range d.invoiceLines {
+		c.invoiceLines[k] = v
+	}
+	return c
+}
+
+// Store 内存版 repository.Store，所有仓库共享同一份数据
+type Store struct {
+	mu   sync.RWMutex
+	txMu sync.Mutex
+	data *data
+	now  func() time.Time
+}
+
+var _ repository.Store = (*Store)(nil)
+
+// NewStore 创建空的内存 Store
+func NewStore() *Store {
+	return &Store{data: newData(), now: func() time.Time { return time.Now().UTC() }}
+}
+
+func (s *Store) Ads() repository.AdRepository {
+	return &adRepository{s: s}
+}
+
+func (s *Store) Buildings() repository.BuildingRepository {
+	return &buildingRepository{s: s}
+}
+
+func (s *Store) Placements() repository.PlacementRepository {
+	return &placementRepository{s: s}
+}
+
+func (s *Store) Admins() repository.AdminRepository {
+	return &adminRepository{s: s}
+}
+
+func (s *Store) AuditLogs() repository.AuditLogRepository {
+	return &auditLogRepository{s: s}
+}
+
+func (s *Store) APIKeys() repository.APIKeyRepository {
+	return &apiKeyRepository{s: s}
+}
+
+func (s *Store) Webhooks() repository.WebhookRepository {
+	return &webhookRepository{s: s}
+}
+
+func (s *Store) Manifests() repository.ManifestRepository {
+	return &manifestRepository{s: s}
+}
+
+func (s *Store) Layouts() repository.LayoutRepository {
+	return &layoutRepository{s: s}
+}
+
+func (s *Store) Notices() repository.NoticeRepository {
+	return &noticeRepository{s: s}
+}
+
+func (s *Store) Calendars() repository.CalendarRepository {
+	return &calendarRepository{s: s}
+}
+
+func (s *Store) Dayparts() repository.DaypartRepository {
+	return &daypartRepository{s: s}
+}
+
+func (s *Store) Exclusivity() repository.ExclusivityRepository {
+	return &exclusivityRepository{s: s}
+}
+
+func (s *Store) Traffic() repository.TrafficRepository {
+	return &trafficRepository{s: s}
+}
+
+func (s *Store) RateCards() repository.RateCardRepository {
+	return &rateCardRepository{s: s}
+}
+
+func (s *Store) Invoices() repository.InvoiceRepository {
+	return &invoiceRepository{s: s}
+}
+
+// Transaction 串行执行事务，fn 返回错误时将数据恢复到事务开始前的快照
+// 注意：事务期间其他 goroutine 的非事务写入在回滚时同样会被丢弃
+func (s *Store) Transaction(ctx context.Context, fn func(tx repository.Store) error) error {
+	s.txMu.Lock()
+	defer s.txMu.Unlock()
+
+	s.mu.RLock()
+	snapshot := s.data.clone()
+	s.mu.RUnlock()
+
+	if err := fn(s); err != nil {
+		s.mu.Lock()
+		s.data = snapshot
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// TryLock 内存存储的事务已经串行执行，总是能获取
+func (s *Store) TryLock(ctx context.Context, key int64) (bool, error) {
+	return true, nil
+}
+
+// Lock 内存存储的事务已经串行执行，无需加锁
+func (s *Store) Lock(ctx context.Context, key int64) error {
+	return nil
+}
+
+// Ping 内存存储始终可用
+func (s *Store) Ping(ctx context.Context) error {
+	return nil
+}
+
+// nextID 按表分配自增 ID，调用方需持有写锁
+func (s *Store) nextID(table string) uint {
+	s.data.sequences[table]++
+	return s.data.sequences[table]
+}
+
+// touch 为新记录分配 ID 并设置创建和更新时间，调用方需持有写锁
+func (s *Store) touch(table string, m *gorm.Model) {
+	now := s.now()
+	if m.ID == 0 {
+		m.ID = s.nextID(table)
+	}
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	m.UpdatedAt = now
+}
+
+// page 截取分页数据
+func page[T any](items []T, opts repository.ListOptions) []T {
+	if opts.Offset >= len(items) {
+		return []T{}
+	}
+	end := len(items)
+	if opts.Limit > 0 && opts.Offset+opts.Limit < end {
+		end = opts.Offset + opts.Limit
+	}
+	return items[opts.Offset:end]
+}
+
+// sortByCreatedAt 按创建时间排序，时间相同时按 ID 排序
+func sortByCreatedAt[T any](items []T, model func(T) gorm.Model, desc bool) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := model(items[i]), model(items[j])
+		if a.CreatedAt.Equal(b.CreatedAt) {
+			if desc {
+				return a.ID > b.ID
+			}
+			return a.ID < b.ID
+		}
+		if desc {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+}
@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+)
+
+type trafficRepository struct {
+	s *Store
+}
+
+func (r *trafficRepository) List(ctx context.Context, buildingID uint, from, to time.Time) ([]models.TrafficCount, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	counts := []models.TrafficCount{}
+	for key, count := range r.s.data.traffic {
+		if key.buildingID == buildingID && !count.Hour.Before(from) && count.Hour.Before(to) {
+			counts = append(counts, count)
+		}
+	}
+	sort.Slice(counts, func(i, j int) bool { return counts[i].Hour.Before(counts[j].Hour) })
+	return counts, nil
+}
+
+func (r *trafficRepository) Upsert(ctx context.Context, counts []models.TrafficCount) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	for i := range counts {
+		count := &counts[i]
+		key := trafficKey{buildingID: count.BuildingID, hour: count.Hour.Unix()}
+		if existing, ok := r.s.data.traffic[key]; ok {
+			count.ID, count.CreatedAt = existing.ID, existing.CreatedAt
+		} else {
+			count.ID = r.s.nextID("traffic_counts")
+			count.CreatedAt = now
+		}
+		count.UpdatedAt = now
+		r.s.data.traffic[key] = *count
+	}
+	return nil
+}
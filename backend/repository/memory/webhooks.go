@@ -0,0 +1,177 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+type webhookRepository struct {
+	s *Store
+}
+
+func (r *webhookRepository) ListSubscriptions(ctx context.Context, opts repository.ListOptions) ([]models.WebhookSubscription, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	subs := make([]models.WebhookSubscription, 0, len(r.s.data.webhooks))
+	for _, sub := range r.s.data.webhooks {
+		subs = append(subs, sub)
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].ID > subs[j].ID
+	})
+	return page(subs, opts), int64(len(subs)), nil
+}
+
+func (r *webhookRepository) GetSubscription(ctx context.Context, id uint) (*models.WebhookSubscription, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	sub, ok := r.s.data.webhooks[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &sub, nil
+}
+
+func (r *webhookRepository) ActiveSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var subs []models.WebhookSubscription
+	for _, sub := range r.s.data.webhooks {
+		if sub.Active {
+			subs = append(subs, sub)
+		}
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].ID < subs[j].ID
+	})
+	return subs, nil
+}
+
+func (r *webhookRepository) CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	sub.ID = r.s.nextID("webhook_subscriptions")
+	sub.CreatedAt = now
+	sub.UpdatedAt = now
+	r.s.data.webhooks[sub.ID] = *sub
+	return nil
+}
+
+func (r *webhookRepository) SaveSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.data.webhooks[sub.ID]; !ok {
+		return repository.ErrNotFound
+	}
+	sub.UpdatedAt = r.s.now()
+	r.s.data.webhooks[sub.ID] = *sub
+	return nil
+}
+
+func (r *webhookRepository) DeleteSubscription(ctx context.Context, id uint) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.data.webhooks[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(r.s.data.webhooks, id)
+	for deliveryID, delivery := range r.s.data.deliveries {
+		if delivery.SubscriptionID == id {
+			delete(r.s.data.deliveries, deliveryID)
+		}
+	}
+	return nil
+}
+
+func (r *webhookRepository) EnqueueDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := r.s.now()
+	for i := range deliveries {
+		deliveries[i].ID = r.s.nextID("webhook_deliveries")
+		deliveries[i].CreatedAt = now
+		deliveries[i].UpdatedAt = now
+		r.s.data.deliveries[deliveries[i].ID] = deliveries[i]
+	}
+	return nil
+}
+
+func (r *webhookRepository) ListDeliveries(ctx context.Context, subscriptionID uint, opts repository.ListOptions) ([]models.WebhookDelivery, int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	deliveries := make([]models.WebhookDelivery, 0)
+	for _, delivery := range r.s.data.deliveries {
+		if delivery.SubscriptionID == subscriptionID {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].ID > deliveries[j].ID
+	})
+	return page(deliveries, opts), int64(len(deliveries)), nil
+}
+
+func (r *webhookRepository) GetDelivery(ctx context.Context, id uint) (*models.WebhookDelivery, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	delivery, ok := r.s.data.deliveries[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &delivery, nil
+}
+
+func (r *webhookRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]models.WebhookDelivery, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	var due []models.WebhookDelivery
+	for _, delivery := range r.s.data.deliveries {
+		if delivery.Status == models.DeliveryPending && !delivery.NextAttemptAt.After(now) {
+			due = append(due, delivery)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if due[i].NextAttemptAt.Equal(due[j].NextAttemptAt) {
+			return due[i].ID < due[j].ID
+		}
+		return due[i].NextAttemptAt.Before(due[j].NextAttemptAt)
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	for i := range due {
+		due[i].NextAttemptAt = leaseUntil
+		r.s.data.deliveries[due[i].ID] = due[i]
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].ID < due[j].ID
+	})
+	return due, nil
+}
+
+func (r *webhookRepository) UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, ok := r.s.data.deliveries[delivery.ID]; !ok {
+		return nil
+	}
+	delivery.UpdatedAt = r.s.now()
+	r.s.data.deliveries[delivery.ID] = *delivery
+	return nil
+}
@@ -0,0 +1,349 @@
+package repository
+
+// repository 定义数据访问接口，控制器通过这些接口访问数据，
+// 生产环境使用基于 GORM 的实现，测试可使用 repository/memory 中的内存实现
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+)
+
+var (
+	// ErrNotFound 表示记录不存在
+	ErrNotFound = errors.New("记录未找到")
+	// ErrDuplicate 表示违反唯一约束
+	ErrDuplicate = errors.New("记录已存在")
+)
+
+// ListOptions 分页与排序参数
+type ListOptions struct {
+	Offset int
+	Limit  int
+	Desc   bool
+}
+
+// PlacementDetail 投放矩阵中的一行，包含广告与大厦的基本信息
+type PlacementDetail struct {
+	AdvertisementID     uint
+	AdvertisementTitle  string
+	AdvertisementStatus string
+	VideoDuration       int64
+	BuildingID          uint
+	BuildingName        string
+	BlgID               string
+	Address             string
+	PlayDuration        int64
+}
+
+// AdRepository 广告数据访问接口
+type AdRepository interface {
+	// List 按创建时间排序分页查询广告，同时返回总数
+	List(ctx context.Context, opts ListOptions) ([]models.Advertisement, int64, error)
+	// Get 查询单个广告，不加载关联
+	Get(ctx context.Context, id uint) (*models.Advertisement, error)
+	// GetWithPlacements 查询单个广告，并加载投放记录及其大厦
+	GetWithPlacements(ctx context.Context, id uint) (*models.Advertisement, error)
+	// FindByIDs 按 ID 批量查询广告并按 ID 升序返回，不存在的 ID 会被忽略
+	FindByIDs(ctx context.Context, ids []uint) ([]models.Advertisement, error)
+	Create(ctx context.Context, ad *models.Advertisement) error
+	Save(ctx context.Context, ad *models.Advertisement) error
+	// Delete 硬删除广告及其投放记录
+	Delete(ctx context.Context, id uint) error
+	// Each 按 ID 顺序逐条遍历所有广告，用于导出等大数据量场景
+	Each(ctx context.Context, fn func(models.Advertisement) error) error
+	// ListDue 按 ID 顺序返回 now 时需要切换状态的广告：已到期但未执行到期，
+	// 或已到上线时间、未执行上线且尚未到期，最多 limit 条
+	ListDue(ctx context.Context, now time.Time, limit int) ([]models.Advertisement, error)
+	// Transition 执行 event（ad.activated 或 ad.expired）对应的状态切换，只写入 status 与切换时间，
+	// 并按数据库中的当前值重新判断是否仍需切换；已不需要切换时返回 false
+	Transition(ctx context.Context, id uint, event string, now time.Time) (bool, error)
+	// Lock 在事务中锁定广告直到事务结束，用于串行化同一广告的开票与修改
+	Lock(ctx context.Context, id uint) error
+}
+
+// BuildingRepository 大厦数据访问接口
+type BuildingRepository interface {
+	// List 按创建时间排序分页查询大厦，同时返回总数
+	List(ctx context.Context, opts ListOptions) ([]models.Building, int64, error)
+	// Get 查询单个大厦，不加载关联
+	Get(ctx context.Context, id uint) (*models.Building, error)
+	// GetWithPlacements 查询单个大厦，并加载投放记录及其广告
+	GetWithPlacements(ctx context.Context, id uint) (*models.Building, error)
+	// FindByIDs 按 ID 批量查询大厦并按 ID 升序返回，不存在的 ID 会被忽略
+	FindByIDs(ctx context.Context, ids []uint) ([]models.Building, error)
+	// ExistingNames 返回 names 中已被使用的大厦名称
+	ExistingNames(ctx context.Context, names []string) ([]string, error)
+	Create(ctx context.Context, building *models.Building) error
+	Save(ctx context.Context, building *models.Building) error
+	// Delete 硬删除大厦及其投放记录
+	Delete(ctx context.Context, id uint) error
+	// Each 按 ID 顺序逐条遍历所有大厦
+	Each(ctx context.Context, fn func(models.Building) error) error
+	// Lock 在事务中按 ID 顺序锁定大厦直到事务结束，用于串行化同一大厦的排他规则检查
+	Lock(ctx context.Context, ids []uint) error
+}
+
+// PlacementRepository 广告与大厦关联（投放）数据访问接口
+type PlacementRepository interface {
+	// Create 创建关联，关联已存在时返回 ErrDuplicate
+	Create(ctx context.Context, placement *models.AdvertisementBuilding) error
+	Get(ctx context.Context, advertisementID, buildingID uint) (*models.AdvertisementBuilding, error)
+	Save(ctx context.Context, placement *models.AdvertisementBuilding) error
+	ListByBuilding(ctx context.Context, buildingID uint) ([]models.AdvertisementBuilding, error)
+	ListByAdvertisement(ctx context.Context, advertisementID uint) ([]models.AdvertisementBuilding, error)
+	// DeleteFromBuilding 删除大厦与指定广告的关联
+	DeleteFromBuilding(ctx context.Context, buildingID uint, advertisementIDs []uint) error
+	// DeleteFromAdvertisement 删除广告与指定大厦的关联
+	DeleteFromAdvertisement(ctx context.Context, advertisementID uint, buildingIDs []uint) error
+	// EachDetail 按广告、大厦 ID 顺序逐条遍历投放矩阵
+	EachDetail(ctx context.Context, fn func(PlacementDetail) error) error
+}
+
+// AdminRepository 管理员数据访问接口
+type AdminRepository interface {
+	// List 按用户名排序分页查询管理员，同时返回总数
+	List(ctx context.Context, opts ListOptions) ([]models.Administrator, int64, error)
+	GetByID(ctx context.Context, id uint) (*models.Administrator, error)
+	GetByUsername(ctx context.Context, username string) (*models.Administrator, error)
+	// Count 返回管理员总数
+	Count(ctx context.Context) (int64, error)
+	// CountByRole 返回指定角色的管理员数量
+	CountByRole(ctx context.Context, role string) (int64, error)
+	// Create 创建管理员，用户名已存在时返回 ErrDuplicate
+	Create(ctx context.Context, admin *models.Administrator) error
+	Save(ctx context.Context, admin *models.Administrator) error
+	// Delete 硬删除管理员
+	Delete(ctx context.Context, id uint) error
+	// RecordLoginFailure 原子地增加登录失败次数，达到 threshold 时锁定到 lockedUntil 并清零计数，返回更新后的管理员
+	RecordLoginFailure(ctx context.Context, id uint, threshold int, lockedUntil time.Time) (*models.Administrator, error)
+	// RecordLoginSuccess 登录成功后清除失败次数与锁定，并记录登录时间
+	RecordLoginSuccess(ctx context.Context, id uint, at time.Time) error
+	// UpdatePassword 更新密码哈希与改密时间，同时解除锁定，changedAt 之前签发的令牌随之失效
+	UpdatePassword(ctx context.Context, id uint, hash string, changedAt time.Time) error
+	// SetTOTP 设置两步验证密钥与启用状态，并清零已使用的时间步；secret 为空表示关闭
+	SetTOTP(ctx context.Context, id uint, secret string, enabled bool) error
+	// ConsumeTOTPStep 原子地记录验证码所在的时间步，step 不大于已使用的时间步时返回 false（重放）
+	ConsumeTOTPStep(ctx context.Context, id uint, step int64) (bool, error)
+	// ReplaceRecoveryCodes 用新的恢复码哈希替换管理员全部恢复码，hashes 为空时只删除
+	ReplaceRecoveryCodes(ctx context.Context, id uint, hashes []string) error
+	// UseRecoveryCode 原子地将未使用的恢复码标记为已使用，不存在或已使用时返回 false
+	UseRecoveryCode(ctx context.Context, id uint, hash string, at time.Time) (bool, error)
+}
+
+// APIKeyRepository API 密钥
+type APIKeyRepository interface {
+	// List 按创建时间倒序分页查询，包含已吊销的密钥
+	List(ctx context.Context, opts ListOptions) ([]models.APIKey, int64, error)
+	GetByPrefix(ctx context.Context, prefix string) (*models.APIKey, error)
+	// Create 创建密钥，前缀冲突时返回 ErrDuplicate
+	Create(ctx context.Context, key *models.APIKey) error
+	// Revoke 吊销密钥，密钥不存在时返回 ErrNotFound，已吊销时保持原吊销时间
+	Revoke(ctx context.Context, id uint, at time.Time) error
+	// Touch 记录最近一次使用的时间与来源 IP
+	Touch(ctx context.Context, id uint, at time.Time, ip string) error
+}
+
+// WebhookRepository 事件推送订阅与投递记录
+type WebhookRepository interface {
+	// ListSubscriptions 按创建时间倒序分页查询订阅
+	ListSubscriptions(ctx context.Context, opts ListOptions) ([]models.WebhookSubscription, int64, error)
+	GetSubscription(ctx context.Context, id uint) (*models.WebhookSubscription, error)
+	// ActiveSubscriptions 返回所有启用的订阅
+	ActiveSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error)
+	CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	SaveSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	// DeleteSubscription 删除订阅及其投递记录，订阅不存在时返回 ErrNotFound
+	DeleteSubscription(ctx context.Context, id uint) error
+	// EnqueueDeliveries 批量写入待投递记录
+	EnqueueDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error
+	// ListDeliveries 按 ID 倒序分页查询订阅的投递记录
+	ListDeliveries(ctx context.Context, subscriptionID uint, opts ListOptions) ([]models.WebhookDelivery, int64, error)
+	GetDelivery(ctx context.Context, id uint) (*models.WebhookDelivery, error)
+	// ClaimDue 领取最多 limit 条到期的待投递记录，并将其下次尝试时间推迟到 leaseUntil，
+	// 投递进程中途退出时记录会在 leaseUntil 之后被重新领取，多个实例不会同时投递同一记录
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]models.WebhookDelivery, error)
+	// UpdateDelivery 保存投递状态、尝试次数与最近一次结果，记录已随订阅删除时不报错
+	UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+}
+
+// LayoutRepository 屏幕布局模板
+type LayoutRepository interface {
+	// List 按名称排序分页查询布局，同时返回总数
+	List(ctx context.Context, opts ListOptions) ([]models.Layout, int64, error)
+	Get(ctx context.Context, id uint) (*models.Layout, error)
+	// Create 创建布局，名称已存在时返回 ErrDuplicate
+	Create(ctx context.Context, layout *models.Layout) error
+	// Save 保存布局，名称已存在时返回 ErrDuplicate
+	Save(ctx context.Context, layout *models.Layout) error
+	// Delete 删除布局，布局不存在时返回 ErrNotFound
+	Delete(ctx context.Context, id uint) error
+	// BuildingIDs 返回使用该布局的大厦 ID
+	BuildingIDs(ctx context.Context, id uint) ([]uint, error)
+}
+
+// NoticeRepository 大厦通知及其与大厦的关联
+type NoticeRepository interface {
+	// List 按创建时间排序分页查询通知，同时返回总数
+	List(ctx context.Context, opts ListOptions) ([]models.Notice, int64, error)
+	Get(ctx context.Context, id uint) (*models.Notice, error)
+	// FindByIDs 按 ID 批量查询通知，不存在的 ID 会被忽略
+	FindByIDs(ctx context.Context, ids []uint) ([]models.Notice, error)
+	Create(ctx context.Context, notice *models.Notice) error
+	Save(ctx context.Context, notice *models.Notice) error
+	// Delete 删除通知及其关联，通知不存在时返回 ErrNotFound
+	Delete(ctx context.Context, id uint) error
+
+	// Link 关联通知与大厦，关联已存在时返回 ErrDuplicate
+	Link(ctx context.Context, link *models.BuildingNotice) error
+	// UnlinkFromBuilding 删除大厦与指定通知的关联
+	UnlinkFromBuilding(ctx context.Context, buildingID uint, noticeIDs []uint) error
+	// UnlinkFromNotice 删除通知与指定大厦的关联
+	UnlinkFromNotice(ctx context.Context, noticeID uint, buildingIDs []uint) error
+	ListByBuilding(ctx context.Context, buildingID uint) ([]models.BuildingNotice, error)
+	ListByNotice(ctx context.Context, noticeID uint) ([]models.BuildingNotice, error)
+	// ActiveForBuilding 返回大厦在 now 时刻有效的通知，按优先级从高到低、生效时间从新到旧排序
+	ActiveForBuilding(ctx context.Context, buildingID uint, now time.Time) ([]models.Notice, error)
+}
+
+// CalendarRepository 命名日历及其事件
+type CalendarRepository interface {
+	// List 按名称排序分页查询日历，同时返回总数
+	List(ctx context.Context, opts ListOptions) ([]models.Calendar, int64, error)
+	Get(ctx context.Context, id uint) (*models.Calendar, error)
+	// Create 创建日历，名称已存在时返回 ErrDuplicate
+	Create(ctx context.Context, calendar *models.Calendar) error
+	// Save 保存日历，名称已存在时返回 ErrDuplicate
+	Save(ctx context.Context, calendar *models.Calendar) error
+	// Delete 删除日历及其事件，日历不存在时返回 ErrNotFound
+	Delete(ctx context.Context, id uint) error
+
+	// ListEvents 按开始日期排序返回日历的全部事件
+	ListEvents(ctx context.Context, calendarID uint) ([]models.CalendarEvent, error)
+	// EventsFor 返回多个日历的全部事件
+	EventsFor(ctx context.Context, calendarIDs []uint) ([]models.CalendarEvent, error)
+	CreateEvent(ctx context.Context, event *models.CalendarEvent) error
+	// DeleteEvent 删除日历中的事件，事件不存在时返回 ErrNotFound
+	DeleteEvent(ctx context.Context, calendarID, eventID uint) error
+	// ReplaceEvents 用 events 替换日历的全部事件
+	ReplaceEvents(ctx context.Context, calendarID uint, events []models.CalendarEvent) error
+}
+
+// DaypartRepository 分时规则
+type DaypartRepository interface {
+	// List 按名称排序分页查询分时规则，同时返回总数
+	List(ctx context.Context, opts ListOptions) ([]models.Daypart, int64, error)
+	Get(ctx context.Context, id uint) (*models.Daypart, error)
+	// FindByIDs 按 ID 批量查询分时规则，不存在的 ID 会被忽略
+	FindByIDs(ctx context.Context, ids []uint) ([]models.Daypart, error)
+	// Create 创建分时规则，名称已存在时返回 ErrDuplicate
+	Create(ctx context.Context, daypart *models.Daypart) error
+	// Save 保存分时规则，名称已存在时返回 ErrDuplicate
+	Save(ctx context.Context, daypart *models.Daypart) error
+	// Delete 删除分时规则，规则不存在时返回 ErrNotFound
+	Delete(ctx context.Context, id uint) error
+	// UsingCalendar 返回引用了该日历的分时规则 ID
+	UsingCalendar(ctx context.Context, calendarID uint) ([]uint, error)
+	// Placements 返回使用该分时规则的投放
+	Placements(ctx context.Context, id uint) ([]models.AdvertisementBuilding, error)
+}
+
+// ExclusivityRepository 大厦的广告类别排他规则
+type ExclusivityRepository interface {
+	// ListByBuildings 返回多个大厦的全部规则，按大厦 ID、类别排序
+	ListByBuildings(ctx context.Context, buildingIDs []uint) ([]models.ExclusivityRule, error)
+	// Get 返回大厦的规则，规则不存在或不属于该大厦时返回 ErrNotFound
+	Get(ctx context.Context, buildingID, id uint) (*models.ExclusivityRule, error)
+	// Create 创建规则，大厦已有该类别的规则时返回 ErrDuplicate
+	Create(ctx context.Context, rule *models.ExclusivityRule) error
+	// Save 保存规则，大厦已有该类别的其他规则时返回 ErrDuplicate
+	Save(ctx context.Context, rule *models.ExclusivityRule) error
+	// Delete 删除大厦的规则，规则不存在或不属于该大厦时返回 ErrNotFound
+	Delete(ctx context.Context, buildingID, id uint) error
+}
+
+// TrafficRepository 大厦每小时的人流量
+type TrafficRepository interface {
+	// List 返回大厦在 [from, to) 内的人流量，按小时排序
+	List(ctx context.Context, buildingID uint, from, to time.Time) ([]models.TrafficCount, error)
+	// Upsert 按大厦与小时写入人流量，已存在时覆盖人数
+	Upsert(ctx context.Context, counts []models.TrafficCount) error
+}
+
+// RateCardRepository 费率卡
+type RateCardRepository interface {
+	// List 按名称排序分页查询费率卡，同时返回总数
+	List(ctx context.Context, opts ListOptions) ([]models.RateCard, int64, error)
+	Get(ctx context.Context, id uint) (*models.RateCard, error)
+	// Create 创建费率卡，名称已存在时返回 ErrDuplicate
+	Create(ctx context.Context, card *models.RateCard) error
+	// Save 保存费率卡，名称已存在时返回 ErrDuplicate
+	Save(ctx context.Context, card *models.RateCard) error
+	// Delete 删除费率卡，已开具发票的 rate_card_id 置空，费率卡不存在时返回 ErrNotFound
+	Delete(ctx context.Context, id uint) error
+}
+
+// InvoiceRepository 发票及其明细
+type InvoiceRepository interface {
+	// List 按创建时间倒序分页查询发票，advertisementID 不为 0 时只返回该广告的发票；不包含明细，同时返回总数
+	List(ctx context.Context, advertisementID uint, opts ListOptions) ([]models.Invoice, int64, error)
+	// Get 返回发票及其明细
+	Get(ctx context.Context, id uint) (*models.Invoice, error)
+	// Create 创建发票及其明细
+	Create(ctx context.Context, invoice *models.Invoice) error
+	// Save 保存发票，不修改明细
+	Save(ctx context.Context, invoice *models.Invoice) error
+	// Overlapping 返回广告计费期与 [from, to] 重叠且未作废的发票，日期为 YYYY-MM-DD
+	Overlapping(ctx context.Context, advertisementID uint, from, to string) ([]models.Invoice, error)
+}
+
+// ManifestRepository 大厦播放清单历史版本
+type ManifestRepository interface {
+	// Latest 返回大厦最新的清单版本，没有版本时返回 ErrNotFound
+	Latest(ctx context.Context, buildingID uint) (*models.PlaylistManifest, error)
+	// Get 返回大厦指定的清单版本
+	Get(ctx context.Context, buildingID uint, version int) (*models.PlaylistManifest, error)
+	// Create 写入新版本，版本号已存在时返回 ErrDuplicate
+	Create(ctx context.Context, manifest *models.PlaylistManifest) error
+	// Prune 只保留大厦最新的 keep 个版本
+	Prune(ctx context.Context, buildingID uint, keep int) error
+}
+
+// AuditLogRepository 管理员审计日志
+type AuditLogRepository interface {
+	// List 按时间倒序分页查询
+	List(ctx context.Context, opts ListOptions) ([]models.AdminAuditLog, int64, error)
+	Create(ctx context.Context, entry *models.AdminAuditLog) error
+}
+
+// Store 汇总所有数据访问接口，并提供事务支持
+type Store interface {
+	Ads() AdRepository
+	Buildings() BuildingRepository
+	Placements() PlacementRepository
+	Admins() AdminRepository
+	AuditLogs() AuditLogRepository
+	APIKeys() APIKeyRepository
+	Webhooks() WebhookRepository
+	Manifests() ManifestRepository
+	Layouts() LayoutRepository
+	Notices() NoticeRepository
+	Calendars() CalendarRepository
+	Dayparts() DaypartRepository
+	Exclusivity() ExclusivityRepository
+	Traffic() TrafficRepository
+	RateCards() RateCardRepository
+	Invoices() InvoiceRepository
+	// Transaction 在事务中执行 fn，fn 返回错误时回滚，tx 中的仓库共享同一事务
+	Transaction(ctx context.Context, fn func(tx Store) error) error
+	// TryLock 尝试获取以 key 标识的事务级排他锁，已被其他事务持有时立即返回 false。
+	// 锁在事务结束时释放，必须在 Transaction 的 tx 上调用
+	TryLock(ctx context.Context, key int64) (bool, error)
+	// Lock 获取以 key 标识的事务级排他锁，已被其他事务持有时等待其释放。
+	// 锁在事务结束时释放，必须在 Transaction 的 tx 上调用
+	Lock(ctx context.Context, key int64) error
+	// Ping 检查底层存储是否可用，供就绪检查使用
+	Ping(ctx context.Context) error
+}
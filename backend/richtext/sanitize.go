@@ -0,0 +1,156 @@
+package richtext
+
+// richtext 清理通知等富文本内容
+//
+// 只保留白名单中的排版标签，去掉全部属性（链接只保留 http、https、mailto、tel 地址），
+// script、style 等标签连同内容一起丢弃，未闭合的标签在末尾补齐，
+// 播放端可以直接渲染清理后的 HTML
+
+import (
+	"html"
+	"net/url"
+	"slices"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// allowed 保留的标签
+var allowed = map[atom.Atom]bool{
+	atom.P: true, atom.Br: true, atom.Div: true, atom.Span: true,
+	atom.Strong: true, atom.B: true, atom.Em: true, atom.I: true, atom.U: true, atom.S: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true,
+	atom.Ul: true, atom.Ol: true, atom.Li: true, atom.Blockquote: true,
+	atom.A: true,
+}
+
+// dropped 连同内容一起丢弃的标签
+var dropped = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Iframe: true, atom.Object: true,
+	atom.Embed: true, atom.Noscript: true, atom.Template: true, atom.Title: true,
+}
+
+// void 没有结束标签的元素，丢弃时不计入嵌套层数
+var void = map[atom.Atom]bool{atom.Embed: true}
+
+// linkSchemes 链接允许的协议
+var linkSchemes = []string{"http", "https", "mailto", "tel"}
+
+// Sanitize 返回只包含白名单标签的 HTML
+func Sanitize(input string) string {
+	var out strings.Builder
+	var open []atom.Atom // 已输出且尚未闭合的标签
+	skip := 0            // 位于被丢弃标签内部的层数
+
+	z := xhtml.NewTokenizer(strings.NewReader(input))
+	for {
+		switch z.Next() {
+		case xhtml.ErrorToken:
+			for i := len(open) - 1; i >= 0; i-- {
+				out.WriteString("</" + open[i].String() + ">")
+			}
+			return strings.TrimSpace(out.String())
+
+		case xhtml.TextToken:
+			if skip == 0 {
+				out.WriteString(html.EscapeString(string(z.Text())))
+			}
+
+		case xhtml.StartTagToken, xhtml.SelfClosingTagToken:
+			token := z.Token()
+			if dropped[token.DataAtom] {
+				if token.Type != xhtml.SelfClosingTagToken && !void[token.DataAtom] {
+					skip++
+				}
+				continue
+			}
+			if skip > 0 || !allowed[token.DataAtom] {
+				continue
+			}
+			if token.DataAtom == atom.Br {
+				out.WriteString("<br>")
+				continue
+			}
+			out.WriteString(startTag(token))
+			open = append(open, token.DataAtom)
+
+		case xhtml.EndTagToken:
+			token := z.Token()
+			if dropped[token.DataAtom] {
+				if skip > 0 {
+					skip--
+				}
+				continue
+			}
+			if skip > 0 || !allowed[token.DataAtom] {
+				continue
+			}
+			// 闭合到最近的同名标签，没有打开过的结束标签直接忽略
+			i := slices.Index(reversed(open), token.DataAtom)
+			if i < 0 {
+				continue
+			}
+			for n := len(open) - 1; n >= len(open)-1-i; n-- {
+				out.WriteString("</" + open[n].String() + ">")
+			}
+			open = open[:len(open)-1-i]
+		}
+	}
+}
+
+// Text 返回去掉全部标签后的纯文本，用于判断内容是否为空
+func Text(input string) string {
+	var out strings.Builder
+	skip := 0
+	z := xhtml.NewTokenizer(strings.NewReader(input))
+	for {
+		switch z.Next() {
+		case xhtml.ErrorToken:
+			return strings.Join(strings.Fields(out.String()), " ")
+		case xhtml.TextToken:
+			if skip == 0 {
+				out.Write(z.Text())
+			}
+		case xhtml.StartTagToken:
+			if token := z.Token(); dropped[token.DataAtom] {
+				if !void[token.DataAtom] {
+					skip++
+				}
+			} else {
+				out.WriteByte(' ')
+			}
+		case xhtml.EndTagToken:
+			if token := z.Token(); dropped[token.DataAtom] && skip > 0 {
+				skip--
+			} else {
+				out.WriteByte(' ')
+			}
+		}
+	}
+}
+
+// startTag 输出不带属性的开始标签，链接只保留安全的 href
+func startTag(token xhtml.Token) string {
+	if token.DataAtom != atom.A {
+		return "<" + token.DataAtom.String() + ">"
+	}
+	for _, attr := range token.Attr {
+		if attr.Key != "href" {
+			continue
+		}
+		u, err := url.Parse(strings.TrimSpace(attr.Val))
+		if err != nil || !slices.Contains(linkSchemes, strings.ToLower(u.Scheme)) {
+			break
+		}
+		return `<a href="` + html.EscapeString(u.String()) + `" rel="noopener noreferrer">`
+	}
+	return "<a>"
+}
+
+// reversed 返回倒序的副本
+func reversed(tags []atom.Atom) []atom.Atom {
+	result := slices.Clone(tags)
+	slices.Reverse(result)
+	return result
+}
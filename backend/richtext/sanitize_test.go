@@ -0,0 +1,100 @@
+package richtext
+
+import "testing"
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		// 白名单
+		{"保留白名单标签", "<p>通知<strong>重要</strong><br/>内容</p>", "<p>通知<strong>重要</strong><br>内容</p>"},
+		{"去掉不在白名单的标签但保留文字", "<table><tr><td>电梯</td></tr></table><font>检修</font>", "电梯检修"},
+		{"去掉全部属性", `<p class="x" style="color:red" id="y">文字</p>`, "<p>文字</p>"},
+
+		// 丢弃的标签
+		{"script 连同内容丢弃", "前<script>alert(1)</script>后", "前后"},
+		{"style 连同内容丢弃", "<style>p{display:none}</style><p>正文</p>", "<p>正文</p>"},
+		{"大写的 script", "<SCRIPT>alert(1)</SCRIPT>正文", "正文"},
+		{"script 内嵌 script", "<script><script>alert(1)</script>正文</script>", "正文"},
+		{"script 内的标签不输出", "<script><p>a</p></script><p>b</p>", "<p>b</p>"},
+		{"iframe 内嵌 iframe", "<iframe><iframe>a</iframe>b</iframe>c", "bc"},
+		{"object 内嵌 embed", "<object><embed src=x.swf><p>a</p></object><p>b</p>", "<p>b</p>"},
+		{"未闭合的 script 吞掉后续内容", "<p>a</p><script>alert(1)", "<p>a</p>"},
+		{"自闭合的丢弃标签不影响后续内容", "<embed src=x.swf />正文", "正文"},
+		{"丢弃标签内的白名单标签不计入未闭合", "<object><p><strong>a</object>b", "b"},
+
+		// 链接
+		{"保留 https 链接", `<a href="https://example.com/a?b=1&amp;c=2" target="_blank">链接</a>`, `<a href="https://example.com/a?b=1&amp;c=2" rel="noopener noreferrer">链接</a>`},
+		{"保留 mailto 与 tel", `<a href="mailto:pm@example.com">邮件</a><a href="tel:+85212345678">电话</a>`, `<a href="mailto:pm@example.com" rel="noopener noreferrer">邮件</a><a href="tel:+85212345678" rel="noopener noreferrer">电话</a>`},
+		{"协议不区分大小写", `<a href="HTTPS://example.com">a</a>`, `<a href="https://example.com" rel="noopener noreferrer">a</a>`},
+		{"javascript 链接", `<a href="javascript:alert(1)">a</a>`, "<a>a</a>"},
+		{"大小写混合的 javascript", `<a href="JaVaScRiPt:alert(1)">a</a>`, "<a>a</a>"},
+		{"前后空白的 javascript", "<a href=\" \tjavascript:alert(1) \">a</a>", "<a>a</a>"},
+		{"中间夹制表符的 javascript", "<a href=\"java\tscript:alert(1)\">a</a>", "<a>a</a>"},
+		{"实体编码的制表符", `<a href="jav&#x09;ascript:alert(1)">a</a>`, "<a>a</a>"},
+		{"实体编码的协议", `<a href="&#106;avascript:alert(1)">a</a>`, "<a>a</a>"},
+		{"控制字符前缀", "<a href=\"\x01javascript:alert(1)\">a</a>", "<a>a</a>"},
+		{"data 链接", `<a href="data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==">a</a>`, "<a>a</a>"},
+		{"vbscript 链接", `<a href="vbscript:msgbox(1)">a</a>`, "<a>a</a>"},
+		{"相对链接", `<a href="/admin">a</a>`, "<a>a</a>"},
+		{"没有 href", `<a name="top">a</a>`, "<a>a</a>"},
+		{"链接中的引号被转义", `<a href='https://example.com/"onmouseover="alert(1)'>a</a>`, `<a href="https://example.com/%22onmouseover=%22alert%281%29" rel="noopener noreferrer">a</a>`},
+
+		// 事件属性
+		{"去掉 on 属性", `<p onclick="alert(1)" onmouseover="alert(2)">a</p>`, "<p>a</p>"},
+		{"链接上的 on 属性", `<a href="https://example.com" onclick="alert(1)">a</a>`, `<a href="https://example.com" rel="noopener noreferrer">a</a>`},
+		{"不在白名单的标签上的 on 属性", `<img src=x onerror="alert(1)">a`, "a"},
+		{"svg onload", `<svg onload="alert(1)"><p>a</p></svg>`, "<p>a</p>"},
+
+		// 未闭合与交错的标签
+		{"末尾补齐未闭合的标签", "<p><strong><em>a", "<p><strong><em>a</em></strong></p>"},
+		{"交错的标签按顺序闭合", "<b><i>a</b>b</i>", "<b><i>a</i></b>b"},
+		{"列表中未闭合的 li", "<ul><li>a<li>b</ul>c", "<ul><li>a<li>b</li></li></ul>c"},
+		{"多余的结束标签被忽略", "</p>a</strong></div>", "a"},
+		{"不在白名单的结束标签不影响闭合", "<p>a</font>b</p>", "<p>ab</p>"},
+
+		// 注释与文档类型
+		{"去掉注释", "a<!-- 注释 -->b", "ab"},
+		{"去掉条件注释", "<!--[if IE]><script>alert(1)</script><![endif]-->a", "a"},
+		{"去掉 doctype", "<!DOCTYPE html><html><body><p>a</p></body></html>", "<p>a</p>"},
+
+		// 文本与实体
+		{"实体编码的文字保持编码", "&lt;script&gt;alert(1)&lt;/script&gt;", "&lt;script&gt;alert(1)&lt;/script&gt;"},
+		{"与号与引号", `A &amp; B "C" 'D'`, "A &amp; B &#34;C&#34; &#39;D&#39;"},
+		{"数字实体解码后重新编码", "&#60;b&#62;", "&lt;b&gt;"},
+		{"不转义的字符原样输出", "&copy; 2026 物业管理处", "© 2026 物业管理处"},
+		{"裸露的尖括号", "1 < 2 > 0", "1 &lt; 2 &gt; 0"},
+
+		{"去掉首尾空白", "  <p>a</p>\n", "<p>a</p>"},
+		{"空输入", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sanitize(tt.input); got != tt.want {
+				t.Errorf("Sanitize(%q)\n得到 %q\n期望 %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"<p>a</p><p>b</p>", "a b"},
+		{"<embed src=x.swf>a", "a"},
+		{"<script>alert(1)</script>", ""},
+		{"<p> </p><br>", ""},
+		{"a<!-- 注释 -->b", "ab"},
+		{"&lt;b&gt;", "<b>"},
+	}
+	for _, tt := range tests {
+		if got := Text(tt.input); got != tt.want {
+			t.Errorf("Text(%q) = %q，期望 %q", tt.input, got, tt.want)
+		}
+	}
+}
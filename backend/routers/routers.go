@@ -1,60 +1,285 @@
 package routers
 
 import (
+	"github.com/10240418/advertisement-management-system/backend/apierror"
+	"github.com/10240418/advertisement-management-system/backend/config"
 	"github.com/10240418/advertisement-management-system/backend/controllers"
+	"github.com/10240418/advertisement-management-system/backend/dayparting"
+	"github.com/10240418/advertisement-management-system/backend/logging"
+	"github.com/10240418/advertisement-management-system/backend/manifest"
+	"github.com/10240418/advertisement-management-system/backend/metrics"
 	"github.com/10240418/advertisement-management-system/backend/middleware"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/openapi"
+	"github.com/10240418/advertisement-management-system/backend/ratelimit"
+	"github.com/10240418/advertisement-management-system/backend/region"
+	"github.com/10240418/advertisement-management-system/backend/repository"
 	"github.com/gin-gonic/gin"
 )
 
-func SetupRouter() *gin.Engine {
-	r := gin.Default()
+// SetupRouter 创建各控制器并注册路由，数据访问通过 store 注入
+// limiter 保存限流状态，多实例部署时可替换为共享存储
+// draining 在服务开始优雅关闭时关闭，为 nil 表示不会关闭
+func SetupRouter(cfg *config.Config, store repository.Store, limiter ratelimit.Store, draining <-chan struct{}) *gin.Engine {
+	r := gin.New()
+	r.HandleMethodNotAllowed = true
+	r.NoRoute(apierror.NoRoute)
+	r.NoMethod(apierror.NoMethod)
+	r.Use(logging.Middleware(), apierror.Recovery(), metrics.Middleware())
+	apierror.RegisterFieldNames()
+
+	// 配置已在启动时校验，密钥无效说明调用方跳过了校验
+	signer, err := manifest.NewSigner(cfg.Manifest.SigningKey)
+	if err != nil {
+		panic(err)
+	}
+	location, err := cfg.Region.Location()
+	if err != nil {
+		panic(err)
+	}
+	regions := region.NewResolver(location, cfg.Region.DefaultLocale)
+	engine := dayparting.NewEngine(store, regions)
+
+	adController := controllers.NewAdController(store)
+	buildingController := controllers.NewBuildingController(store)
+	adminController := controllers.NewAdminController(store, limiter, cfg.Auth)
+	exportController := controllers.NewExportController(store, regions)
+	apiKeyController := controllers.NewAPIKeyController(store)
+	webhookController := controllers.NewWebhookController(store)
+	layoutController := controllers.NewLayoutController(store, engine)
+	noticeController := controllers.NewNoticeController(store, regions)
+	calendarController := controllers.NewCalendarController(store)
+	daypartController := controllers.NewDaypartController(store, engine)
+	previewController := controllers.NewPreviewController(store, engine, cfg.Playlist.LoopCapacity)
+	exclusivityController := controllers.NewExclusivityController(store)
+	trafficController := controllers.NewTrafficController(store, engine)
+	impressionController := controllers.NewImpressionController(store, engine, cfg.Playlist.LoopCapacity)
+	billingController := controllers.NewBillingController(store, engine)
+	manifestController := controllers.NewManifestController(store, manifest.NewService(store, signer, regions, cfg.Manifest.History))
+	uploadController := controllers.NewUploadController(controllers.NewFileService(cfg.OSS))
+	healthController := controllers.NewHealthController(store, draining)
+
+	// 监控路由：存活、就绪检查与 Prometheus 指标
+	r.GET("/healthz", healthController.Healthz)
+	r.GET("/readyz", healthController.Readyz)
+	r.GET("/metrics", metrics.Handler())
+
+	// 接口文档
+	r.GET("/api/openapi.json", openapi.Handler())
 
 	// 公共路由
-	r.POST("/api/admin/register", controllers.RegisterAdmin)
-	r.POST("/api/admin/login", controllers.LoginAdmin)
-	// 获取上传参数
-	r.POST("/api/upload/policy", controllers.GetUploadParams)
+	loginLimit := ratelimit.Middleware(limiter, "login", ratelimit.PerMinute(cfg.Auth.LoginPerIP), ratelimit.ByIP)
+	// 第一个管理员可匿名注册，之后只允许超级管理员创建
+	r.POST("/api/admin/register", loginLimit, middleware.OptionalAuth(store),
+		middleware.RequireSuperAdminTOTP(cfg.Auth.TOTP.RequireSuperAdmin, "/api/admins/2fa"), adminController.RegisterAdmin)
+	r.POST("/api/admin/login", loginLimit, adminController.LoginAdmin)
+	r.POST("/api/admin/login/2fa", loginLimit, adminController.VerifyLoginTOTP) // 两步验证的第二步
+	r.GET("/api/manifest/public-key", manifestController.GetManifestPublicKey)  // 播放端校验清单签名的公钥
 
 	// 受保护的路由组
 	protected := r.Group("/api")
-	protected.Use(middleware.AuthMiddleware()) // 应用认证中间件
+	protected.Use(
+		middleware.AuthMiddleware(store), // 应用认证中间件
+		ratelimit.Middleware(limiter, "api", ratelimit.PerMinute(cfg.Auth.APIPerUser), ratelimit.ByUser),
+		middleware.RequireSuperAdminTOTP(cfg.Auth.TOTP.RequireSuperAdmin, "/api/admins/2fa"),
+	)
 	{
+		// 获取上传参数，签发 OSS 上传策略需要登录
+		protected.POST("/upload/policy", middleware.RequireScope(models.ScopeUploadsWrite), uploadController.GetUploadParams)
+
+		// 广告与大厦路由也对 API 密钥开放，按请求方法要求 read 或 write 权限
 		// 广告路由
 		ads := protected.Group("/ads")
 		{
-			ads.GET("", controllers.GetAds)
-			ads.GET("/:id", controllers.GetAd)
-			ads.POST("", controllers.CreateAd)
-			ads.PUT("/:id", controllers.UpdateAd)
-			ads.DELETE("/:id", controllers.DeleteAd)
+			adRoutes := ads.Group("", middleware.ResourceScope("ads"))
+			adRoutes.GET("", adController.GetAds)
+			adRoutes.GET("/:id", adController.GetAd)
+			adRoutes.POST("", adController.CreateAd)
+			adRoutes.PUT("/:id", adController.UpdateAd)
+			adRoutes.DELETE("/:id", adController.DeleteAd)
 
 			// 新增的路由：管理广告与建筑的关联
-			ads.POST("/:id/buildings", controllers.AddBuildingsToAd)           // 添加建筑到广告
-			ads.DELETE("/:id/buildings", controllers.RemoveBuildingsFromAd)    // 删除建筑与广告的关联
-			ads.GET("/:id/buildings", controllers.GetBuildingsByAdvertisement) // 获取广告关联的建筑 IDs
+			adPlacements := ads.Group("/:id/buildings", middleware.ResourceScope("placements"))
+			adPlacements.POST("", adController.AddBuildingsToAd)           // 添加建筑到广告
+			adPlacements.DELETE("", adController.RemoveBuildingsFromAd)    // 删除建筑与广告的关联
+			adPlacements.GET("", adController.GetBuildingsByAdvertisement) // 获取广告关联的建筑 IDs
+
+			// 按各投放大厦的人流量估算广告每天的曝光数，?from=&to= 为 YYYY-MM-DD
+			ads.GET("/:id/impressions", middleware.RequireScope(models.ScopePlacementsRead), impressionController.GetAdImpressions)
+
+			// 按费率卡计算广告的费用但不开票，?rate_card_id=&from=&to= 均必填
+			ads.GET("/:id/charges", middleware.RequireScope(models.ScopeBillingRead), billingController.GetAdCharges)
 		}
 
 		// 大厦路由
 		buildings := protected.Group("/buildings")
 		{
-			buildings.GET("", controllers.GetBuildings)
-			buildings.GET("/:id", controllers.GetBuilding)
-			buildings.POST("", controllers.CreateBuilding)
-			buildings.PUT("/:id", controllers.UpdateBuilding)
-			buildings.DELETE("/:id", controllers.DeleteBuilding)
+			buildingRoutes := buildings.Group("", middleware.ResourceScope("buildings"))
+			buildingRoutes.GET("", buildingController.GetBuildings)
+			buildingRoutes.GET("/:id", buildingController.GetBuilding)
+			buildingRoutes.POST("", buildingController.CreateBuilding)
+			buildingRoutes.POST("/import", buildingController.ImportBuildings) // 通过 CSV/XLSX 批量导入大厦
+			buildingRoutes.PUT("/:id", buildingController.UpdateBuilding)
+			buildingRoutes.DELETE("/:id", buildingController.DeleteBuilding)
+			buildingRoutes.PUT("/:id/layout", layoutController.AssignBuildingLayout) // layout_id 为 null 时恢复全屏播放
 
 			// 新增的路由：管理建筑与广告的关联
-			buildings.POST("/:id/ads", controllers.AddAdsToBuilding)           // 添加广告到建筑
-			buildings.DELETE("/:id/ads", controllers.RemoveAdsFromBuilding)    // 删除广告与建筑的关联
-			buildings.GET("/:id/ads", controllers.GetAdvertisementsByBuilding) // 获取建筑关联的广告 IDs
+			buildingPlacements := buildings.Group("/:id/ads", middleware.ResourceScope("placements"))
+			buildingPlacements.POST("", adController.AddAdsToBuilding)           // 添加广告到建筑
+			buildingPlacements.DELETE("", adController.RemoveAdsFromBuilding)    // 删除广告与建筑的关联
+			buildingPlacements.GET("", adController.GetAdvertisementsByBuilding) // 获取建筑关联的广告 IDs
+
+			// 设置广告所在的布局区域
+			buildingPlacements.PUT("/:ad_id/zone", layoutController.UpdatePlacementZone)
+
+			// 设置广告使用的分时规则，daypart_id 为 null 时全天播放
+			buildingPlacements.PUT("/:ad_id/daypart", daypartController.AssignPlacementDaypart)
+
+			// 大厦通知，GET 需要 notices:read，其余需要 notices:write
+			buildingNotices := buildings.Group("/:id/notices", middleware.ResourceScope("notices"))
+			buildingNotices.POST("", noticeController.AddNoticesToBuilding)
+			buildingNotices.DELETE("", noticeController.RemoveNoticesFromBuilding)
+			buildingNotices.GET("", noticeController.GetNoticesByBuilding)
+			buildingNotices.GET("/active", noticeController.GetActiveNotices) // 当前有效的通知，按优先级排序
+
+			// 大厦布局及各区域的播放内容，与播放列表一样需要 placements:read 权限
+			buildings.GET("/:id/layout", middleware.RequireScope(models.ScopePlacementsRead), layoutController.GetBuildingLayout)
+
+			// 按分时规则判断各广告在某一时刻能否播放，?at=<RFC 3339 时间>
+			buildings.GET("/:id/eligibility", middleware.RequireScope(models.ScopePlacementsRead), daypartController.GetBuildingEligibility)
+
+			// 模拟一段时间内的播放时间线，?from=&to=，默认为大厦当地的明天
+			buildings.GET("/:id/preview", middleware.RequireScope(models.ScopePlacementsRead), previewController.GetBuildingPreview)
+
+			// 广告类别排他规则，投放时检查，GET 需要 placements:read，其余需要 placements:write
+			buildingExclusivity := buildings.Group("/:id/exclusivity-rules", middleware.ResourceScope("placements"))
+			buildingExclusivity.GET("", exclusivityController.ListExclusivityRules)
+			buildingExclusivity.POST("", exclusivityController.CreateExclusivityRule)
+			buildingExclusivity.PUT("/:rule_id", exclusivityController.UpdateExclusivityRule)
+			buildingExclusivity.DELETE("/:rule_id", exclusivityController.DeleteExclusivityRule)
+
+			// 每小时人流量，PUT 覆盖同一小时的数据，import 通过 CSV/XLSX 上传
+			buildingTraffic := buildings.Group("/:id/traffic", middleware.ResourceScope("buildings"))
+			buildingTraffic.GET("", trafficController.ListTraffic)
+			buildingTraffic.PUT("", trafficController.PutTraffic)
+			buildingTraffic.POST("/import", trafficController.ImportTraffic)
+
+			// 按人流量与投放的播放时长占比估算曝光数，?from=&to= 为大厦当地的 YYYY-MM-DD 日期
+			buildings.GET("/:id/impressions", middleware.RequireScope(models.ScopePlacementsRead), impressionController.GetBuildingImpressions)
+
+			// 离线播放清单，与播放列表一样需要 placements:read 权限
+			buildingManifest := buildings.Group("/:id/manifest", middleware.ResourceScope("placements"))
+			buildingManifest.GET("", manifestController.GetBuildingManifest)
+			buildingManifest.GET("/delta", manifestController.GetBuildingManifestDelta) // ?since=<版本号>
+		}
+
+		// 通知路由
+		notices := protected.Group("/notices", middleware.ResourceScope("notices"))
+		{
+			notices.GET("", noticeController.GetNotices)
+			notices.GET("/:id", noticeController.GetNotice)
+			notices.POST("", noticeController.CreateNotice)
+			notices.PUT("/:id", noticeController.UpdateNotice)
+			notices.DELETE("/:id", noticeController.DeleteNotice)
+
+			// 管理通知与建筑的关联
+			notices.POST("/:id/buildings", noticeController.AddBuildingsToNotice)
+			notices.DELETE("/:id/buildings", noticeController.RemoveBuildingsFromNotice)
+			notices.GET("/:id/buildings", noticeController.GetBuildingsByNotice)
+		}
+
+		// 屏幕布局模板
+		layouts := protected.Group("/layouts", middleware.ResourceScope("layouts"))
+		{
+			layouts.GET("", layoutController.ListLayouts)
+			layouts.GET("/:id", layoutController.GetLayout)
+			layouts.POST("", layoutController.CreateLayout)
+			layouts.PUT("/:id", layoutController.UpdateLayout)
+			layouts.DELETE("/:id", layoutController.DeleteLayout)
+		}
+
+		// 日历（节假日等）与分时规则，GET 需要 schedules:read，其余需要 schedules:write
+		calendars := protected.Group("/calendars", middleware.ResourceScope("schedules"))
+		{
+			calendars.GET("", calendarController.ListCalendars)
+			calendars.GET("/:id", calendarController.GetCalendar)
+			calendars.POST("", calendarController.CreateCalendar)
+			calendars.PUT("/:id", calendarController.UpdateCalendar)
+			calendars.DELETE("/:id", calendarController.DeleteCalendar)
+			calendars.GET("/:id/events", calendarController.ListCalendarEvents)
+			calendars.POST("/:id/events", calendarController.CreateCalendarEvent)
+			calendars.DELETE("/:id/events/:event_id", calendarController.DeleteCalendarEvent)
+			calendars.POST("/:id/import", calendarController.ImportCalendar) // 上传 iCalendar 文件，替换全部事件
+		}
+		dayparts := protected.Group("/dayparts", middleware.ResourceScope("schedules"))
+		{
+			dayparts.GET("", daypartController.ListDayparts)
+			dayparts.GET("/:id", daypartController.GetDaypart)
+			dayparts.POST("", daypartController.CreateDaypart)
+			dayparts.PUT("/:id", daypartController.UpdateDaypart)
+			dayparts.DELETE("/:id", daypartController.DeleteDaypart)
+		}
+
+		// 费率卡与发票，GET 需要 billing:read，其余需要 billing:write
+		rateCards := protected.Group("/rate-cards", middleware.ResourceScope("billing"))
+		{
+			rateCards.GET("", billingController.ListRateCards)
+			rateCards.GET("/:id", billingController.GetRateCard)
+			rateCards.POST("", billingController.CreateRateCard)
+			rateCards.PUT("/:id", billingController.UpdateRateCard)
+			rateCards.DELETE("/:id", billingController.DeleteRateCard)
+		}
+		invoices := protected.Group("/invoices", middleware.ResourceScope("billing"))
+		{
+			invoices.GET("", billingController.ListInvoices) // ?advertisement_id=
+			invoices.GET("/:id", billingController.GetInvoice)
+			invoices.POST("", billingController.CreateInvoice)
+			invoices.POST("/:id/void", billingController.VoidInvoice)
 		}
 
-		// 管理员路由
-		admins := protected.Group("/admins")
+		// 导出路由（format=csv|xlsx）
+		exports := protected.Group("/exports", middleware.RequireScope(models.ScopeExportsRead))
 		{
-			admins.GET("/users", controllers.GetAdminUsers)
-			admins.DELETE("/users", controllers.DeleteAdmin)
-			admins.PUT("/user", controllers.UpdateAdminPassword)
+			exports.GET("/ads", exportController.ExportAds)
+			exports.GET("/buildings", exportController.ExportBuildings)
+			exports.GET("/placements", exportController.ExportPlacements)
+			exports.GET("/ads/:id/certificate", exportController.ExportAdCertificate) // 广告投放证明 PDF
+
+			// 发票还需要 billing:read，format 另外支持 pdf
+			exports.GET("/invoices/:id", middleware.RequireScope(models.ScopeBillingRead), exportController.ExportInvoice)
+		}
+
+		// 管理员路由，只允许管理员登录令牌访问
+		admins := protected.Group("/admins", middleware.RequireAdminSession())
+		{
+			admins.GET("/users", adminController.GetAdminUsers)
+			admins.PUT("/user", adminController.UpdateAdminPassword) // 修改当前登录管理员的密码
+
+			// 当前登录管理员的两步验证
+			admins.POST("/2fa/enroll", adminController.EnrollTOTP)
+			admins.POST("/2fa/verify", adminController.ConfirmTOTP)
+			admins.POST("/2fa/recovery-codes", adminController.RegenerateRecoveryCodes)
+			admins.DELETE("/2fa", adminController.DisableTOTP)
+
+			// 仅超级管理员可用
+			superAdmin := admins.Group("", middleware.RequireRole(models.RoleSuperAdmin))
+			superAdmin.DELETE("/users", adminController.DeleteAdmin)
+			superAdmin.POST("/users/:id/password", adminController.ResetAdminPassword)
+			superAdmin.DELETE("/users/:id/2fa", adminController.ResetAdminTOTP)
+			superAdmin.GET("/audit-logs", adminController.GetAuditLogs)
+
+			// API 密钥管理
+			superAdmin.GET("/api-keys", apiKeyController.ListAPIKeys)
+			superAdmin.POST("/api-keys", apiKeyController.CreateAPIKey)
+			superAdmin.DELETE("/api-keys/:id", apiKeyController.RevokeAPIKey)
+
+			// 事件推送订阅与投递日志
+			superAdmin.GET("/webhooks", webhookController.ListWebhooks)
+			superAdmin.POST("/webhooks", webhookController.CreateWebhook)
+			superAdmin.PUT("/webhooks/:id", webhookController.UpdateWebhook)
+			superAdmin.DELETE("/webhooks/:id", webhookController.DeleteWebhook)
+			superAdmin.GET("/webhooks/:id/deliveries", webhookController.ListWebhookDeliveries)
+			superAdmin.POST("/webhooks/:id/deliveries/:delivery_id/retry", webhookController.RetryWebhookDelivery)
 		}
 	}
 
@@ -0,0 +1,257 @@
+package routers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/10240418/advertisement-management-system/backend/config"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/openapi"
+	"github.com/10240418/advertisement-management-system/backend/ratelimit"
+	"github.com/10240418/advertisement-management-system/backend/repository/memory"
+	"github.com/gin-gonic/gin"
+)
+
+// TestMain 丢弃请求日志，失败时只输出断言信息
+func TestMain(m *testing.M) {
+	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	os.Exit(m.Run())
+}
+
+// testPassword 满足默认密码策略的测试密码
+const testPassword = "Str0ng!Passw0rd"
+
+// testServer 基于内存存储的完整路由
+type testServer struct {
+	t      *testing.T
+	router *gin.Engine
+	store  *memory.Store
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	cfg := config.Default()
+	cfg.JWT.Secret = "0123456789abcdef0123456789abcdef"
+	config.InitJWT(cfg.JWT)
+	store := memory.NewStore()
+	return &testServer{t: t, router: SetupRouter(&cfg, store, ratelimit.NewMemoryStore(), nil), store: store}
+}
+
+// do 发送请求，body 不为 nil 时编码为 JSON，token 不为空时作为 Bearer 令牌
+func (s *testServer) do(method, path, token string, body any) *httptest.ResponseRecorder {
+	s.t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			s.t.Fatal(err)
+		}
+	}
+	req := httptest.NewRequest(method, path, &buf)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, req)
+	return w
+}
+
+// expect 检查状态码并解码响应体，out 为 nil 时只检查状态码
+func (s *testServer) expect(w *httptest.ResponseRecorder, status int, out any) {
+	s.t.Helper()
+	if w.Code != status {
+		s.t.Fatalf("状态码 %d，期望 %d：%s", w.Code, status, w.Body.String())
+	}
+	if out != nil {
+		if err := json.Unmarshal(w.Body.Bytes(), out); err != nil {
+			s.t.Fatalf("解码响应失败：%v：%s", err, w.Body.String())
+		}
+	}
+}
+
+// login 登录并返回访问令牌
+func (s *testServer) login(username string) string {
+	s.t.Helper()
+	var resp struct {
+		Token string `json:"token"`
+	}
+	s.expect(s.do(http.MethodPost, "/api/admin/login", "", credentials(username)), http.StatusOK, &resp)
+	return resp.Token
+}
+
+// bootstrap 注册第一个管理员（超级管理员）并返回其令牌
+func (s *testServer) bootstrap() string {
+	s.t.Helper()
+	s.expect(s.do(http.MethodPost, "/api/admin/register", "", credentials("root")), http.StatusCreated, nil)
+	return s.login("root")
+}
+
+func credentials(username string) map[string]string {
+	return map[string]string{"username": username, "password": testPassword}
+}
+
+// errorCode 取出错误响应中的 code
+func errorCode(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+	var resp struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("解码错误响应失败：%v：%s", err, w.Body.String())
+	}
+	return resp.Error.Code
+}
+
+// TestRoutesMatchOpenAPI 注册的路由必须与 openapi.yaml 一致，新增或删除接口时需要同步更新文档
+func TestRoutesMatchOpenAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := config.Default()
+	r := SetupRouter(&cfg, memory.NewStore(), ratelimit.NewMemoryStore(), nil)
+
+	if err := openapi.Check(r.Routes()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestRegisterAdmin 已有管理员后只有超级管理员可以注册新的管理员
+func TestRegisterAdmin(t *testing.T) {
+	s := newTestServer(t)
+	root := s.bootstrap()
+
+	w := s.do(http.MethodPost, "/api/admin/register", "", credentials("anonymous"))
+	s.expect(w, http.StatusUnauthorized, nil)
+	if code := errorCode(t, w); code != "auth_required" {
+		t.Fatalf("匿名注册返回 %q，期望 auth_required", code)
+	}
+
+	s.expect(s.do(http.MethodPost, "/api/admin/register", root, credentials("operator")), http.StatusCreated, nil)
+	operator := s.login("operator")
+
+	w = s.do(http.MethodPost, "/api/admin/register", operator, credentials("another"))
+	s.expect(w, http.StatusForbidden, nil)
+	if code := errorCode(t, w); code != "permission_denied" {
+		t.Fatalf("普通管理员注册返回 %q，期望 permission_denied", code)
+	}
+
+	s.expect(s.do(http.MethodPost, "/api/admin/register", "not-a-token", credentials("another")), http.StatusUnauthorized, nil)
+}
+
+// TestRegisterAdminRateLimit 注册与登录共用按 IP 的限流
+func TestRegisterAdminRateLimit(t *testing.T) {
+	s := newTestServer(t)
+	s.bootstrap() // 注册与登录各占一次
+
+	limit := config.Default().Auth.LoginPerIP
+	for i := 2; i < limit; i++ {
+		s.expect(s.do(http.MethodPost, "/api/admin/register", "", credentials("anonymous")), http.StatusUnauthorized, nil)
+	}
+	s.expect(s.do(http.MethodPost, "/api/admin/register", "", credentials("anonymous")), http.StatusTooManyRequests, nil)
+}
+
+// TestRegisterAdminBootstrapRace 并发的首次注册中只有一个成功成为超级管理员
+func TestRegisterAdminBootstrapRace(t *testing.T) {
+	s := newTestServer(t)
+
+	const n = 5
+	codes := make(chan int, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			body, _ := json.Marshal(credentials(fmt.Sprintf("root%d", i)))
+			req := httptest.NewRequest(http.MethodPost, "/api/admin/register", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			s.router.ServeHTTP(w, req)
+			codes <- w.Code
+		}(i)
+	}
+	wg.Wait()
+	close(codes)
+
+	created := 0
+	for code := range codes {
+		switch code {
+		case http.StatusCreated:
+			created++
+		case http.StatusUnauthorized:
+		default:
+			t.Fatalf("并发注册返回 %d", code)
+		}
+	}
+	if created != 1 {
+		t.Fatalf("%d 个注册成功，期望 1 个", created)
+	}
+	if count, _ := s.store.Admins().CountByRole(context.Background(), models.RoleSuperAdmin); count != 1 {
+		t.Fatalf("超级管理员 %d 个，期望 1 个", count)
+	}
+
+	// 之后的匿名注册同样被拒绝
+	w := s.do(http.MethodPost, "/api/admin/register", "", credentials("late"))
+	s.expect(w, http.StatusUnauthorized, nil)
+}
+
+// TestDeleteAdmin 只有超级管理员可以删除管理员，不能删除自己，删除写入审计日志
+func TestDeleteAdmin(t *testing.T) {
+	s := newTestServer(t)
+	root := s.bootstrap()
+	s.expect(s.do(http.MethodPost, "/api/admin/register", root, credentials("operator")), http.StatusCreated, nil)
+	s.expect(s.do(http.MethodPost, "/api/admin/register", root, credentials("intern")), http.StatusCreated, nil)
+	operator := s.login("operator")
+
+	ctx := context.Background()
+	rootAdmin, err := s.store.Admins().GetByUsername(ctx, "root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	intern, err := s.store.Admins().GetByUsername(ctx, "intern")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := s.do(http.MethodDelete, "/api/admins/users", operator, gin.H{"id": intern.ID})
+	s.expect(w, http.StatusForbidden, nil)
+
+	w = s.do(http.MethodDelete, "/api/admins/users", root, gin.H{"id": rootAdmin.ID})
+	s.expect(w, http.StatusConflict, nil)
+	if code := errorCode(t, w); code != "admin_self_delete" {
+		t.Fatalf("删除自己返回 %q，期望 admin_self_delete", code)
+	}
+
+	w = s.do(http.MethodDelete, "/api/admins/users", root, gin.H{"id": 9999})
+	s.expect(w, http.StatusNotFound, nil)
+	if code := errorCode(t, w); code != "admin_not_found" {
+		t.Fatalf("删除不存在的管理员返回 %q，期望 admin_not_found", code)
+	}
+
+	s.expect(s.do(http.MethodDelete, "/api/admins/users", root, gin.H{"id": intern.ID}), http.StatusOK, nil)
+	if _, err := s.store.Admins().GetByID(ctx, intern.ID); err == nil {
+		t.Fatal("管理员未被删除")
+	}
+
+	var logs struct {
+		Data []struct {
+			Action         string `json:"action"`
+			ActorUsername  string `json:"actor_username"`
+			TargetUsername string `json:"target_username"`
+		} `json:"data"`
+	}
+	s.expect(s.do(http.MethodGet, "/api/admins/audit-logs", root, nil), http.StatusOK, &logs)
+	if len(logs.Data) != 1 || logs.Data[0].Action != models.AuditAdminDeleted ||
+		logs.Data[0].ActorUsername != "root" || logs.Data[0].TargetUsername != "intern" {
+		t.Fatalf("审计日志 %+v", logs.Data)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/config"
+	"github.com/10240418/advertisement-management-system/backend/worker"
+)
+
+// serve 启动 HTTP 服务与后台任务，收到 SIGINT/SIGTERM 后按以下顺序优雅关闭：
+// 关闭 draining 使 /readyz 返回 503，等待 DrainDelay，停止接收新连接并等待进行中的请求，
+// 执行通过 srv.RegisterOnShutdown 注册的连接排空回调，最后停止后台任务
+func serve(cfg config.ServerConfig, srv *http.Server, supervisor *worker.Supervisor, draining chan<- struct{}) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	supervisor.Start(ctx)
+
+	errCh := make(chan error, 1)
+	go func() {
+		slog.Info("服务启动", slog.String("addr", srv.Addr))
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+		close(errCh)
+	}()
+
+	var serveErr error
+	select {
+	case serveErr = <-errCh:
+	case <-ctx.Done():
+		slog.Info("收到退出信号，开始优雅关闭")
+	}
+	// 再次收到信号时恢复默认行为，立即退出
+	stop()
+
+	close(draining)
+	if serveErr == nil && cfg.DrainDelay > 0 {
+		time.Sleep(cfg.DrainDelay)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	var errs []error
+	if serveErr != nil {
+		errs = append(errs, serveErr)
+	} else if err := srv.Shutdown(shutdownCtx); err != nil {
+		errs = append(errs, err)
+	}
+	if err := supervisor.Stop(shutdownCtx); err != nil {
+		errs = append(errs, err)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	slog.Info("服务已停止")
+	return nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/10240418/advertisement-management-system/backend/config"
+	"github.com/10240418/advertisement-management-system/backend/openapi"
+	"github.com/10240418/advertisement-management-system/backend/ratelimit"
+	"github.com/10240418/advertisement-management-system/backend/repository/memory"
+	"github.com/10240418/advertisement-management-system/backend/routers"
+	"github.com/gin-gonic/gin"
+)
+
+// runOpenAPI 执行 openapi 子命令，不需要数据库与配置
+// 用法: main openapi check | print
+//
+//	check 比对 routers.SetupRouter 注册的路由与 openapi.yaml，不一致时返回错误（供 CI 使用）
+//	print 输出 JSON 格式的接口文档
+func runOpenAPI(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("用法: openapi check | print")
+	}
+
+	switch args[0] {
+	case "check":
+		gin.SetMode(gin.ReleaseMode)
+		cfg := config.Default()
+		r := routers.SetupRouter(&cfg, memory.NewStore(), ratelimit.NewMemoryStore(), nil)
+		if err := openapi.Check(r.Routes()); err != nil {
+			return err
+		}
+		fmt.Printf("已注册的 %d 个路由均与 openapi.yaml 一致\n", len(r.Routes()))
+		return nil
+	case "print":
+		content, err := openapi.JSON()
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(content)
+		return err
+	default:
+		return fmt.Errorf("未知的 openapi 子命令 %q，可选 check、print", args[0])
+	}
+}
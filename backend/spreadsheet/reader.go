@@ -0,0 +1,116 @@
+package spreadsheet
+
+// spreadsheet 封装 CSV 与 XLSX 表格的读写，供导入导出功能复用
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Format 表示表格文件格式
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// ErrUnsupportedFormat 表示不支持的文件格式
+var ErrUnsupportedFormat = errors.New("仅支持 csv 和 xlsx 格式")
+
+// FormatFromFilename 根据文件扩展名判断表格格式
+func FormatFromFilename(filename string) (Format, error) {
+	return ParseFormat(strings.TrimPrefix(filepath.Ext(filename), "."))
+}
+
+// ParseFormat 解析格式名称（不区分大小写）
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(name))) {
+	case FormatCSV:
+		return FormatCSV, nil
+	case FormatXLSX:
+		return FormatXLSX, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// ReadRows 读取表格中的所有行，XLSX 文件只读取第一个工作表
+func ReadRows(format Format, r io.Reader) ([][]string, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(r)
+	case FormatXLSX:
+		return readXLSX(r)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+func readCSV(r io.Reader) ([][]string, error) {
+	reader := csv.NewReader(r)
+	// 允许每行字段数不同，缺失的可选列由调用方处理
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("解析 CSV 失败: %w", err)
+	}
+
+	// 去除 Excel 导出 CSV 时常见的 UTF-8 BOM
+	if len(rows) > 0 && len(rows[0]) > 0 {
+		rows[0][0] = strings.TrimPrefix(rows[0][0], "\ufeff")
+	}
+	return rows, nil
+}
+
+func readXLSX(r io.Reader) ([][]string, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("解析 XLSX 失败: %w", err)
+	}
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, errors.New("XLSX 文件不包含工作表")
+	}
+
+	rows, err := f.GetRows(sheets[0])
+	if err != nil {
+		return nil, fmt.Errorf("读取工作表失败: %w", err)
+	}
+	return rows, nil
+}
+
+// HeaderIndex 将表头映射为列索引，列名统一转为小写并去除空格
+func HeaderIndex(header []string) map[string]int {
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if key == "" {
+			continue
+		}
+		if _, exists := index[key]; !exists {
+			index[key] = i
+		}
+	}
+	return index
+}
+
+// Cell 按列名取值，列不存在或行长度不足时返回空字符串
+func Cell(row []string, index map[string]int, names ...string) string {
+	for _, name := range names {
+		if i, ok := index[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+	}
+	return ""
+}
@@ -0,0 +1,151 @@
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// 每写入多少行刷新一次 CSV 缓冲区
+const csvFlushInterval = 500
+
+// RowWriter 逐行写入表格，避免一次性把所有数据加载到内存
+type RowWriter interface {
+	// WriteRow 写入一行数据
+	WriteRow(values ...interface{}) error
+	// Close 完成写入并将剩余内容输出到底层 Writer，释放临时数据；重复调用时不做任何事
+	Close() error
+}
+
+// ContentType 返回格式对应的 MIME 类型
+func ContentType(format Format) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// NewRowWriter 根据格式创建逐行写入器
+func NewRowWriter(format Format, w io.Writer, sheetName string) (RowWriter, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatXLSX:
+		return newXLSXWriter(w, sheetName)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+type csvWriter struct {
+	w     *csv.Writer
+	flush func()
+	rows  int
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	// 写入 BOM，方便 Excel 正确识别 UTF-8 中文
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return nil, err
+	}
+
+	flush := func() {}
+	if f, ok := w.(interface{ Flush() }); ok {
+		flush = f.Flush
+	}
+	return &csvWriter{w: csv.NewWriter(w), flush: flush}, nil
+}
+
+func (cw *csvWriter) WriteRow(values ...interface{}) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		switch v := v.(type) {
+		case nil:
+		case string:
+			record[i] = escapeFormula(v)
+		default:
+			record[i] = fmt.Sprint(v)
+		}
+	}
+	if err := cw.w.Write(record); err != nil {
+		return err
+	}
+
+	cw.rows++
+	if cw.rows%csvFlushInterval == 0 {
+		cw.w.Flush()
+		if err := cw.w.Error(); err != nil {
+			return err
+		}
+		// 同时刷新 HTTP 响应，让客户端尽早收到数据
+		cw.flush()
+	}
+	return nil
+}
+
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// escapeFormula 在以 =、+、-、@ 开头的文本前加单引号，避免 Excel 打开 CSV 时将用户输入的标题等内容当作公式执行。
+// 数字等非文本值不经过这里，负数不受影响
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+type xlsxWriter struct {
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	out    io.Writer
+	row    int
+	closed bool
+}
+
+func newXLSXWriter(w io.Writer, sheetName string) (*xlsxWriter, error) {
+	f := excelize.NewFile()
+	if sheetName == "" {
+		sheetName = "Sheet1"
+	}
+	if sheetName != "Sheet1" {
+		if err := f.SetSheetName("Sheet1", sheetName); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+
+	// StreamWriter 超过内存阈值后会写入临时文件，适合大数据量导出
+	stream, err := f.NewStreamWriter(sheetName)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &xlsxWriter{file: f, stream: stream, out: w}, nil
+}
+
+func (xw *xlsxWriter) WriteRow(values ...interface{}) error {
+	xw.row++
+	cell, err := excelize.CoordinatesToCellName(1, xw.row)
+	if err != nil {
+		return err
+	}
+	return xw.stream.SetRow(cell, values)
+}
+
+func (xw *xlsxWriter) Close() error {
+	if xw.closed {
+		return nil
+	}
+	xw.closed = true
+	defer xw.file.Close()
+	if err := xw.stream.Flush(); err != nil {
+		return err
+	}
+	return xw.file.Write(xw.out)
+}
@@ -0,0 +1,115 @@
+package twofactor
+
+// twofactor 实现管理员两步验证：基于时间的一次性密码（TOTP，RFC 6238）与一次性恢复码
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"image/png"
+	"strings"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	// period 验证码的时间步长，与主流验证器应用一致
+	period = 30
+	// skew 允许前后各偏差的时间步数，容忍设备时钟误差
+	skew = 1
+	// RecoveryCodeCount 每次生成的恢复码数量
+	RecoveryCodeCount = 10
+)
+
+var validateOpts = totp.ValidateOpts{
+	Period:    period,
+	Digits:    otp.DigitsSix,
+	Algorithm: otp.AlgorithmSHA1,
+}
+
+// Enrollment 绑定验证器所需的信息
+type Enrollment struct {
+	Secret          string // Base32 编码的密钥，无法扫码时手动输入
+	ProvisioningURI string // otpauth:// 链接，即二维码的内容
+	QRCode          string // 二维码 PNG 的 data URI
+}
+
+// NewEnrollment 为 account 生成新的 TOTP 密钥
+func NewEnrollment(issuer, account string) (*Enrollment, error) {
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      issuer,
+		AccountName: account,
+		Period:      period,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("生成 TOTP 密钥失败: %w", err)
+	}
+
+	img, err := key.Image(256, 256)
+	if err != nil {
+		return nil, fmt.Errorf("生成二维码失败: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("编码二维码失败: %w", err)
+	}
+
+	return &Enrollment{
+		Secret:          key.Secret(),
+		ProvisioningURI: key.URL(),
+		QRCode:          "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}, nil
+}
+
+// Match 校验验证码，返回验证码对应的时间步，调用方据此拒绝重复使用同一验证码
+func Match(secret, code string, now time.Time) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != otp.DigitsSix.Length() {
+		return 0, false
+	}
+	current := now.Unix() / period
+	for step := current - skew; step <= current+skew; step++ {
+		expected, err := totp.GenerateCodeCustom(secret, time.Unix(step*period, 0), validateOpts)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// NewRecoveryCodes 生成一组恢复码，返回展示给用户的明文与需要保存的哈希
+func NewRecoveryCodes() (codes, hashes []string, err error) {
+	codes = make([]string, RecoveryCodeCount)
+	hashes = make([]string, RecoveryCodeCount)
+	for i := range codes {
+		raw := make([]byte, 10)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, fmt.Errorf("生成恢复码失败: %w", err)
+		}
+		// 10 字节随机数编码为 16 个 Base32 字符，格式 xxxx-xxxx-xxxx-xxxx
+		encoded := strings.ToLower(base32.StdEncoding.EncodeToString(raw))
+		codes[i] = encoded[:4] + "-" + encoded[4:8] + "-" + encoded[8:12] + "-" + encoded[12:]
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode 计算恢复码的哈希，忽略大小写、空格与连字符
+// 恢复码本身是高熵随机值，SHA-256 足以防止数据库泄露后被直接使用
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
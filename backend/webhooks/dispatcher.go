@@ -0,0 +1,190 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/config"
+	"github.com/10240418/advertisement-management-system/backend/metrics"
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+// userAgent 投递请求的 User-Agent
+const userAgent = "AdvertisementManagement-Webhook/1.0"
+
+// maxErrorBody 失败响应记录到投递日志的最大字节数
+const maxErrorBody = 512
+
+// Dispatcher 领取到期的投递记录并发送
+type Dispatcher struct {
+	store  repository.Store
+	cfg    config.WebhookConfig
+	client *http.Client
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewDispatcher 创建 Dispatcher
+func NewDispatcher(store repository.Store, cfg config.WebhookConfig, logger *slog.Logger) *Dispatcher {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Dispatcher{
+		store: store,
+		cfg:   cfg,
+		client: &http.Client{
+			Timeout: cfg.Timeout,
+			// 不跟随重定向，避免签名请求被转发到订阅之外的地址
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		logger: logger.With(slog.String("component", "webhooks")),
+		now:    time.Now,
+	}
+}
+
+// Run 领取并投递一批到期记录，供后台任务周期调用
+func (d *Dispatcher) Run(ctx context.Context) error {
+	now := d.now()
+	// 租期覆盖整批记录逐条超时的最坏情况，到期前其他实例不会重复领取
+	lease := now.Add(d.cfg.Timeout*time.Duration(d.cfg.BatchSize) + time.Minute)
+	deliveries, err := d.store.Webhooks().ClaimDue(ctx, now, lease, d.cfg.BatchSize)
+	if err != nil {
+		return fmt.Errorf("领取待投递事件失败: %w", err)
+	}
+
+	subs := make(map[uint]*models.WebhookSubscription)
+	for i := range deliveries {
+		if ctx.Err() != nil {
+			// 未处理的记录在租期结束后重新投递
+			return nil
+		}
+		delivery := &deliveries[i]
+		sub, ok := subs[delivery.SubscriptionID]
+		if !ok {
+			sub, err = d.store.Webhooks().GetSubscription(ctx, delivery.SubscriptionID)
+			if errors.Is(err, repository.ErrNotFound) {
+				continue // 订阅已删除，投递记录随之删除
+			}
+			if err != nil {
+				return err
+			}
+			subs[delivery.SubscriptionID] = sub
+		}
+		if err := d.deliver(ctx, sub, delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliver 发送一次并记录结果
+func (d *Dispatcher) deliver(ctx context.Context, sub *models.WebhookSubscription, delivery *models.WebhookDelivery) error {
+	var status int
+	var sendErr error
+	if sub.Active {
+		status, sendErr = d.send(ctx, sub, delivery)
+		if ctx.Err() != nil {
+			return nil
+		}
+	} else {
+		sendErr = errors.New("订阅已停用")
+	}
+
+	now := d.now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus = status
+	delivery.LastError = ""
+	result := "success"
+	switch {
+	case sendErr == nil:
+		delivery.Status = models.DeliverySucceeded
+		delivery.DeliveredAt = &now
+	case !sub.Active || delivery.Attempts >= d.cfg.MaxAttempts:
+		delivery.Status = models.DeliveryFailed
+		delivery.LastError = sendErr.Error()
+		result = "failure"
+	default:
+		delivery.NextAttemptAt = now.Add(d.backoff(delivery.Attempts))
+		delivery.LastError = sendErr.Error()
+		result = "retry"
+	}
+	metrics.WebhookDelivered(result)
+
+	logger := d.logger.With(
+		slog.Uint64("delivery_id", uint64(delivery.ID)),
+		slog.Uint64("subscription_id", uint64(sub.ID)),
+		slog.String("event", delivery.Event),
+		slog.Int("attempts", delivery.Attempts),
+	)
+	if sendErr != nil {
+		logger.Warn("事件投递失败", slog.String("result", result), slog.Int("status", status), slog.Any("error", sendErr))
+	} else {
+		logger.Debug("事件投递成功", slog.Int("status", status))
+	}
+
+	// 使用独立的 ctx 保存结果，关闭过程中也不丢失已完成的投递
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := d.store.Webhooks().UpdateDelivery(saveCtx, delivery); err != nil {
+		return fmt.Errorf("保存投递结果失败: %w", err)
+	}
+	return nil
+}
+
+// send 发送签名请求，非 2xx 响应视为失败
+func (d *Dispatcher) send(ctx context.Context, sub *models.WebhookSubscription, delivery *models.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := d.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(HeaderEvent, delivery.Event)
+	req.Header.Set(HeaderEventID, delivery.EventID)
+	req.Header.Set(HeaderDelivery, strconv.FormatUint(uint64(delivery.ID), 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		return resp.StatusCode, nil
+	}
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	msg := fmt.Sprintf("响应状态码 %d", resp.StatusCode)
+	if s := strings.TrimSpace(string(snippet)); s != "" {
+		msg += ": " + s
+	}
+	return resp.StatusCode, errors.New(msg)
+}
+
+// backoff 第 attempts 次失败后的等待时间：BackoffBase * 2^(attempts-1)，不超过 BackoffMax，
+// 并加入最多 10% 的随机抖动，避免大量失败的记录同时重试
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	wait := d.cfg.BackoffBase
+	for i := 1; i < attempts && wait < d.cfg.BackoffMax; i++ {
+		wait *= 2
+	}
+	wait = min(wait, d.cfg.BackoffMax)
+	return wait + rand.N(wait/10+1)
+}
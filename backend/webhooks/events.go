@@ -0,0 +1,142 @@
+package webhooks
+
+// webhooks 实现事件推送：业务操作在同一事务中写入发件箱（webhook_deliveries），
+// 后台任务 Dispatcher 领取到期记录并以 HMAC-SHA256 签名后投递，失败按指数退避重试
+//
+// 接收方收到的请求体：
+//
+//	{"id": "<事件 ID>", "event": "ad.updated", "created_at": "...", "data": {...}}
+//
+// 同一事件投递到多个订阅时 id 相同；重试时请求体不变，接收方应按 id 去重
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/10240418/advertisement-management-system/backend/models"
+	"github.com/10240418/advertisement-management-system/backend/repository"
+)
+
+// Event 待推送的事件，Data 会被序列化为请求体的 data 字段
+type Event struct {
+	Type string
+	Data any
+}
+
+// envelope 请求体
+type envelope struct {
+	ID        string    `json:"id"`
+	Event     string    `json:"event"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// Ad 广告事件的 data
+type Ad struct {
+	ID            uint       `json:"id"`
+	Title         string     `json:"title"`
+	Description   string     `json:"description"`
+	ImageURL      string     `json:"image_url"`
+	VideoURL      string     `json:"video_url"`
+	VideoDuration int64      `json:"video_duration"`
+	Status        string     `json:"status"`
+	GoLiveAt      *time.Time `json:"go_live_at"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// Placement 投放事件的 data
+type Placement struct {
+	AdvertisementID uint  `json:"advertisement_id"`
+	BuildingID      uint  `json:"building_id"`
+	PlayDuration    int64 `json:"play_duration"`
+}
+
+// AdEvent 创建广告事件
+func AdEvent(event string, ad models.Advertisement) Event {
+	return Event{Type: event, Data: Ad{
+		ID:            ad.ID,
+		Title:         ad.Title,
+		Description:   ad.Description,
+		ImageURL:      ad.ImageURL,
+		VideoURL:      ad.VideoURL,
+		VideoDuration: ad.VideoDuration,
+		Status:        ad.Status,
+		GoLiveAt:      ad.GoLiveAt,
+		ExpiresAt:     ad.ExpiresAt,
+		UpdatedAt:     ad.UpdatedAt,
+	}}
+}
+
+// PlacementEvent 创建投放事件
+func PlacementEvent(event string, placement models.AdvertisementBuilding) Event {
+	return Event{Type: event, Data: Placement{
+		AdvertisementID: placement.AdvertisementID,
+		BuildingID:      placement.BuildingID,
+		PlayDuration:    placement.PlayDuration,
+	}}
+}
+
+// PlacementEvents 为一组投放记录创建同类事件
+func PlacementEvents(event string, placements []models.AdvertisementBuilding) []Event {
+	events := make([]Event, len(placements))
+	for i, placement := range placements {
+		events[i] = PlacementEvent(event, placement)
+	}
+	return events
+}
+
+// Emit 为订阅了事件的每个启用订阅写入一条待投递记录
+// tx 应与业务数据使用同一事务，事务回滚时事件同样不会发出
+func Emit(ctx context.Context, tx repository.Store, events ...Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	subs, err := tx.Webhooks().ActiveSubscriptions(ctx)
+	if err != nil || len(subs) == 0 {
+		return err
+	}
+
+	now := time.Now().UTC() // 事件时间统一使用 UTC，与数据库中的时间一致
+	var deliveries []models.WebhookDelivery
+	for _, event := range events {
+		var payload []byte
+		var eventID string
+		for _, sub := range subs {
+			if !sub.Subscribed(event.Type) {
+				continue
+			}
+			// 只为有订阅的事件生成 ID 和请求体
+			if payload == nil {
+				if eventID, err = newEventID(); err != nil {
+					return err
+				}
+				payload, err = json.Marshal(envelope{ID: eventID, Event: event.Type, CreatedAt: now, Data: event.Data})
+				if err != nil {
+					return err
+				}
+			}
+			deliveries = append(deliveries, models.WebhookDelivery{
+				SubscriptionID: sub.ID,
+				EventID:        eventID,
+				Event:          event.Type,
+				Payload:        string(payload),
+				Status:         models.DeliveryPending,
+				NextAttemptAt:  now,
+			})
+		}
+	}
+	return tx.Webhooks().EnqueueDeliveries(ctx, deliveries)
+}
+
+// newEventID 生成 32 位十六进制事件 ID
+func newEventID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,133 @@
+package worker
+
+// worker 管理后台周期任务（过期清理、缓存清理等）的启动与停止
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// Job 周期执行的后台任务
+type Job struct {
+	Name     string
+	Interval time.Duration
+	// RunOnStart 为 true 时启动后立即执行一次，否则等待第一个周期
+	RunOnStart bool
+	// Run 执行一次任务，ctx 在停止时取消，任务应尽快返回
+	Run func(ctx context.Context) error
+}
+
+// Supervisor 启动并监督一组周期任务，停止时取消 ctx 并等待任务退出
+type Supervisor struct {
+	mu      sync.Mutex
+	jobs    []Job
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	logger  *slog.Logger
+	started bool
+}
+
+// NewSupervisor 创建 Supervisor
+func NewSupervisor(logger *slog.Logger) *Supervisor {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Supervisor{logger: logger.With(slog.String("component", "worker"))}
+}
+
+// Add 注册任务，必须在 Start 之前调用
+func (s *Supervisor) Add(job Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		return errors.New("任务必须在启动前注册")
+	}
+	if job.Name == "" || job.Run == nil || job.Interval <= 0 {
+		return fmt.Errorf("任务 %q 配置无效：需要名称、执行函数和大于 0 的间隔", job.Name)
+	}
+	s.jobs = append(s.jobs, job)
+	return nil
+}
+
+// Start 在后台启动所有任务，parent 取消时任务同样停止
+func (s *Supervisor) Start(parent context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		return
+	}
+	s.started = true
+
+	ctx, cancel := context.WithCancel(parent)
+	s.cancel = cancel
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+	s.logger.Info("后台任务已启动", slog.Int("jobs", len(s.jobs)))
+}
+
+// Stop 取消所有任务并等待退出，ctx 到期时返回 ctx 的错误
+func (s *Supervisor) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.mu.Unlock()
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		s.logger.Info("后台任务已停止")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("等待后台任务退出超时: %w", ctx.Err())
+	}
+}
+
+// loop 按间隔执行任务，直到 ctx 取消
+func (s *Supervisor) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	if job.RunOnStart {
+		s.run(ctx, job)
+	}
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.run(ctx, job)
+		}
+	}
+}
+
+// run 执行一次任务，记录耗时与错误，任务 panic 不会影响其他任务
+func (s *Supervisor) run(ctx context.Context, job Job) {
+	logger := s.logger.With(slog.String("job", job.Name))
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("后台任务 panic", slog.Any("panic", r), slog.String("stack", string(debug.Stack())))
+		}
+	}()
+
+	if err := job.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		logger.Error("后台任务执行失败", slog.Any("error", err), slog.Duration("elapsed", time.Since(start)))
+		return
+	}
+	logger.Debug("后台任务执行完成", slog.Duration("elapsed", time.Since(start)))
+}